@@ -63,10 +63,11 @@ func main() {
 	txManager := tx_manager.NewTxManager(dbConn)
 
 	auditRepo := postgresql.NewAuditRepositoryImpl(dbConn)
-	orderStatusAuditRepo := postgresql.NewOrderStatusAuditRepositoryImpl(dbConn)
 	outboxRepo := postgresql.NewOutboxRepositoryImpl(txManager)
-	workersManager := workers.NewWorkerManager(kafkaClient, auditRepo, orderStatusAuditRepo, outboxRepo, 5, cancel, *cfg)
+	workersManager := workers.NewWorkerManager(kafkaClient, auditRepo, outboxRepo, 5, cancel, *cfg)
 	workersManager.Start(ctx)
+	workers.NewOutboxReaper(outboxRepo, cfg.Outbox).Start(ctx)
+	workers.NewArchivePurger(postgresql.NewOrderArchiveRepositoryImpl(txManager), cfg.Archive).Start(ctx)
 
 	tech_monitoring.RegisterBusinessMetrics()
 	domain_monitoring.RegisterBusinessMetrics()
@@ -81,7 +82,7 @@ func main() {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		server.Run(*cfg, txManager, workersManager)
+		server.Run(ctx, *cfg, txManager)
 	}()
 
 	wg.Wait()
@@ -22,22 +22,80 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Money is an amount in minor units of an ISO 4217 currency, e.g. kopecks for RUB.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_order_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateOrderRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	OrderId          int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	ExpirationTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
 	Weight           int32                  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
-	Cost             int32                  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
 	PackageType      string                 `protobuf:"bytes,6,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
 	IsAdditionalFilm bool                   `protobuf:"varint,7,opt,name=is_additional_film,json=isAdditionalFilm,proto3" json:"is_additional_film,omitempty"`
+	PackagingLayers  []string               `protobuf:"bytes,8,rep,name=packaging_layers,json=packagingLayers,proto3" json:"packaging_layers,omitempty"`
+	Length           int32                  `protobuf:"varint,9,opt,name=length,proto3" json:"length,omitempty"`
+	Width            int32                  `protobuf:"varint,10,opt,name=width,proto3" json:"width,omitempty"`
+	Height           int32                  `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	Cost             *Money                 `protobuf:"bytes,12,opt,name=cost,proto3" json:"cost,omitempty"`
+	PickupPointId    int64                  `protobuf:"varint,13,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
 	*x = CreateOrderRequest{}
-	mi := &file_order_service_proto_msgTypes[0]
+	mi := &file_order_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -49,7 +107,7 @@ func (x *CreateOrderRequest) String() string {
 func (*CreateOrderRequest) ProtoMessage() {}
 
 func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[0]
+	mi := &file_order_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +120,7 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
 func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{0}
+	return file_order_service_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreateOrderRequest) GetOrderId() int64 {
@@ -93,13 +151,6 @@ func (x *CreateOrderRequest) GetWeight() int32 {
 	return 0
 }
 
-func (x *CreateOrderRequest) GetCost() int32 {
-	if x != nil {
-		return x.Cost
-	}
-	return 0
-}
-
 func (x *CreateOrderRequest) GetPackageType() string {
 	if x != nil {
 		return x.PackageType
@@ -114,16 +165,59 @@ func (x *CreateOrderRequest) GetIsAdditionalFilm() bool {
 	return false
 }
 
+func (x *CreateOrderRequest) GetPackagingLayers() []string {
+	if x != nil {
+		return x.PackagingLayers
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PickupCode    string                 `protobuf:"bytes,2,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateOrderResponse) Reset() {
 	*x = CreateOrderResponse{}
-	mi := &file_order_service_proto_msgTypes[1]
+	mi := &file_order_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +229,7 @@ func (x *CreateOrderResponse) String() string {
 func (*CreateOrderResponse) ProtoMessage() {}
 
 func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[1]
+	mi := &file_order_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +242,7 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
 func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{1}
+	return file_order_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateOrderResponse) GetOrderId() int64 {
@@ -158,33 +252,6996 @@ func (x *CreateOrderResponse) GetOrderId() int64 {
 	return 0
 }
 
+func (x *CreateOrderResponse) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
 type Order struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Weight         int32                  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	Cost           int32                  `protobuf:"varint,6,opt,name=cost,proto3" json:"cost,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpirationTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Weight           int32                  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Packaging        []*PackagingLayer      `protobuf:"bytes,7,rep,name=packaging,proto3" json:"packaging,omitempty"`
+	PackageType      string                 `protobuf:"bytes,8,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	IsAdditionalFilm bool                   `protobuf:"varint,9,opt,name=is_additional_film,json=isAdditionalFilm,proto3" json:"is_additional_film,omitempty"`
+	Length           int32                  `protobuf:"varint,12,opt,name=length,proto3" json:"length,omitempty"`
+	Width            int32                  `protobuf:"varint,13,opt,name=width,proto3" json:"width,omitempty"`
+	Height           int32                  `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+	Cost             *Money                 `protobuf:"bytes,15,opt,name=cost,proto3" json:"cost,omitempty"`
+	BaseCost         *Money                 `protobuf:"bytes,16,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
+	PackagingCost    *Money                 `protobuf:"bytes,17,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
+	PickupPointId    int64                  `protobuf:"varint,18,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	StorageCell      string                 `protobuf:"bytes,19,opt,name=storage_cell,json=storageCell,proto3" json:"storage_cell,omitempty"`
+	Refund           *RefundDetails         `protobuf:"bytes,20,opt,name=refund,proto3" json:"refund,omitempty"`
+	StorageFee       *StorageFee            `protobuf:"bytes,21,opt,name=storage_fee,json=storageFee,proto3" json:"storage_fee,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_order_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Order) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Order) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Order) GetPackaging() []*PackagingLayer {
+	if x != nil {
+		return x.Packaging
+	}
+	return nil
+}
+
+func (x *Order) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *Order) GetIsAdditionalFilm() bool {
+	if x != nil {
+		return x.IsAdditionalFilm
+	}
+	return false
+}
+
+func (x *Order) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Order) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Order) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Order) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *Order) GetBaseCost() *Money {
+	if x != nil {
+		return x.BaseCost
+	}
+	return nil
+}
+
+func (x *Order) GetPackagingCost() *Money {
+	if x != nil {
+		return x.PackagingCost
+	}
+	return nil
+}
+
+func (x *Order) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *Order) GetStorageCell() string {
+	if x != nil {
+		return x.StorageCell
+	}
+	return ""
+}
+
+func (x *Order) GetRefund() *RefundDetails {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *Order) GetStorageFee() *StorageFee {
+	if x != nil {
+		return x.StorageFee
+	}
+	return nil
+}
+
+// StorageFee is accrued by orders waiting for their owners, stored days are
+// started days since the order was put on the shelf.
+type StorageFee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoredSince   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=stored_since,json=storedSince,proto3" json:"stored_since,omitempty"`
+	StoredDays    int32                  `protobuf:"varint,2,opt,name=stored_days,json=storedDays,proto3" json:"stored_days,omitempty"`
+	FreeDays      int32                  `protobuf:"varint,3,opt,name=free_days,json=freeDays,proto3" json:"free_days,omitempty"`
+	ChargedDays   int32                  `protobuf:"varint,4,opt,name=charged_days,json=chargedDays,proto3" json:"charged_days,omitempty"`
+	DailyRate     *Money                 `protobuf:"bytes,5,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageFee) Reset() {
+	*x = StorageFee{}
+	mi := &file_order_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageFee) ProtoMessage() {}
+
+func (x *StorageFee) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageFee.ProtoReflect.Descriptor instead.
+func (*StorageFee) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StorageFee) GetStoredSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoredSince
+	}
+	return nil
+}
+
+func (x *StorageFee) GetStoredDays() int32 {
+	if x != nil {
+		return x.StoredDays
+	}
+	return 0
+}
+
+func (x *StorageFee) GetFreeDays() int32 {
+	if x != nil {
+		return x.FreeDays
+	}
+	return 0
+}
+
+func (x *StorageFee) GetChargedDays() int32 {
+	if x != nil {
+		return x.ChargedDays
+	}
+	return 0
+}
+
+func (x *StorageFee) GetDailyRate() *Money {
+	if x != nil {
+		return x.DailyRate
+	}
+	return nil
+}
+
+func (x *StorageFee) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type RefundDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReasonCode    string                 `protobuf:"bytes,1,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	ItemCondition string                 `protobuf:"bytes,3,opt,name=item_condition,json=itemCondition,proto3" json:"item_condition,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundDetails) Reset() {
+	*x = RefundDetails{}
+	mi := &file_order_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundDetails) ProtoMessage() {}
+
+func (x *RefundDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundDetails.ProtoReflect.Descriptor instead.
+func (*RefundDetails) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RefundDetails) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *RefundDetails) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RefundDetails) GetItemCondition() string {
+	if x != nil {
+		return x.ItemCondition
+	}
+	return ""
+}
+
+func (x *RefundDetails) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PackagingLayer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Position      int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	PackageType   string                 `protobuf:"bytes,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	Cost          *Money                 `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackagingLayer) Reset() {
+	*x = PackagingLayer{}
+	mi := &file_order_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagingLayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagingLayer) ProtoMessage() {}
+
+func (x *PackagingLayer) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagingLayer.ProtoReflect.Descriptor instead.
+func (*PackagingLayer) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PackagingLayer) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PackagingLayer) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *PackagingLayer) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type GetOrderByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderByIDRequest) Reset() {
+	*x = GetOrderByIDRequest{}
+	mi := &file_order_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByIDRequest) ProtoMessage() {}
+
+func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderByIDRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOrderByIDRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type GetOrderByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderByIDResponse) Reset() {
+	*x = GetOrderByIDResponse{}
+	mi := &file_order_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderByIDResponse) ProtoMessage() {}
+
+func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderByIDResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOrderByIDResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	LastId        int64                  `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	SearchTerm    string                 `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,6,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	RefundReason  string                 `protobuf:"bytes,7,opt,name=refund_reason,json=refundReason,proto3" json:"refund_reason,omitempty"`
+	ItemCondition string                 `protobuf:"bytes,8,opt,name=item_condition,json=itemCondition,proto3" json:"item_condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_order_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetLastId() int64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetSearchTerm() string {
+	if x != nil {
+		return x.SearchTerm
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ListOrdersRequest) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
+	}
+	return ""
+}
+
+func (x *ListOrdersRequest) GetItemCondition() string {
+	if x != nil {
+		return x.ItemCondition
+	}
+	return ""
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_order_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// ProcessOrderRequest action "complete" needs pickup_code, action "override"
+// issues the order without it and needs override_reason. An override is made
+// by the supervisor authenticated with the x-supervisor-token metadata,
+// supervisor_id is ignored.
+type ProcessOrderRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OrderId    int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId     int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action     string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	PickupCode string                 `protobuf:"bytes,4,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	// Deprecated: Marked as deprecated in order_service.proto.
+	SupervisorId   int64  `protobuf:"varint,5,opt,name=supervisor_id,json=supervisorId,proto3" json:"supervisor_id,omitempty"`
+	OverrideReason string `protobuf:"bytes,6,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	RefundReason   string `protobuf:"bytes,7,opt,name=refund_reason,json=refundReason,proto3" json:"refund_reason,omitempty"`
+	RefundComment  string `protobuf:"bytes,8,opt,name=refund_comment,json=refundComment,proto3" json:"refund_comment,omitempty"`
+	ItemCondition  string `protobuf:"bytes,9,opt,name=item_condition,json=itemCondition,proto3" json:"item_condition,omitempty"`
+	StorageFeePaid bool   `protobuf:"varint,10,opt,name=storage_fee_paid,json=storageFeePaid,proto3" json:"storage_fee_paid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProcessOrderRequest) Reset() {
+	*x = ProcessOrderRequest{}
+	mi := &file_order_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessOrderRequest) ProtoMessage() {}
+
+func (x *ProcessOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessOrderRequest.ProtoReflect.Descriptor instead.
+func (*ProcessOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProcessOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ProcessOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ProcessOrderRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProcessOrderRequest) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in order_service.proto.
+func (x *ProcessOrderRequest) GetSupervisorId() int64 {
+	if x != nil {
+		return x.SupervisorId
+	}
+	return 0
+}
+
+func (x *ProcessOrderRequest) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
+func (x *ProcessOrderRequest) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
+	}
+	return ""
+}
+
+func (x *ProcessOrderRequest) GetRefundComment() string {
+	if x != nil {
+		return x.RefundComment
+	}
+	return ""
+}
+
+func (x *ProcessOrderRequest) GetItemCondition() string {
+	if x != nil {
+		return x.ItemCondition
+	}
+	return ""
+}
+
+func (x *ProcessOrderRequest) GetStorageFeePaid() bool {
+	if x != nil {
+		return x.StorageFeePaid
+	}
+	return false
+}
+
+type ProcessOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessOrderResponse) Reset() {
+	*x = ProcessOrderResponse{}
+	mi := &file_order_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessOrderResponse) ProtoMessage() {}
+
+func (x *ProcessOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessOrderResponse.ProtoReflect.Descriptor instead.
+func (*ProcessOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{12}
+}
+
+type ReturnOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnOrderRequest) Reset() {
+	*x = ReturnOrderRequest{}
+	mi := &file_order_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderRequest) ProtoMessage() {}
+
+func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReturnOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReturnOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ReturnOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnOrderResponse) Reset() {
+	*x = ReturnOrderResponse{}
+	mi := &file_order_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderResponse) ProtoMessage() {}
+
+func (x *ReturnOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderResponse.ProtoReflect.Descriptor instead.
+func (*ReturnOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{14}
+}
+
+type ListOrderTransitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderTransitionsRequest) Reset() {
+	*x = ListOrderTransitionsRequest{}
+	mi := &file_order_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderTransitionsRequest) ProtoMessage() {}
+
+func (x *ListOrderTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListOrderTransitionsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ListOrderTransitionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type OrderTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,2,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,3,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTransition) Reset() {
+	*x = OrderTransition{}
+	mi := &file_order_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTransition) ProtoMessage() {}
+
+func (x *OrderTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTransition.ProtoReflect.Descriptor instead.
+func (*OrderTransition) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderTransition) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+type ListOrderTransitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transitions   []*OrderTransition     `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderTransitionsResponse) Reset() {
+	*x = ListOrderTransitionsResponse{}
+	mi := &file_order_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderTransitionsResponse) ProtoMessage() {}
+
+func (x *ListOrderTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListOrderTransitionsResponse) GetTransitions() []*OrderTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type Package struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MaxWeight     int32                  `protobuf:"varint,2,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	CanWrap       bool                   `protobuf:"varint,4,opt,name=can_wrap,json=canWrap,proto3" json:"can_wrap,omitempty"`
+	IsActive      bool                   `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	MaxVolume     int32                  `protobuf:"varint,6,opt,name=max_volume,json=maxVolume,proto3" json:"max_volume,omitempty"`
+	MaxLength     int32                  `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	Price         *Money                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Package) Reset() {
+	*x = Package{}
+	mi := &file_order_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Package) ProtoMessage() {}
+
+func (x *Package) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Package) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Package) GetMaxWeight() int32 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *Package) GetCanWrap() bool {
+	if x != nil {
+		return x.CanWrap
+	}
+	return false
+}
+
+func (x *Package) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Package) GetMaxVolume() int32 {
+	if x != nil {
+		return x.MaxVolume
+	}
+	return 0
+}
+
+func (x *Package) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *Package) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type ListPackagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackagesRequest) Reset() {
+	*x = ListPackagesRequest{}
+	mi := &file_order_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesRequest) ProtoMessage() {}
+
+func (x *ListPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{19}
+}
+
+type ListPackagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*Package             `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackagesResponse) Reset() {
+	*x = ListPackagesResponse{}
+	mi := &file_order_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesResponse) ProtoMessage() {}
+
+func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPackagesResponse) GetPackages() []*Package {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type UpsertPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       *Package               `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPackageRequest) Reset() {
+	*x = UpsertPackageRequest{}
+	mi := &file_order_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPackageRequest) ProtoMessage() {}
+
+func (x *UpsertPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPackageRequest.ProtoReflect.Descriptor instead.
+func (*UpsertPackageRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpsertPackageRequest) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+type UpsertPackageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Package       *Package               `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertPackageResponse) Reset() {
+	*x = UpsertPackageResponse{}
+	mi := &file_order_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertPackageResponse) ProtoMessage() {}
+
+func (x *UpsertPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertPackageResponse.ProtoReflect.Descriptor instead.
+func (*UpsertPackageResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpsertPackageResponse) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+type QuoteOrderRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Weight           int32                  `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	PackageType      string                 `protobuf:"bytes,3,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	IsAdditionalFilm bool                   `protobuf:"varint,4,opt,name=is_additional_film,json=isAdditionalFilm,proto3" json:"is_additional_film,omitempty"`
+	PackagingLayers  []string               `protobuf:"bytes,5,rep,name=packaging_layers,json=packagingLayers,proto3" json:"packaging_layers,omitempty"`
+	Length           int32                  `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty"`
+	Width            int32                  `protobuf:"varint,7,opt,name=width,proto3" json:"width,omitempty"`
+	Height           int32                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Cost             *Money                 `protobuf:"bytes,9,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QuoteOrderRequest) Reset() {
+	*x = QuoteOrderRequest{}
+	mi := &file_order_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderRequest) ProtoMessage() {}
+
+func (x *QuoteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderRequest.ProtoReflect.Descriptor instead.
+func (*QuoteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuoteOrderRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *QuoteOrderRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *QuoteOrderRequest) GetIsAdditionalFilm() bool {
+	if x != nil {
+		return x.IsAdditionalFilm
+	}
+	return false
+}
+
+func (x *QuoteOrderRequest) GetPackagingLayers() []string {
+	if x != nil {
+		return x.PackagingLayers
+	}
+	return nil
+}
+
+func (x *QuoteOrderRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *QuoteOrderRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *QuoteOrderRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *QuoteOrderRequest) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type QuoteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packaging     []*PackagingLayer      `protobuf:"bytes,2,rep,name=packaging,proto3" json:"packaging,omitempty"`
+	BaseCost      *Money                 `protobuf:"bytes,5,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
+	PackagingCost *Money                 `protobuf:"bytes,6,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
+	TotalCost     *Money                 `protobuf:"bytes,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteOrderResponse) Reset() {
+	*x = QuoteOrderResponse{}
+	mi := &file_order_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteOrderResponse) ProtoMessage() {}
+
+func (x *QuoteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteOrderResponse.ProtoReflect.Descriptor instead.
+func (*QuoteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuoteOrderResponse) GetPackaging() []*PackagingLayer {
+	if x != nil {
+		return x.Packaging
+	}
+	return nil
+}
+
+func (x *QuoteOrderResponse) GetBaseCost() *Money {
+	if x != nil {
+		return x.BaseCost
+	}
+	return nil
+}
+
+func (x *QuoteOrderResponse) GetPackagingCost() *Money {
+	if x != nil {
+		return x.PackagingCost
+	}
+	return nil
+}
+
+func (x *QuoteOrderResponse) GetTotalCost() *Money {
+	if x != nil {
+		return x.TotalCost
+	}
+	return nil
+}
+
+type RecommendPackagingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        int32                  `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Width         int32                  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Cost          *Money                 `protobuf:"bytes,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendPackagingRequest) Reset() {
+	*x = RecommendPackagingRequest{}
+	mi := &file_order_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendPackagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendPackagingRequest) ProtoMessage() {}
+
+func (x *RecommendPackagingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendPackagingRequest.ProtoReflect.Descriptor instead.
+func (*RecommendPackagingRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecommendPackagingRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RecommendPackagingRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *RecommendPackagingRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *RecommendPackagingRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *RecommendPackagingRequest) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+type RecommendPackagingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageType   string                 `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	Packaging     []*PackagingLayer      `protobuf:"bytes,3,rep,name=packaging,proto3" json:"packaging,omitempty"`
+	BaseCost      *Money                 `protobuf:"bytes,6,opt,name=base_cost,json=baseCost,proto3" json:"base_cost,omitempty"`
+	PackagingCost *Money                 `protobuf:"bytes,7,opt,name=packaging_cost,json=packagingCost,proto3" json:"packaging_cost,omitempty"`
+	TotalCost     *Money                 `protobuf:"bytes,8,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendPackagingResponse) Reset() {
+	*x = RecommendPackagingResponse{}
+	mi := &file_order_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendPackagingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendPackagingResponse) ProtoMessage() {}
+
+func (x *RecommendPackagingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendPackagingResponse.ProtoReflect.Descriptor instead.
+func (*RecommendPackagingResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RecommendPackagingResponse) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *RecommendPackagingResponse) GetPackaging() []*PackagingLayer {
+	if x != nil {
+		return x.Packaging
+	}
+	return nil
+}
+
+func (x *RecommendPackagingResponse) GetBaseCost() *Money {
+	if x != nil {
+		return x.BaseCost
+	}
+	return nil
+}
+
+func (x *RecommendPackagingResponse) GetPackagingCost() *Money {
+	if x != nil {
+		return x.PackagingCost
+	}
+	return nil
+}
+
+func (x *RecommendPackagingResponse) GetTotalCost() *Money {
+	if x != nil {
+		return x.TotalCost
+	}
+	return nil
+}
+
+// PickupPoint working hours are "15:04" times in the point timezone.
+type PickupPoint struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address             string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Timezone            string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	OpensAt             string                 `protobuf:"bytes,5,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`
+	ClosesAt            string                 `protobuf:"bytes,6,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	OrderExpirationDays int32                  `protobuf:"varint,7,opt,name=order_expiration_days,json=orderExpirationDays,proto3" json:"order_expiration_days,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PickupPoint) Reset() {
+	*x = PickupPoint{}
+	mi := &file_order_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupPoint) ProtoMessage() {}
+
+func (x *PickupPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupPoint.ProtoReflect.Descriptor instead.
+func (*PickupPoint) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PickupPoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PickupPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetOpensAt() string {
+	if x != nil {
+		return x.OpensAt
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetClosesAt() string {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return ""
+}
+
+func (x *PickupPoint) GetOrderExpirationDays() int32 {
+	if x != nil {
+		return x.OrderExpirationDays
+	}
+	return 0
+}
+
+type CreatePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupPointRequest) Reset() {
+	*x = CreatePickupPointRequest{}
+	mi := &file_order_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupPointRequest) ProtoMessage() {}
+
+func (x *CreatePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreatePickupPointRequest) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type CreatePickupPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePickupPointResponse) Reset() {
+	*x = CreatePickupPointResponse{}
+	mi := &file_order_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupPointResponse) ProtoMessage() {}
+
+func (x *CreatePickupPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupPointResponse.ProtoReflect.Descriptor instead.
+func (*CreatePickupPointResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreatePickupPointResponse) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type GetPickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupPointRequest) Reset() {
+	*x = GetPickupPointRequest{}
+	mi := &file_order_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupPointRequest) ProtoMessage() {}
+
+func (x *GetPickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupPointRequest.ProtoReflect.Descriptor instead.
+func (*GetPickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPickupPointRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPickupPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupPointResponse) Reset() {
+	*x = GetPickupPointResponse{}
+	mi := &file_order_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupPointResponse) ProtoMessage() {}
+
+func (x *GetPickupPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupPointResponse.ProtoReflect.Descriptor instead.
+func (*GetPickupPointResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPickupPointResponse) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type ListPickupPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupPointsRequest) Reset() {
+	*x = ListPickupPointsRequest{}
+	mi := &file_order_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupPointsRequest) ProtoMessage() {}
+
+func (x *ListPickupPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupPointsRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupPointsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{32}
+}
+
+type ListPickupPointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoints  []*PickupPoint         `protobuf:"bytes,1,rep,name=pickup_points,json=pickupPoints,proto3" json:"pickup_points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPickupPointsResponse) Reset() {
+	*x = ListPickupPointsResponse{}
+	mi := &file_order_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupPointsResponse) ProtoMessage() {}
+
+func (x *ListPickupPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupPointsResponse.ProtoReflect.Descriptor instead.
+func (*ListPickupPointsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPickupPointsResponse) GetPickupPoints() []*PickupPoint {
+	if x != nil {
+		return x.PickupPoints
+	}
+	return nil
+}
+
+type UpdatePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePickupPointRequest) Reset() {
+	*x = UpdatePickupPointRequest{}
+	mi := &file_order_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePickupPointRequest) ProtoMessage() {}
+
+func (x *UpdatePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdatePickupPointRequest) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type UpdatePickupPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPoint   *PickupPoint           `protobuf:"bytes,1,opt,name=pickup_point,json=pickupPoint,proto3" json:"pickup_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePickupPointResponse) Reset() {
+	*x = UpdatePickupPointResponse{}
+	mi := &file_order_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePickupPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePickupPointResponse) ProtoMessage() {}
+
+func (x *UpdatePickupPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePickupPointResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePickupPointResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdatePickupPointResponse) GetPickupPoint() *PickupPoint {
+	if x != nil {
+		return x.PickupPoint
+	}
+	return nil
+}
+
+type DeletePickupPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePickupPointRequest) Reset() {
+	*x = DeletePickupPointRequest{}
+	mi := &file_order_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePickupPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePickupPointRequest) ProtoMessage() {}
+
+func (x *DeletePickupPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePickupPointRequest.ProtoReflect.Descriptor instead.
+func (*DeletePickupPointRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeletePickupPointRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePickupPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePickupPointResponse) Reset() {
+	*x = DeletePickupPointResponse{}
+	mi := &file_order_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePickupPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePickupPointResponse) ProtoMessage() {}
+
+func (x *DeletePickupPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePickupPointResponse.ProtoReflect.Descriptor instead.
+func (*DeletePickupPointResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{37}
+}
+
+// StorageCell limits of zero mean no limit. order_id is zero while the cell is free.
+type StorageCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	MaxWeight     int32                  `protobuf:"varint,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	MaxVolume     int32                  `protobuf:"varint,5,opt,name=max_volume,json=maxVolume,proto3" json:"max_volume,omitempty"`
+	MaxLength     int32                  `protobuf:"varint,6,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	OrderId       int64                  `protobuf:"varint,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageCell) Reset() {
+	*x = StorageCell{}
+	mi := &file_order_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCell) ProtoMessage() {}
+
+func (x *StorageCell) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCell.ProtoReflect.Descriptor instead.
+func (*StorageCell) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StorageCell) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StorageCell) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *StorageCell) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *StorageCell) GetMaxWeight() int32 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *StorageCell) GetMaxVolume() int32 {
+	if x != nil {
+		return x.MaxVolume
+	}
+	return 0
+}
+
+func (x *StorageCell) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *StorageCell) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type CreateStorageCellRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorageCell   *StorageCell           `protobuf:"bytes,1,opt,name=storage_cell,json=storageCell,proto3" json:"storage_cell,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStorageCellRequest) Reset() {
+	*x = CreateStorageCellRequest{}
+	mi := &file_order_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStorageCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStorageCellRequest) ProtoMessage() {}
+
+func (x *CreateStorageCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStorageCellRequest.ProtoReflect.Descriptor instead.
+func (*CreateStorageCellRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateStorageCellRequest) GetStorageCell() *StorageCell {
+	if x != nil {
+		return x.StorageCell
+	}
+	return nil
+}
+
+type CreateStorageCellResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorageCell   *StorageCell           `protobuf:"bytes,1,opt,name=storage_cell,json=storageCell,proto3" json:"storage_cell,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStorageCellResponse) Reset() {
+	*x = CreateStorageCellResponse{}
+	mi := &file_order_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStorageCellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStorageCellResponse) ProtoMessage() {}
+
+func (x *CreateStorageCellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStorageCellResponse.ProtoReflect.Descriptor instead.
+func (*CreateStorageCellResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateStorageCellResponse) GetStorageCell() *StorageCell {
+	if x != nil {
+		return x.StorageCell
+	}
+	return nil
+}
+
+type ListStorageCellsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageCellsRequest) Reset() {
+	*x = ListStorageCellsRequest{}
+	mi := &file_order_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageCellsRequest) ProtoMessage() {}
+
+func (x *ListStorageCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageCellsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorageCellsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListStorageCellsRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+type ListStorageCellsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StorageCells  []*StorageCell         `protobuf:"bytes,1,rep,name=storage_cells,json=storageCells,proto3" json:"storage_cells,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStorageCellsResponse) Reset() {
+	*x = ListStorageCellsResponse{}
+	mi := &file_order_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStorageCellsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageCellsResponse) ProtoMessage() {}
+
+func (x *ListStorageCellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageCellsResponse.ProtoReflect.Descriptor instead.
+func (*ListStorageCellsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListStorageCellsResponse) GetStorageCells() []*StorageCell {
+	if x != nil {
+		return x.StorageCells
+	}
+	return nil
+}
+
+type StorageOccupancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Occupied      int32                  `protobuf:"varint,3,opt,name=occupied,proto3" json:"occupied,omitempty"`
+	FillRatio     float64                `protobuf:"fixed64,4,opt,name=fill_ratio,json=fillRatio,proto3" json:"fill_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageOccupancy) Reset() {
+	*x = StorageOccupancy{}
+	mi := &file_order_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageOccupancy) ProtoMessage() {}
+
+func (x *StorageOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageOccupancy.ProtoReflect.Descriptor instead.
+func (*StorageOccupancy) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StorageOccupancy) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *StorageOccupancy) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StorageOccupancy) GetOccupied() int32 {
+	if x != nil {
+		return x.Occupied
+	}
+	return 0
+}
+
+func (x *StorageOccupancy) GetFillRatio() float64 {
+	if x != nil {
+		return x.FillRatio
+	}
+	return 0
+}
+
+type GetStorageOccupancyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageOccupancyRequest) Reset() {
+	*x = GetStorageOccupancyRequest{}
+	mi := &file_order_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageOccupancyRequest) ProtoMessage() {}
+
+func (x *GetStorageOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{44}
+}
+
+type GetStorageOccupancyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Occupancy     []*StorageOccupancy    `protobuf:"bytes,1,rep,name=occupancy,proto3" json:"occupancy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageOccupancyResponse) Reset() {
+	*x = GetStorageOccupancyResponse{}
+	mi := &file_order_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageOccupancyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageOccupancyResponse) ProtoMessage() {}
+
+func (x *GetStorageOccupancyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageOccupancyResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageOccupancyResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetStorageOccupancyResponse) GetOccupancy() []*StorageOccupancy {
+	if x != nil {
+		return x.Occupancy
+	}
+	return nil
+}
+
+// IssuePickupCodeRequest is made by the supervisor authenticated with the
+// x-supervisor-token metadata for the owner of the order, user_id, whose
+// identity the supervisor checked.
+type IssuePickupCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuePickupCodeRequest) Reset() {
+	*x = IssuePickupCodeRequest{}
+	mi := &file_order_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuePickupCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePickupCodeRequest) ProtoMessage() {}
+
+func (x *IssuePickupCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePickupCodeRequest.ProtoReflect.Descriptor instead.
+func (*IssuePickupCodeRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *IssuePickupCodeRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *IssuePickupCodeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type IssuePickupCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupCode    string                 `protobuf:"bytes,1,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuePickupCodeResponse) Reset() {
+	*x = IssuePickupCodeResponse{}
+	mi := &file_order_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuePickupCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePickupCodeResponse) ProtoMessage() {}
+
+func (x *IssuePickupCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePickupCodeResponse.ProtoReflect.Descriptor instead.
+func (*IssuePickupCodeResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *IssuePickupCodeResponse) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+type PickupItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PickupCode     string                 `protobuf:"bytes,2,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	StorageFeePaid bool                   `protobuf:"varint,3,opt,name=storage_fee_paid,json=storageFeePaid,proto3" json:"storage_fee_paid,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PickupItem) Reset() {
+	*x = PickupItem{}
+	mi := &file_order_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupItem) ProtoMessage() {}
+
+func (x *PickupItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupItem.ProtoReflect.Descriptor instead.
+func (*PickupItem) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PickupItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *PickupItem) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+func (x *PickupItem) GetStorageFeePaid() bool {
+	if x != nil {
+		return x.StorageFeePaid
+	}
+	return false
+}
+
+type CompleteOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Orders        []*PickupItem          `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOrdersRequest) Reset() {
+	*x = CompleteOrdersRequest{}
+	mi := &file_order_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOrdersRequest) ProtoMessage() {}
+
+func (x *CompleteOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CompleteOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompleteOrdersRequest) GetOrders() []*PickupItem {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *CompleteOrdersRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type BatchOrderResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Ok            bool                   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOrderResult) Reset() {
+	*x = BatchOrderResult{}
+	mi := &file_order_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOrderResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOrderResult) ProtoMessage() {}
+
+func (x *BatchOrderResult) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOrderResult.ProtoReflect.Descriptor instead.
+func (*BatchOrderResult) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchOrderResult) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *BatchOrderResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *BatchOrderResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CompleteOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchOrderResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Totals        []*Money               `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOrdersResponse) Reset() {
+	*x = CompleteOrdersResponse{}
+	mi := &file_order_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOrdersResponse) ProtoMessage() {}
+
+func (x *CompleteOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CompleteOrdersResponse) GetResults() []*BatchOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CompleteOrdersResponse) GetTotals() []*Money {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type RefundOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderIds      []int64                `protobuf:"varint,2,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	RefundReason  string                 `protobuf:"bytes,4,opt,name=refund_reason,json=refundReason,proto3" json:"refund_reason,omitempty"`
+	RefundComment string                 `protobuf:"bytes,5,opt,name=refund_comment,json=refundComment,proto3" json:"refund_comment,omitempty"`
+	ItemCondition string                 `protobuf:"bytes,6,opt,name=item_condition,json=itemCondition,proto3" json:"item_condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrdersRequest) Reset() {
+	*x = RefundOrdersRequest{}
+	mi := &file_order_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrdersRequest) ProtoMessage() {}
+
+func (x *RefundOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrdersRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RefundOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefundOrdersRequest) GetOrderIds() []int64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *RefundOrdersRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *RefundOrdersRequest) GetRefundReason() string {
+	if x != nil {
+		return x.RefundReason
+	}
+	return ""
+}
+
+func (x *RefundOrdersRequest) GetRefundComment() string {
+	if x != nil {
+		return x.RefundComment
+	}
+	return ""
+}
+
+func (x *RefundOrdersRequest) GetItemCondition() string {
+	if x != nil {
+		return x.ItemCondition
+	}
+	return ""
+}
+
+type RefundOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchOrderResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Totals        []*Money               `protobuf:"bytes,2,rep,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundOrdersResponse) Reset() {
+	*x = RefundOrdersResponse{}
+	mi := &file_order_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundOrdersResponse) ProtoMessage() {}
+
+func (x *RefundOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundOrdersResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RefundOrdersResponse) GetResults() []*BatchOrderResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *RefundOrdersResponse) GetTotals() []*Money {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type RefundReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundReason) Reset() {
+	*x = RefundReason{}
+	mi := &file_order_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundReason) ProtoMessage() {}
+
+func (x *RefundReason) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundReason.ProtoReflect.Descriptor instead.
+func (*RefundReason) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RefundReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RefundReason) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListRefundReasonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundReasonsRequest) Reset() {
+	*x = ListRefundReasonsRequest{}
+	mi := &file_order_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundReasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundReasonsRequest) ProtoMessage() {}
+
+func (x *ListRefundReasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundReasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundReasonsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{55}
+}
+
+type ListRefundReasonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reasons       []*RefundReason        `protobuf:"bytes,1,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundReasonsResponse) Reset() {
+	*x = ListRefundReasonsResponse{}
+	mi := &file_order_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundReasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundReasonsResponse) ProtoMessage() {}
+
+func (x *ListRefundReasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundReasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundReasonsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListRefundReasonsResponse) GetReasons() []*RefundReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type ArchivedOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivedOrder) Reset() {
+	*x = ArchivedOrder{}
+	mi := &file_order_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivedOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedOrder) ProtoMessage() {}
+
+func (x *ArchivedOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedOrder.ProtoReflect.Descriptor instead.
+func (*ArchivedOrder) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ArchivedOrder) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ArchivedOrder) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type SearchArchivedOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,3,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	ArchivedFrom  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_from,json=archivedFrom,proto3" json:"archived_from,omitempty"`
+	ArchivedTo    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_to,json=archivedTo,proto3" json:"archived_to,omitempty"`
+	LastId        int64                  `protobuf:"varint,6,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArchivedOrdersRequest) Reset() {
+	*x = SearchArchivedOrdersRequest{}
+	mi := &file_order_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArchivedOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArchivedOrdersRequest) ProtoMessage() {}
+
+func (x *SearchArchivedOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArchivedOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SearchArchivedOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchArchivedOrdersRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *SearchArchivedOrdersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchArchivedOrdersRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *SearchArchivedOrdersRequest) GetArchivedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedFrom
+	}
+	return nil
+}
+
+func (x *SearchArchivedOrdersRequest) GetArchivedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedTo
+	}
+	return nil
+}
+
+func (x *SearchArchivedOrdersRequest) GetLastId() int64 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *SearchArchivedOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchArchivedOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*ArchivedOrder       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArchivedOrdersResponse) Reset() {
+	*x = SearchArchivedOrdersResponse{}
+	mi := &file_order_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArchivedOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArchivedOrdersResponse) ProtoMessage() {}
+
+func (x *SearchArchivedOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArchivedOrdersResponse.ProtoReflect.Descriptor instead.
+func (*SearchArchivedOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchArchivedOrdersResponse) GetOrders() []*ArchivedOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type ReturnManifestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderStatus   string                 `protobuf:"bytes,3,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnManifestItem) Reset() {
+	*x = ReturnManifestItem{}
+	mi := &file_order_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnManifestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnManifestItem) ProtoMessage() {}
+
+func (x *ReturnManifestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnManifestItem.ProtoReflect.Descriptor instead.
+func (*ReturnManifestItem) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReturnManifestItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReturnManifestItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReturnManifestItem) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+func (x *ReturnManifestItem) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+// ReturnManifest status is "open" or "closed".
+type ReturnManifest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	CourierName   string                 `protobuf:"bytes,3,opt,name=courier_name,json=courierName,proto3" json:"courier_name,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Items         []*ReturnManifestItem  `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnManifest) Reset() {
+	*x = ReturnManifest{}
+	mi := &file_order_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnManifest) ProtoMessage() {}
+
+func (x *ReturnManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnManifest.ProtoReflect.Descriptor instead.
+func (*ReturnManifest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReturnManifest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReturnManifest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ReturnManifest) GetCourierName() string {
+	if x != nil {
+		return x.CourierName
+	}
+	return ""
+}
+
+func (x *ReturnManifest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReturnManifest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReturnManifest) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *ReturnManifest) GetItems() []*ReturnManifestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CreateReturnManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	CourierName   string                 `protobuf:"bytes,2,opt,name=courier_name,json=courierName,proto3" json:"courier_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnManifestRequest) Reset() {
+	*x = CreateReturnManifestRequest{}
+	mi := &file_order_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnManifestRequest) ProtoMessage() {}
+
+func (x *CreateReturnManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnManifestRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnManifestRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateReturnManifestRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *CreateReturnManifestRequest) GetCourierName() string {
+	if x != nil {
+		return x.CourierName
+	}
+	return ""
+}
+
+type CreateReturnManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *ReturnManifest        `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReturnManifestResponse) Reset() {
+	*x = CreateReturnManifestResponse{}
+	mi := &file_order_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnManifestResponse) ProtoMessage() {}
+
+func (x *CreateReturnManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnManifestResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnManifestResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateReturnManifestResponse) GetManifest() *ReturnManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type GetReturnManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnManifestRequest) Reset() {
+	*x = GetReturnManifestRequest{}
+	mi := &file_order_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnManifestRequest) ProtoMessage() {}
+
+func (x *GetReturnManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnManifestRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnManifestRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetReturnManifestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetReturnManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *ReturnManifest        `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReturnManifestResponse) Reset() {
+	*x = GetReturnManifestResponse{}
+	mi := &file_order_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnManifestResponse) ProtoMessage() {}
+
+func (x *GetReturnManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnManifestResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnManifestResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetReturnManifestResponse) GetManifest() *ReturnManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type ListReturnManifestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnManifestsRequest) Reset() {
+	*x = ListReturnManifestsRequest{}
+	mi := &file_order_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnManifestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnManifestsRequest) ProtoMessage() {}
+
+func (x *ListReturnManifestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnManifestsRequest.ProtoReflect.Descriptor instead.
+func (*ListReturnManifestsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListReturnManifestsRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ListReturnManifestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListReturnManifestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifests     []*ReturnManifest      `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReturnManifestsResponse) Reset() {
+	*x = ListReturnManifestsResponse{}
+	mi := &file_order_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReturnManifestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReturnManifestsResponse) ProtoMessage() {}
+
+func (x *ListReturnManifestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReturnManifestsResponse.ProtoReflect.Descriptor instead.
+func (*ListReturnManifestsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListReturnManifestsResponse) GetManifests() []*ReturnManifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type ScanReturnManifestOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ManifestId    int64                  `protobuf:"varint,1,opt,name=manifest_id,json=manifestId,proto3" json:"manifest_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanReturnManifestOrderRequest) Reset() {
+	*x = ScanReturnManifestOrderRequest{}
+	mi := &file_order_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanReturnManifestOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanReturnManifestOrderRequest) ProtoMessage() {}
+
+func (x *ScanReturnManifestOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanReturnManifestOrderRequest.ProtoReflect.Descriptor instead.
+func (*ScanReturnManifestOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ScanReturnManifestOrderRequest) GetManifestId() int64 {
+	if x != nil {
+		return x.ManifestId
+	}
+	return 0
+}
+
+func (x *ScanReturnManifestOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ScanReturnManifestOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *ReturnManifest        `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanReturnManifestOrderResponse) Reset() {
+	*x = ScanReturnManifestOrderResponse{}
+	mi := &file_order_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanReturnManifestOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanReturnManifestOrderResponse) ProtoMessage() {}
+
+func (x *ScanReturnManifestOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanReturnManifestOrderResponse.ProtoReflect.Descriptor instead.
+func (*ScanReturnManifestOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ScanReturnManifestOrderResponse) GetManifest() *ReturnManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type CloseReturnManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseReturnManifestRequest) Reset() {
+	*x = CloseReturnManifestRequest{}
+	mi := &file_order_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseReturnManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReturnManifestRequest) ProtoMessage() {}
+
+func (x *CloseReturnManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReturnManifestRequest.ProtoReflect.Descriptor instead.
+func (*CloseReturnManifestRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CloseReturnManifestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CloseReturnManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *ReturnManifest        `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseReturnManifestResponse) Reset() {
+	*x = CloseReturnManifestResponse{}
+	mi := &file_order_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseReturnManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReturnManifestResponse) ProtoMessage() {}
+
+func (x *CloseReturnManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReturnManifestResponse.ProtoReflect.Descriptor instead.
+func (*CloseReturnManifestResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CloseReturnManifestResponse) GetManifest() *ReturnManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+// ExportReturnManifestRequest format is "json" (default) or "csv".
+type ExportReturnManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReturnManifestRequest) Reset() {
+	*x = ExportReturnManifestRequest{}
+	mi := &file_order_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReturnManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReturnManifestRequest) ProtoMessage() {}
+
+func (x *ExportReturnManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReturnManifestRequest.ProtoReflect.Descriptor instead.
+func (*ExportReturnManifestRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExportReturnManifestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExportReturnManifestRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportReturnManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReturnManifestResponse) Reset() {
+	*x = ExportReturnManifestResponse{}
+	mi := &file_order_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReturnManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReturnManifestResponse) ProtoMessage() {}
+
+func (x *ExportReturnManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReturnManifestResponse.ProtoReflect.Descriptor instead.
+func (*ExportReturnManifestResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportReturnManifestResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportReturnManifestResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ShipmentItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	Weight         int32                  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Cost           *Money                 `protobuf:"bytes,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	Length         int32                  `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty"`
+	Width          int32                  `protobuf:"varint,7,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShipmentItem) Reset() {
+	*x = ShipmentItem{}
+	mi := &file_order_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentItem) ProtoMessage() {}
+
+func (x *ShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentItem.ProtoReflect.Descriptor instead.
+func (*ShipmentItem) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ShipmentItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ShipmentItem) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShipmentItem) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *ShipmentItem) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ShipmentItem) GetCost() *Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
+}
+
+func (x *ShipmentItem) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ShipmentItem) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ShipmentItem) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// InboundShipment status is "open" or "closed". Scanned lists every scanned
+// order, including the ones the manifest does not expect.
+type InboundShipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Items         []*ShipmentItem        `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	Scanned       []int64                `protobuf:"varint,8,rep,packed,name=scanned,proto3" json:"scanned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboundShipment) Reset() {
+	*x = InboundShipment{}
+	mi := &file_order_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboundShipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboundShipment) ProtoMessage() {}
+
+func (x *InboundShipment) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboundShipment.ProtoReflect.Descriptor instead.
+func (*InboundShipment) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *InboundShipment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboundShipment) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *InboundShipment) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *InboundShipment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InboundShipment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InboundShipment) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *InboundShipment) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InboundShipment) GetScanned() []int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return nil
+}
+
+type RejectedShipmentItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedShipmentItem) Reset() {
+	*x = RejectedShipmentItem{}
+	mi := &file_order_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedShipmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedShipmentItem) ProtoMessage() {}
+
+func (x *RejectedShipmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedShipmentItem.ProtoReflect.Descriptor instead.
+func (*RejectedShipmentItem) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RejectedShipmentItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RejectedShipmentItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ShipmentReport struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ShipmentId    int64                   `protobuf:"varint,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	Received      []int64                 `protobuf:"varint,2,rep,packed,name=received,proto3" json:"received,omitempty"`
+	Missing       []int64                 `protobuf:"varint,3,rep,packed,name=missing,proto3" json:"missing,omitempty"`
+	Unexpected    []int64                 `protobuf:"varint,4,rep,packed,name=unexpected,proto3" json:"unexpected,omitempty"`
+	Rejected      []*RejectedShipmentItem `protobuf:"bytes,5,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentReport) Reset() {
+	*x = ShipmentReport{}
+	mi := &file_order_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentReport) ProtoMessage() {}
+
+func (x *ShipmentReport) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentReport.ProtoReflect.Descriptor instead.
+func (*ShipmentReport) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ShipmentReport) GetShipmentId() int64 {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return 0
+}
+
+func (x *ShipmentReport) GetReceived() []int64 {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *ShipmentReport) GetMissing() []int64 {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *ShipmentReport) GetUnexpected() []int64 {
+	if x != nil {
+		return x.Unexpected
+	}
+	return nil
+}
+
+func (x *ShipmentReport) GetRejected() []*RejectedShipmentItem {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *ShipmentReport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RegisterShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Items         []*ShipmentItem        `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterShipmentRequest) Reset() {
+	*x = RegisterShipmentRequest{}
+	mi := &file_order_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterShipmentRequest) ProtoMessage() {}
+
+func (x *RegisterShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterShipmentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RegisterShipmentRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *RegisterShipmentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *RegisterShipmentRequest) GetItems() []*ShipmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RegisterShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *InboundShipment       `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterShipmentResponse) Reset() {
+	*x = RegisterShipmentResponse{}
+	mi := &file_order_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterShipmentResponse) ProtoMessage() {}
+
+func (x *RegisterShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterShipmentResponse.ProtoReflect.Descriptor instead.
+func (*RegisterShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RegisterShipmentResponse) GetShipment() *InboundShipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type GetShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentRequest) Reset() {
+	*x = GetShipmentRequest{}
+	mi := &file_order_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentRequest) ProtoMessage() {}
+
+func (x *GetShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentRequest.ProtoReflect.Descriptor instead.
+func (*GetShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetShipmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *InboundShipment       `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentResponse) Reset() {
+	*x = GetShipmentResponse{}
+	mi := &file_order_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentResponse) ProtoMessage() {}
+
+func (x *GetShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentResponse.ProtoReflect.Descriptor instead.
+func (*GetShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetShipmentResponse) GetShipment() *InboundShipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type ListShipmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsRequest) Reset() {
+	*x = ListShipmentsRequest{}
+	mi := &file_order_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsRequest) ProtoMessage() {}
+
+func (x *ListShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListShipmentsRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ListShipmentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*InboundShipment     `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsResponse) Reset() {
+	*x = ListShipmentsResponse{}
+	mi := &file_order_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsResponse) ProtoMessage() {}
+
+func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListShipmentsResponse) GetShipments() []*InboundShipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type ScanShipmentOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipmentId    int64                  `protobuf:"varint,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanShipmentOrderRequest) Reset() {
+	*x = ScanShipmentOrderRequest{}
+	mi := &file_order_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanShipmentOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanShipmentOrderRequest) ProtoMessage() {}
+
+func (x *ScanShipmentOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanShipmentOrderRequest.ProtoReflect.Descriptor instead.
+func (*ScanShipmentOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ScanShipmentOrderRequest) GetShipmentId() int64 {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return 0
+}
+
+func (x *ScanShipmentOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ScanShipmentOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipment      *InboundShipment       `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanShipmentOrderResponse) Reset() {
+	*x = ScanShipmentOrderResponse{}
+	mi := &file_order_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanShipmentOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanShipmentOrderResponse) ProtoMessage() {}
+
+func (x *ScanShipmentOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanShipmentOrderResponse.ProtoReflect.Descriptor instead.
+func (*ScanShipmentOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ScanShipmentOrderResponse) GetShipment() *InboundShipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type CloseShipmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseShipmentRequest) Reset() {
+	*x = CloseShipmentRequest{}
+	mi := &file_order_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShipmentRequest) ProtoMessage() {}
+
+func (x *CloseShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CloseShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CloseShipmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CloseShipmentResponse carries the pickup codes of the received orders,
+// they are shown only here.
+type CloseShipmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ShipmentReport        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	PickupCodes   []*IssuedPickupCode    `protobuf:"bytes,2,rep,name=pickup_codes,json=pickupCodes,proto3" json:"pickup_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseShipmentResponse) Reset() {
+	*x = CloseShipmentResponse{}
+	mi := &file_order_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShipmentResponse) ProtoMessage() {}
+
+func (x *CloseShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CloseShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CloseShipmentResponse) GetReport() *ShipmentReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *CloseShipmentResponse) GetPickupCodes() []*IssuedPickupCode {
+	if x != nil {
+		return x.PickupCodes
+	}
+	return nil
+}
+
+type IssuedPickupCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PickupCode    string                 `protobuf:"bytes,2,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuedPickupCode) Reset() {
+	*x = IssuedPickupCode{}
+	mi := &file_order_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuedPickupCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedPickupCode) ProtoMessage() {}
+
+func (x *IssuedPickupCode) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedPickupCode.ProtoReflect.Descriptor instead.
+func (*IssuedPickupCode) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *IssuedPickupCode) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *IssuedPickupCode) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+type GetShipmentReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentReportRequest) Reset() {
+	*x = GetShipmentReportRequest{}
+	mi := &file_order_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentReportRequest) ProtoMessage() {}
+
+func (x *GetShipmentReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentReportRequest.ProtoReflect.Descriptor instead.
+func (*GetShipmentReportRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetShipmentReportRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetShipmentReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ShipmentReport        `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentReportResponse) Reset() {
+	*x = GetShipmentReportResponse{}
+	mi := &file_order_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentReportResponse) ProtoMessage() {}
+
+func (x *GetShipmentReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentReportResponse.ProtoReflect.Descriptor instead.
+func (*GetShipmentReportResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetShipmentReportResponse) GetReport() *ShipmentReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// StocktakingResult lists stored orders missing from the shelves and parcels
+// found on the shelves that are not stored at the point.
+type StocktakingResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expected      int32                  `protobuf:"varint,1,opt,name=expected,proto3" json:"expected,omitempty"`
+	Found         int32                  `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Missing       []int64                `protobuf:"varint,3,rep,packed,name=missing,proto3" json:"missing,omitempty"`
+	Unknown       []int64                `protobuf:"varint,4,rep,packed,name=unknown,proto3" json:"unknown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StocktakingResult) Reset() {
+	*x = StocktakingResult{}
+	mi := &file_order_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakingResult) ProtoMessage() {}
+
+func (x *StocktakingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakingResult.ProtoReflect.Descriptor instead.
+func (*StocktakingResult) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *StocktakingResult) GetExpected() int32 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *StocktakingResult) GetFound() int32 {
+	if x != nil {
+		return x.Found
+	}
+	return 0
+}
+
+func (x *StocktakingResult) GetMissing() []int64 {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *StocktakingResult) GetUnknown() []int64 {
+	if x != nil {
+		return x.Unknown
+	}
+	return nil
+}
+
+// StocktakingSession status is "open" or "finished", result is set once the
+// session is finished.
+type StocktakingSession struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	ScannedCount  int32                  `protobuf:"varint,7,opt,name=scanned_count,json=scannedCount,proto3" json:"scanned_count,omitempty"`
+	Result        *StocktakingResult     `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StocktakingSession) Reset() {
+	*x = StocktakingSession{}
+	mi := &file_order_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StocktakingSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StocktakingSession) ProtoMessage() {}
+
+func (x *StocktakingSession) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StocktakingSession.ProtoReflect.Descriptor instead.
+func (*StocktakingSession) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *StocktakingSession) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StocktakingSession) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *StocktakingSession) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *StocktakingSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StocktakingSession) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *StocktakingSession) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *StocktakingSession) GetScannedCount() int32 {
+	if x != nil {
+		return x.ScannedCount
+	}
+	return 0
+}
+
+func (x *StocktakingSession) GetResult() *StocktakingResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type StartStocktakingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStocktakingRequest) Reset() {
+	*x = StartStocktakingRequest{}
+	mi := &file_order_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStocktakingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStocktakingRequest) ProtoMessage() {}
+
+func (x *StartStocktakingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStocktakingRequest.ProtoReflect.Descriptor instead.
+func (*StartStocktakingRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *StartStocktakingRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *StartStocktakingRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type StartStocktakingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *StocktakingSession    `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStocktakingResponse) Reset() {
+	*x = StartStocktakingResponse{}
+	mi := &file_order_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStocktakingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStocktakingResponse) ProtoMessage() {}
+
+func (x *StartStocktakingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStocktakingResponse.ProtoReflect.Descriptor instead.
+func (*StartStocktakingResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *StartStocktakingResponse) GetSession() *StocktakingSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// ScanStocktakingRequest is one scanned parcel, every message of a stream
+// belongs to the same session.
+type ScanStocktakingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     int64                  `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanStocktakingRequest) Reset() {
+	*x = ScanStocktakingRequest{}
+	mi := &file_order_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanStocktakingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanStocktakingRequest) ProtoMessage() {}
+
+func (x *ScanStocktakingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanStocktakingRequest.ProtoReflect.Descriptor instead.
+func (*ScanStocktakingRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ScanStocktakingRequest) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *ScanStocktakingRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ScanStocktakingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *StocktakingSession    `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanStocktakingResponse) Reset() {
+	*x = ScanStocktakingResponse{}
+	mi := &file_order_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanStocktakingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanStocktakingResponse) ProtoMessage() {}
+
+func (x *ScanStocktakingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanStocktakingResponse.ProtoReflect.Descriptor instead.
+func (*ScanStocktakingResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ScanStocktakingResponse) GetSession() *StocktakingSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type FinishStocktakingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishStocktakingRequest) Reset() {
+	*x = FinishStocktakingRequest{}
+	mi := &file_order_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishStocktakingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishStocktakingRequest) ProtoMessage() {}
+
+func (x *FinishStocktakingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishStocktakingRequest.ProtoReflect.Descriptor instead.
+func (*FinishStocktakingRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *FinishStocktakingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FinishStocktakingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *StocktakingSession    `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishStocktakingResponse) Reset() {
+	*x = FinishStocktakingResponse{}
+	mi := &file_order_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishStocktakingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishStocktakingResponse) ProtoMessage() {}
+
+func (x *FinishStocktakingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishStocktakingResponse.ProtoReflect.Descriptor instead.
+func (*FinishStocktakingResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *FinishStocktakingResponse) GetSession() *StocktakingSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type GetStocktakingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStocktakingRequest) Reset() {
+	*x = GetStocktakingRequest{}
+	mi := &file_order_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStocktakingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStocktakingRequest) ProtoMessage() {}
+
+func (x *GetStocktakingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStocktakingRequest.ProtoReflect.Descriptor instead.
+func (*GetStocktakingRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetStocktakingRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetStocktakingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *StocktakingSession    `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStocktakingResponse) Reset() {
+	*x = GetStocktakingResponse{}
+	mi := &file_order_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStocktakingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStocktakingResponse) ProtoMessage() {}
+
+func (x *GetStocktakingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStocktakingResponse.ProtoReflect.Descriptor instead.
+func (*GetStocktakingResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetStocktakingResponse) GetSession() *StocktakingSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type ListStocktakingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStocktakingsRequest) Reset() {
+	*x = ListStocktakingsRequest{}
+	mi := &file_order_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStocktakingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStocktakingsRequest) ProtoMessage() {}
+
+func (x *ListStocktakingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStocktakingsRequest.ProtoReflect.Descriptor instead.
+func (*ListStocktakingsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListStocktakingsRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *ListStocktakingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListStocktakingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*StocktakingSession  `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStocktakingsResponse) Reset() {
+	*x = ListStocktakingsResponse{}
+	mi := &file_order_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStocktakingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStocktakingsResponse) ProtoMessage() {}
+
+func (x *ListStocktakingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStocktakingsResponse.ProtoReflect.Descriptor instead.
+func (*ListStocktakingsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListStocktakingsResponse) GetSessions() []*StocktakingSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Incident type is "lost" or "damaged", status is "open", "resolved" or
+// "cancelled". Outcome is "found", "compensated" or "written_off" once the
+// incident is resolved. Order status is the status the order had when it was
+// blocked.
+type Incident struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	OrderStatus   string                 `protobuf:"bytes,7,opt,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty"`
+	Outcome       string                 `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Resolution    string                 `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolvedBy    string                 `protobuf:"bytes,10,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Incident) Reset() {
+	*x = Incident{}
+	mi := &file_order_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Incident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Incident) ProtoMessage() {}
+
+func (x *Incident) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Incident.ProtoReflect.Descriptor instead.
+func (*Incident) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Incident) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Incident) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Incident) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Incident) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Incident) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Incident) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Incident) GetOrderStatus() string {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return ""
+}
+
+func (x *Incident) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *Incident) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *Incident) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *Incident) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Incident) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Incident) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type OpenIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenIncidentRequest) Reset() {
+	*x = OpenIncidentRequest{}
+	mi := &file_order_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenIncidentRequest) ProtoMessage() {}
+
+func (x *OpenIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenIncidentRequest.ProtoReflect.Descriptor instead.
+func (*OpenIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *OpenIncidentRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OpenIncidentRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OpenIncidentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OpenIncidentRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type OpenIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenIncidentResponse) Reset() {
+	*x = OpenIncidentResponse{}
+	mi := &file_order_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenIncidentResponse) ProtoMessage() {}
+
+func (x *OpenIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenIncidentResponse.ProtoReflect.Descriptor instead.
+func (*OpenIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *OpenIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type GetIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncidentRequest) Reset() {
+	*x = GetIncidentRequest{}
+	mi := &file_order_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentRequest) ProtoMessage() {}
+
+func (x *GetIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentRequest.ProtoReflect.Descriptor instead.
+func (*GetIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetIncidentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncidentResponse) Reset() {
+	*x = GetIncidentResponse{}
+	mi := &file_order_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncidentResponse) ProtoMessage() {}
+
+func (x *GetIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncidentResponse.ProtoReflect.Descriptor instead.
+func (*GetIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type ListIncidentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsRequest) Reset() {
+	*x = ListIncidentsRequest{}
+	mi := &file_order_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsRequest) ProtoMessage() {}
+
+func (x *ListIncidentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncidentsRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListIncidentsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ListIncidentsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListIncidentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListIncidentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incidents     []*Incident            `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncidentsResponse) Reset() {
+	*x = ListIncidentsResponse{}
+	mi := &file_order_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncidentsResponse) ProtoMessage() {}
+
+func (x *ListIncidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncidentsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncidentsResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListIncidentsResponse) GetIncidents() []*Incident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+// UpdateIncidentRequest changes an open incident, empty fields are kept.
+type UpdateIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIncidentRequest) Reset() {
+	*x = UpdateIncidentRequest{}
+	mi := &file_order_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIncidentRequest) ProtoMessage() {}
+
+func (x *UpdateIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIncidentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateIncidentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateIncidentRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateIncidentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIncidentResponse) Reset() {
+	*x = UpdateIncidentResponse{}
+	mi := &file_order_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIncidentResponse) ProtoMessage() {}
+
+func (x *UpdateIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIncidentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type ResolveIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Outcome       string                 `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Resolution    string                 `protobuf:"bytes,3,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIncidentRequest) Reset() {
+	*x = ResolveIncidentRequest{}
+	mi := &file_order_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIncidentRequest) ProtoMessage() {}
+
+func (x *ResolveIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIncidentRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ResolveIncidentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveIncidentRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *ResolveIncidentRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *ResolveIncidentRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ResolveIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIncidentResponse) Reset() {
+	*x = ResolveIncidentResponse{}
+	mi := &file_order_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIncidentResponse) ProtoMessage() {}
+
+func (x *ResolveIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIncidentResponse.ProtoReflect.Descriptor instead.
+func (*ResolveIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ResolveIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+type CancelIncidentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelIncidentRequest) Reset() {
+	*x = CancelIncidentRequest{}
+	mi := &file_order_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelIncidentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelIncidentRequest) ProtoMessage() {}
+
+func (x *CancelIncidentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelIncidentRequest.ProtoReflect.Descriptor instead.
+func (*CancelIncidentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CancelIncidentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelIncidentRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+func (x *CancelIncidentRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type CancelIncidentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelIncidentResponse) Reset() {
+	*x = CancelIncidentResponse{}
+	mi := &file_order_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelIncidentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelIncidentResponse) ProtoMessage() {}
+
+func (x *CancelIncidentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelIncidentResponse.ProtoReflect.Descriptor instead.
+func (*CancelIncidentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CancelIncidentResponse) GetIncident() *Incident {
+	if x != nil {
+		return x.Incident
+	}
+	return nil
+}
+
+// StorageTariff charges daily_rate for every started day beyond free_days.
+// Zero pickup_point_id is the global tariff.
+type StorageTariff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	FreeDays      int32                  `protobuf:"varint,2,opt,name=free_days,json=freeDays,proto3" json:"free_days,omitempty"`
+	DailyRate     *Money                 `protobuf:"bytes,3,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageTariff) Reset() {
+	*x = StorageTariff{}
+	mi := &file_order_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageTariff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageTariff) ProtoMessage() {}
+
+func (x *StorageTariff) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageTariff.ProtoReflect.Descriptor instead.
+func (*StorageTariff) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *StorageTariff) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *StorageTariff) GetFreeDays() int32 {
+	if x != nil {
+		return x.FreeDays
+	}
+	return 0
+}
+
+func (x *StorageTariff) GetDailyRate() *Money {
+	if x != nil {
+		return x.DailyRate
+	}
+	return nil
+}
+
+type GetStorageTariffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageTariffRequest) Reset() {
+	*x = GetStorageTariffRequest{}
+	mi := &file_order_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageTariffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageTariffRequest) ProtoMessage() {}
+
+func (x *GetStorageTariffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageTariffRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageTariffRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetStorageTariffRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+type GetStorageTariffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tariff        *StorageTariff         `protobuf:"bytes,1,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageTariffResponse) Reset() {
+	*x = GetStorageTariffResponse{}
+	mi := &file_order_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageTariffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageTariffResponse) ProtoMessage() {}
+
+func (x *GetStorageTariffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageTariffResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageTariffResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetStorageTariffResponse) GetTariff() *StorageTariff {
+	if x != nil {
+		return x.Tariff
+	}
+	return nil
+}
+
+type SetStorageTariffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	FreeDays      int32                  `protobuf:"varint,2,opt,name=free_days,json=freeDays,proto3" json:"free_days,omitempty"`
+	DailyRate     *Money                 `protobuf:"bytes,3,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStorageTariffRequest) Reset() {
+	*x = SetStorageTariffRequest{}
+	mi := &file_order_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStorageTariffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStorageTariffRequest) ProtoMessage() {}
+
+func (x *SetStorageTariffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStorageTariffRequest.ProtoReflect.Descriptor instead.
+func (*SetStorageTariffRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SetStorageTariffRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *SetStorageTariffRequest) GetFreeDays() int32 {
+	if x != nil {
+		return x.FreeDays
+	}
+	return 0
+}
+
+func (x *SetStorageTariffRequest) GetDailyRate() *Money {
+	if x != nil {
+		return x.DailyRate
+	}
+	return nil
+}
+
+type SetStorageTariffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tariff        *StorageTariff         `protobuf:"bytes,1,opt,name=tariff,proto3" json:"tariff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStorageTariffResponse) Reset() {
+	*x = SetStorageTariffResponse{}
+	mi := &file_order_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStorageTariffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStorageTariffResponse) ProtoMessage() {}
+
+func (x *SetStorageTariffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStorageTariffResponse.ProtoReflect.Descriptor instead.
+func (*SetStorageTariffResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SetStorageTariffResponse) GetTariff() *StorageTariff {
+	if x != nil {
+		return x.Tariff
+	}
+	return nil
+}
+
+type DeleteStorageTariffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupPointId int64                  `protobuf:"varint,1,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStorageTariffRequest) Reset() {
+	*x = DeleteStorageTariffRequest{}
+	mi := &file_order_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStorageTariffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStorageTariffRequest) ProtoMessage() {}
+
+func (x *DeleteStorageTariffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStorageTariffRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStorageTariffRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DeleteStorageTariffRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+type DeleteStorageTariffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStorageTariffResponse) Reset() {
+	*x = DeleteStorageTariffResponse{}
+	mi := &file_order_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStorageTariffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStorageTariffResponse) ProtoMessage() {}
+
+func (x *DeleteStorageTariffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStorageTariffResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStorageTariffResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{122}
+}
+
+type DailyRevenue struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	PickupPointId   int64                  `protobuf:"varint,2,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	OrdersIssued    int32                  `protobuf:"varint,3,opt,name=orders_issued,json=ordersIssued,proto3" json:"orders_issued,omitempty"`
+	OrdersRevenue   *Money                 `protobuf:"bytes,4,opt,name=orders_revenue,json=ordersRevenue,proto3" json:"orders_revenue,omitempty"`
+	StorageFeesPaid int32                  `protobuf:"varint,5,opt,name=storage_fees_paid,json=storageFeesPaid,proto3" json:"storage_fees_paid,omitempty"`
+	StorageFees     *Money                 `protobuf:"bytes,6,opt,name=storage_fees,json=storageFees,proto3" json:"storage_fees,omitempty"`
+	Total           *Money                 `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DailyRevenue) Reset() {
+	*x = DailyRevenue{}
+	mi := &file_order_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyRevenue) ProtoMessage() {}
+
+func (x *DailyRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyRevenue.ProtoReflect.Descriptor instead.
+func (*DailyRevenue) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DailyRevenue) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DailyRevenue) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+func (x *DailyRevenue) GetOrdersIssued() int32 {
+	if x != nil {
+		return x.OrdersIssued
+	}
+	return 0
+}
+
+func (x *DailyRevenue) GetOrdersRevenue() *Money {
+	if x != nil {
+		return x.OrdersRevenue
+	}
+	return nil
+}
+
+func (x *DailyRevenue) GetStorageFeesPaid() int32 {
+	if x != nil {
+		return x.StorageFeesPaid
+	}
+	return 0
+}
+
+func (x *DailyRevenue) GetStorageFees() *Money {
+	if x != nil {
+		return x.StorageFees
+	}
+	return nil
+}
+
+func (x *DailyRevenue) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// GetDailyRevenueRequest reports the UTC days [from, to), zero pickup_point_id
+// reports every point.
+type GetDailyRevenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	PickupPointId int64                  `protobuf:"varint,3,opt,name=pickup_point_id,json=pickupPointId,proto3" json:"pickup_point_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyRevenueRequest) Reset() {
+	*x = GetDailyRevenueRequest{}
+	mi := &file_order_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyRevenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyRevenueRequest) ProtoMessage() {}
+
+func (x *GetDailyRevenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyRevenueRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyRevenueRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetDailyRevenueRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetDailyRevenueRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetDailyRevenueRequest) GetPickupPointId() int64 {
+	if x != nil {
+		return x.PickupPointId
+	}
+	return 0
+}
+
+type GetDailyRevenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*DailyRevenue        `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyRevenueResponse) Reset() {
+	*x = GetDailyRevenueResponse{}
+	mi := &file_order_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyRevenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyRevenueResponse) ProtoMessage() {}
+
+func (x *GetDailyRevenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyRevenueResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyRevenueResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetDailyRevenueResponse) GetDays() []*DailyRevenue {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// DeadLetter is an outbox task that failed every attempt of its retry policy.
+// entry is the audit entry the task publishes as JSON, it is only set by
+// GetDeadLetter.
+type DeadLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType      string                 `protobuf:"bytes,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	EntryId       int64                  `protobuf:"varint,4,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	AttemptsCount int32                  `protobuf:"varint,5,opt,name=attempts_count,json=attemptsCount,proto3" json:"attempts_count,omitempty"`
+	LastError     string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeadAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=dead_at,json=deadAt,proto3" json:"dead_at,omitempty"`
+	Entry         string                 `protobuf:"bytes,9,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_order_service_proto_msgTypes[2]
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_order_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *DeadLetter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*DeadLetter) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[2]
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -195,75 +7252,96 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *Order) GetOrderId() int64 {
+func (x *DeadLetter) GetId() int64 {
 	if x != nil {
-		return x.OrderId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Order) GetUserId() int64 {
+func (x *DeadLetter) GetTaskId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.TaskId
 	}
 	return 0
 }
 
-func (x *Order) GetExpirationTime() *timestamppb.Timestamp {
+func (x *DeadLetter) GetTaskType() string {
 	if x != nil {
-		return x.ExpirationTime
+		return x.TaskType
 	}
-	return nil
+	return ""
 }
 
-func (x *Order) GetStatus() string {
+func (x *DeadLetter) GetEntryId() int64 {
 	if x != nil {
-		return x.Status
+		return x.EntryId
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetWeight() int32 {
+func (x *DeadLetter) GetAttemptsCount() int32 {
 	if x != nil {
-		return x.Weight
+		return x.AttemptsCount
 	}
 	return 0
 }
 
-func (x *Order) GetCost() int32 {
+func (x *DeadLetter) GetLastError() string {
 	if x != nil {
-		return x.Cost
+		return x.LastError
 	}
-	return 0
+	return ""
 }
 
-type GetOrderByIDRequest struct {
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetDeadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetEntry() string {
+	if x != nil {
+		return x.Entry
+	}
+	return ""
+}
+
+type ListDeadLettersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TaskType      string                 `protobuf:"bytes,1,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderByIDRequest) Reset() {
-	*x = GetOrderByIDRequest{}
-	mi := &file_order_service_proto_msgTypes[3]
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	mi := &file_order_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderByIDRequest) String() string {
+func (x *ListDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderByIDRequest) ProtoMessage() {}
+func (*ListDeadLettersRequest) ProtoMessage() {}
 
-func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[3]
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,40 +7352,40 @@ func (x *GetOrderByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderByIDRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetOrderByIDRequest) GetOrderId() int64 {
+func (x *ListDeadLettersRequest) GetTaskType() string {
 	if x != nil {
-		return x.OrderId
+		return x.TaskType
 	}
-	return 0
+	return ""
 }
 
-type GetOrderByIDResponse struct {
+type ListDeadLettersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderByIDResponse) Reset() {
-	*x = GetOrderByIDResponse{}
-	mi := &file_order_service_proto_msgTypes[4]
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	mi := &file_order_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderByIDResponse) String() string {
+func (x *ListDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderByIDResponse) ProtoMessage() {}
+func (*ListDeadLettersResponse) ProtoMessage() {}
 
-func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[4]
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,44 +7396,40 @@ func (x *GetOrderByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderByIDResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetOrderByIDResponse) GetOrder() *Order {
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
 	if x != nil {
-		return x.Order
+		return x.DeadLetters
 	}
 	return nil
 }
 
-type ListOrdersRequest struct {
+type GetDeadLetterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	LastId        int64                  `protobuf:"varint,3,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	SearchTerm    string                 `protobuf:"bytes,5,opt,name=search_term,json=searchTerm,proto3" json:"search_term,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_order_service_proto_msgTypes[5]
+func (x *GetDeadLetterRequest) Reset() {
+	*x = GetDeadLetterRequest{}
+	mi := &file_order_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *GetDeadLetterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*GetDeadLetterRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[5]
+func (x *GetDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,68 +7440,40 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListOrdersRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *ListOrdersRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *ListOrdersRequest) GetLastId() int64 {
-	if x != nil {
-		return x.LastId
-	}
-	return 0
+// Deprecated: Use GetDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *ListOrdersRequest) GetLimit() int32 {
+func (x *GetDeadLetterRequest) GetId() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListOrdersRequest) GetSearchTerm() string {
-	if x != nil {
-		return x.SearchTerm
-	}
-	return ""
-}
-
-type ListOrdersResponse struct {
+type GetDeadLetterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	DeadLetter    *DeadLetter            `protobuf:"bytes,1,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_order_service_proto_msgTypes[6]
+func (x *GetDeadLetterResponse) Reset() {
+	*x = GetDeadLetterResponse{}
+	mi := &file_order_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *GetDeadLetterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*GetDeadLetterResponse) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[6]
+func (x *GetDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,42 +7484,40 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*GetDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *GetDeadLetterResponse) GetDeadLetter() *DeadLetter {
 	if x != nil {
-		return x.Orders
+		return x.DeadLetter
 	}
 	return nil
 }
 
-type ProcessOrderRequest struct {
+type RequeueDeadLetterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessOrderRequest) Reset() {
-	*x = ProcessOrderRequest{}
-	mi := &file_order_service_proto_msgTypes[7]
+func (x *RequeueDeadLetterRequest) Reset() {
+	*x = RequeueDeadLetterRequest{}
+	mi := &file_order_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessOrderRequest) String() string {
+func (x *RequeueDeadLetterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessOrderRequest) ProtoMessage() {}
+func (*RequeueDeadLetterRequest) ProtoMessage() {}
 
-func (x *ProcessOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[7]
+func (x *RequeueDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,53 +7528,40 @@ func (x *ProcessOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessOrderRequest.ProtoReflect.Descriptor instead.
-func (*ProcessOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *ProcessOrderRequest) GetOrderId() int64 {
-	if x != nil {
-		return x.OrderId
-	}
-	return 0
+// Deprecated: Use RequeueDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *ProcessOrderRequest) GetUserId() int64 {
+func (x *RequeueDeadLetterRequest) GetId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ProcessOrderRequest) GetAction() string {
-	if x != nil {
-		return x.Action
-	}
-	return ""
-}
-
-type ProcessOrderResponse struct {
+type RequeueDeadLetterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessOrderResponse) Reset() {
-	*x = ProcessOrderResponse{}
-	mi := &file_order_service_proto_msgTypes[8]
+func (x *RequeueDeadLetterResponse) Reset() {
+	*x = RequeueDeadLetterResponse{}
+	mi := &file_order_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessOrderResponse) String() string {
+func (x *RequeueDeadLetterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessOrderResponse) ProtoMessage() {}
+func (*RequeueDeadLetterResponse) ProtoMessage() {}
 
-func (x *ProcessOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[8]
+func (x *RequeueDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,33 +7572,43 @@ func (x *ProcessOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessOrderResponse.ProtoReflect.Descriptor instead.
-func (*ProcessOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RequeueDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{132}
 }
 
-type ReturnOrderRequest struct {
+func (x *RequeueDeadLetterResponse) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+// PurgeDeadLettersRequest removes dead letters of the task type that died
+// before dead_before, empty fields match every dead letter.
+type PurgeDeadLettersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TaskType      string                 `protobuf:"bytes,1,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	DeadBefore    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=dead_before,json=deadBefore,proto3" json:"dead_before,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReturnOrderRequest) Reset() {
-	*x = ReturnOrderRequest{}
-	mi := &file_order_service_proto_msgTypes[9]
+func (x *PurgeDeadLettersRequest) Reset() {
+	*x = PurgeDeadLettersRequest{}
+	mi := &file_order_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReturnOrderRequest) String() string {
+func (x *PurgeDeadLettersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnOrderRequest) ProtoMessage() {}
+func (*PurgeDeadLettersRequest) ProtoMessage() {}
 
-func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[9]
+func (x *PurgeDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,39 +7619,47 @@ func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnOrderRequest.ProtoReflect.Descriptor instead.
-func (*ReturnOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PurgeDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ReturnOrderRequest) GetOrderId() int64 {
+func (x *PurgeDeadLettersRequest) GetTaskType() string {
 	if x != nil {
-		return x.OrderId
+		return x.TaskType
 	}
-	return 0
+	return ""
 }
 
-type ReturnOrderResponse struct {
+func (x *PurgeDeadLettersRequest) GetDeadBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadBefore
+	}
+	return nil
+}
+
+type PurgeDeadLettersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purged        int64                  `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReturnOrderResponse) Reset() {
-	*x = ReturnOrderResponse{}
-	mi := &file_order_service_proto_msgTypes[10]
+func (x *PurgeDeadLettersResponse) Reset() {
+	*x = PurgeDeadLettersResponse{}
+	mi := &file_order_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReturnOrderResponse) String() string {
+func (x *PurgeDeadLettersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnOrderResponse) ProtoMessage() {}
+func (*PurgeDeadLettersResponse) ProtoMessage() {}
 
-func (x *ReturnOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_proto_msgTypes[10]
+func (x *PurgeDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,61 +7670,618 @@ func (x *ReturnOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnOrderResponse.ProtoReflect.Descriptor instead.
-func (*ReturnOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PurgeDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *PurgeDeadLettersResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
 }
 
 var File_order_service_proto protoreflect.FileDescriptor
 
 const file_order_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13order_service.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x02\n" +
+	"\x13order_service.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xb7\x03\n" +
 	"\x12CreateOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12C\n" +
 	"\x0fexpiration_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationTime\x12\x16\n" +
-	"\x06weight\x18\x04 \x01(\x05R\x06weight\x12\x12\n" +
-	"\x04cost\x18\x05 \x01(\x05R\x04cost\x12!\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\x12!\n" +
 	"\fpackage_type\x18\x06 \x01(\tR\vpackageType\x12,\n" +
-	"\x12is_additional_film\x18\a \x01(\bR\x10isAdditionalFilm\"0\n" +
+	"\x12is_additional_film\x18\a \x01(\bR\x10isAdditionalFilm\x12)\n" +
+	"\x10packaging_layers\x18\b \x03(\tR\x0fpackagingLayers\x12\x16\n" +
+	"\x06length\x18\t \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\n" +
+	" \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\v \x01(\x05R\x06height\x12 \n" +
+	"\x04cost\x18\f \x01(\v2\f.order.MoneyR\x04cost\x12&\n" +
+	"\x0fpickup_point_id\x18\r \x01(\x03R\rpickupPointIdJ\x04\b\x05\x10\x06\"Q\n" +
 	"\x13CreateOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\"\xc4\x01\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vpickup_code\x18\x02 \x01(\tR\n" +
+	"pickupCode\"\xbd\x05\n" +
 	"\x05Order\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12C\n" +
 	"\x0fexpiration_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationTime\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
-	"\x06weight\x18\x05 \x01(\x05R\x06weight\x12\x12\n" +
-	"\x04cost\x18\x06 \x01(\x05R\x04cost\"0\n" +
+	"\x06weight\x18\x05 \x01(\x05R\x06weight\x123\n" +
+	"\tpackaging\x18\a \x03(\v2\x15.order.PackagingLayerR\tpackaging\x12!\n" +
+	"\fpackage_type\x18\b \x01(\tR\vpackageType\x12,\n" +
+	"\x12is_additional_film\x18\t \x01(\bR\x10isAdditionalFilm\x12\x16\n" +
+	"\x06length\x18\f \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\r \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x0e \x01(\x05R\x06height\x12 \n" +
+	"\x04cost\x18\x0f \x01(\v2\f.order.MoneyR\x04cost\x12)\n" +
+	"\tbase_cost\x18\x10 \x01(\v2\f.order.MoneyR\bbaseCost\x123\n" +
+	"\x0epackaging_cost\x18\x11 \x01(\v2\f.order.MoneyR\rpackagingCost\x12&\n" +
+	"\x0fpickup_point_id\x18\x12 \x01(\x03R\rpickupPointId\x12!\n" +
+	"\fstorage_cell\x18\x13 \x01(\tR\vstorageCell\x12,\n" +
+	"\x06refund\x18\x14 \x01(\v2\x14.order.RefundDetailsR\x06refund\x122\n" +
+	"\vstorage_fee\x18\x15 \x01(\v2\x11.order.StorageFeeR\n" +
+	"storageFeeJ\x04\b\x06\x10\aJ\x04\b\n" +
+	"\x10\vJ\x04\b\v\x10\f\"\xff\x01\n" +
+	"\n" +
+	"StorageFee\x12=\n" +
+	"\fstored_since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vstoredSince\x12\x1f\n" +
+	"\vstored_days\x18\x02 \x01(\x05R\n" +
+	"storedDays\x12\x1b\n" +
+	"\tfree_days\x18\x03 \x01(\x05R\bfreeDays\x12!\n" +
+	"\fcharged_days\x18\x04 \x01(\x05R\vchargedDays\x12+\n" +
+	"\n" +
+	"daily_rate\x18\x05 \x01(\v2\f.order.MoneyR\tdailyRate\x12$\n" +
+	"\x06amount\x18\x06 \x01(\v2\f.order.MoneyR\x06amount\"\xac\x01\n" +
+	"\rRefundDetails\x12\x1f\n" +
+	"\vreason_code\x18\x01 \x01(\tR\n" +
+	"reasonCode\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\x12%\n" +
+	"\x0eitem_condition\x18\x03 \x01(\tR\ritemCondition\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"w\n" +
+	"\x0ePackagingLayer\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12!\n" +
+	"\fpackage_type\x18\x02 \x01(\tR\vpackageType\x12 \n" +
+	"\x04cost\x18\x04 \x01(\v2\f.order.MoneyR\x04costJ\x04\b\x03\x10\x04\"0\n" +
 	"\x13GetOrderByIDRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\":\n" +
 	"\x14GetOrderByIDResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\x94\x01\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\x88\x02\n" +
 	"\x11ListOrdersRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x17\n" +
 	"\alast_id\x18\x03 \x01(\x03R\x06lastId\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x1f\n" +
 	"\vsearch_term\x18\x05 \x01(\tR\n" +
-	"searchTerm\":\n" +
+	"searchTerm\x12&\n" +
+	"\x0fpickup_point_id\x18\x06 \x01(\x03R\rpickupPointId\x12#\n" +
+	"\rrefund_reason\x18\a \x01(\tR\frefundReason\x12%\n" +
+	"\x0eitem_condition\x18\b \x01(\tR\ritemCondition\":\n" +
 	"\x12ListOrdersResponse\x12$\n" +
-	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\"a\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\"\xf1\x02\n" +
 	"\x13ProcessOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06action\x18\x03 \x01(\tR\x06action\"\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vpickup_code\x18\x04 \x01(\tR\n" +
+	"pickupCode\x12'\n" +
+	"\rsupervisor_id\x18\x05 \x01(\x03B\x02\x18\x01R\fsupervisorId\x12'\n" +
+	"\x0foverride_reason\x18\x06 \x01(\tR\x0eoverrideReason\x12#\n" +
+	"\rrefund_reason\x18\a \x01(\tR\frefundReason\x12%\n" +
+	"\x0erefund_comment\x18\b \x01(\tR\rrefundComment\x12%\n" +
+	"\x0eitem_condition\x18\t \x01(\tR\ritemCondition\x12(\n" +
+	"\x10storage_fee_paid\x18\n" +
+	" \x01(\bR\x0estorageFeePaid\"\x16\n" +
 	"\x14ProcessOrderResponse\"/\n" +
 	"\x12ReturnOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\"\x15\n" +
-	"\x13ReturnOrderResponse2\xf0\x02\n" +
+	"\x13ReturnOrderResponse\"Q\n" +
+	"\x1bListOrderTransitionsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"g\n" +
+	"\x0fOrderTransition\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x1f\n" +
+	"\vfrom_status\x18\x02 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x03 \x01(\tR\btoStatus\"X\n" +
+	"\x1cListOrderTransitionsResponse\x128\n" +
+	"\vtransitions\x18\x01 \x03(\v2\x16.order.OrderTransitionR\vtransitions\"\xdc\x01\n" +
+	"\aPackage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x02 \x01(\x05R\tmaxWeight\x12\x19\n" +
+	"\bcan_wrap\x18\x04 \x01(\bR\acanWrap\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"max_volume\x18\x06 \x01(\x05R\tmaxVolume\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\a \x01(\x05R\tmaxLength\x12\"\n" +
+	"\x05price\x18\b \x01(\v2\f.order.MoneyR\x05priceJ\x04\b\x03\x10\x04\"\x15\n" +
+	"\x13ListPackagesRequest\"B\n" +
+	"\x14ListPackagesResponse\x12*\n" +
+	"\bpackages\x18\x01 \x03(\v2\x0e.order.PackageR\bpackages\"@\n" +
+	"\x14UpsertPackageRequest\x12(\n" +
+	"\apackage\x18\x01 \x01(\v2\x0e.order.PackageR\apackage\"A\n" +
+	"\x15UpsertPackageResponse\x12(\n" +
+	"\apackage\x18\x01 \x01(\v2\x0e.order.PackageR\apackage\"\x95\x02\n" +
+	"\x11QuoteOrderRequest\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x05R\x06weight\x12!\n" +
+	"\fpackage_type\x18\x03 \x01(\tR\vpackageType\x12,\n" +
+	"\x12is_additional_film\x18\x04 \x01(\bR\x10isAdditionalFilm\x12)\n" +
+	"\x10packaging_layers\x18\x05 \x03(\tR\x0fpackagingLayers\x12\x16\n" +
+	"\x06length\x18\x06 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\a \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\b \x01(\x05R\x06height\x12 \n" +
+	"\x04cost\x18\t \x01(\v2\f.order.MoneyR\x04costJ\x04\b\x02\x10\x03\"\xe8\x01\n" +
+	"\x12QuoteOrderResponse\x123\n" +
+	"\tpackaging\x18\x02 \x03(\v2\x15.order.PackagingLayerR\tpackaging\x12)\n" +
+	"\tbase_cost\x18\x05 \x01(\v2\f.order.MoneyR\bbaseCost\x123\n" +
+	"\x0epackaging_cost\x18\x06 \x01(\v2\f.order.MoneyR\rpackagingCost\x12+\n" +
+	"\n" +
+	"total_cost\x18\a \x01(\v2\f.order.MoneyR\ttotalCostJ\x04\b\x01\x10\x02J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"\xa1\x01\n" +
+	"\x19RecommendPackagingRequest\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x05R\x06weight\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x05 \x01(\x05R\x06height\x12 \n" +
+	"\x04cost\x18\x06 \x01(\v2\f.order.MoneyR\x04costJ\x04\b\x02\x10\x03\"\x93\x02\n" +
+	"\x1aRecommendPackagingResponse\x12!\n" +
+	"\fpackage_type\x18\x01 \x01(\tR\vpackageType\x123\n" +
+	"\tpackaging\x18\x03 \x03(\v2\x15.order.PackagingLayerR\tpackaging\x12)\n" +
+	"\tbase_cost\x18\x06 \x01(\v2\f.order.MoneyR\bbaseCost\x123\n" +
+	"\x0epackaging_cost\x18\a \x01(\v2\f.order.MoneyR\rpackagingCost\x12+\n" +
+	"\n" +
+	"total_cost\x18\b \x01(\v2\f.order.MoneyR\ttotalCostJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"\xd3\x01\n" +
+	"\vPickupPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x19\n" +
+	"\bopens_at\x18\x05 \x01(\tR\aopensAt\x12\x1b\n" +
+	"\tcloses_at\x18\x06 \x01(\tR\bclosesAt\x122\n" +
+	"\x15order_expiration_days\x18\a \x01(\x05R\x13orderExpirationDays\"Q\n" +
+	"\x18CreatePickupPointRequest\x125\n" +
+	"\fpickup_point\x18\x01 \x01(\v2\x12.order.PickupPointR\vpickupPoint\"R\n" +
+	"\x19CreatePickupPointResponse\x125\n" +
+	"\fpickup_point\x18\x01 \x01(\v2\x12.order.PickupPointR\vpickupPoint\"'\n" +
+	"\x15GetPickupPointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x16GetPickupPointResponse\x125\n" +
+	"\fpickup_point\x18\x01 \x01(\v2\x12.order.PickupPointR\vpickupPoint\"\x19\n" +
+	"\x17ListPickupPointsRequest\"S\n" +
+	"\x18ListPickupPointsResponse\x127\n" +
+	"\rpickup_points\x18\x01 \x03(\v2\x12.order.PickupPointR\fpickupPoints\"Q\n" +
+	"\x18UpdatePickupPointRequest\x125\n" +
+	"\fpickup_point\x18\x01 \x01(\v2\x12.order.PickupPointR\vpickupPoint\"R\n" +
+	"\x19UpdatePickupPointResponse\x125\n" +
+	"\fpickup_point\x18\x01 \x01(\v2\x12.order.PickupPointR\vpickupPoint\"*\n" +
+	"\x18DeletePickupPointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1b\n" +
+	"\x19DeletePickupPointResponse\"\xd1\x01\n" +
+	"\vStorageCell\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x04 \x01(\x05R\tmaxWeight\x12\x1d\n" +
+	"\n" +
+	"max_volume\x18\x05 \x01(\x05R\tmaxVolume\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x06 \x01(\x05R\tmaxLength\x12\x19\n" +
+	"\border_id\x18\a \x01(\x03R\aorderId\"Q\n" +
+	"\x18CreateStorageCellRequest\x125\n" +
+	"\fstorage_cell\x18\x01 \x01(\v2\x12.order.StorageCellR\vstorageCell\"R\n" +
+	"\x19CreateStorageCellResponse\x125\n" +
+	"\fstorage_cell\x18\x01 \x01(\v2\x12.order.StorageCellR\vstorageCell\"A\n" +
+	"\x17ListStorageCellsRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\"S\n" +
+	"\x18ListStorageCellsResponse\x127\n" +
+	"\rstorage_cells\x18\x01 \x03(\v2\x12.order.StorageCellR\fstorageCells\"\x8b\x01\n" +
+	"\x10StorageOccupancy\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1a\n" +
+	"\boccupied\x18\x03 \x01(\x05R\boccupied\x12\x1d\n" +
+	"\n" +
+	"fill_ratio\x18\x04 \x01(\x01R\tfillRatio\"\x1c\n" +
+	"\x1aGetStorageOccupancyRequest\"T\n" +
+	"\x1bGetStorageOccupancyResponse\x125\n" +
+	"\toccupancy\x18\x01 \x03(\v2\x17.order.StorageOccupancyR\toccupancy\"L\n" +
+	"\x16IssuePickupCodeRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\":\n" +
+	"\x17IssuePickupCodeResponse\x12\x1f\n" +
+	"\vpickup_code\x18\x01 \x01(\tR\n" +
+	"pickupCode\"r\n" +
+	"\n" +
+	"PickupItem\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vpickup_code\x18\x02 \x01(\tR\n" +
+	"pickupCode\x12(\n" +
+	"\x10storage_fee_paid\x18\x03 \x01(\bR\x0estorageFeePaid\"o\n" +
+	"\x15CompleteOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x06orders\x18\x02 \x03(\v2\x11.order.PickupItemR\x06orders\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"S\n" +
+	"\x10BatchOrderResult\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x0e\n" +
+	"\x02ok\x18\x02 \x01(\bR\x02ok\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"q\n" +
+	"\x16CompleteOrdersResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.order.BatchOrderResultR\aresults\x12$\n" +
+	"\x06totals\x18\x02 \x03(\v2\f.order.MoneyR\x06totals\"\xd2\x01\n" +
+	"\x13RefundOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\torder_ids\x18\x02 \x03(\x03R\borderIds\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12#\n" +
+	"\rrefund_reason\x18\x04 \x01(\tR\frefundReason\x12%\n" +
+	"\x0erefund_comment\x18\x05 \x01(\tR\rrefundComment\x12%\n" +
+	"\x0eitem_condition\x18\x06 \x01(\tR\ritemCondition\"o\n" +
+	"\x14RefundOrdersResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.order.BatchOrderResultR\aresults\x12$\n" +
+	"\x06totals\x18\x02 \x03(\v2\f.order.MoneyR\x06totals\"D\n" +
+	"\fRefundReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x1a\n" +
+	"\x18ListRefundReasonsRequest\"J\n" +
+	"\x19ListRefundReasonsResponse\x12-\n" +
+	"\areasons\x18\x01 \x03(\v2\x13.order.RefundReasonR\areasons\"p\n" +
+	"\rArchivedOrder\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12;\n" +
+	"\varchived_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"\xa6\x02\n" +
+	"\x1bSearchArchivedOrdersRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12&\n" +
+	"\x0fpickup_point_id\x18\x03 \x01(\x03R\rpickupPointId\x12?\n" +
+	"\rarchived_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\farchivedFrom\x12;\n" +
+	"\varchived_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedTo\x12\x17\n" +
+	"\alast_id\x18\x06 \x01(\x03R\x06lastId\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"L\n" +
+	"\x1cSearchArchivedOrdersResponse\x12,\n" +
+	"\x06orders\x18\x01 \x03(\v2\x14.order.ArchivedOrderR\x06orders\"\xa6\x01\n" +
+	"\x12ReturnManifestItem\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12!\n" +
+	"\forder_status\x18\x03 \x01(\tR\vorderStatus\x129\n" +
+	"\n" +
+	"scanned_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"\xa8\x02\n" +
+	"\x0eReturnManifest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12!\n" +
+	"\fcourier_name\x18\x03 \x01(\tR\vcourierName\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tclosed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12/\n" +
+	"\x05items\x18\a \x03(\v2\x19.order.ReturnManifestItemR\x05items\"h\n" +
+	"\x1bCreateReturnManifestRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12!\n" +
+	"\fcourier_name\x18\x02 \x01(\tR\vcourierName\"Q\n" +
+	"\x1cCreateReturnManifestResponse\x121\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x15.order.ReturnManifestR\bmanifest\"*\n" +
+	"\x18GetReturnManifestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"N\n" +
+	"\x19GetReturnManifestResponse\x121\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x15.order.ReturnManifestR\bmanifest\"\\\n" +
+	"\x1aListReturnManifestsRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"R\n" +
+	"\x1bListReturnManifestsResponse\x123\n" +
+	"\tmanifests\x18\x01 \x03(\v2\x15.order.ReturnManifestR\tmanifests\"\\\n" +
+	"\x1eScanReturnManifestOrderRequest\x12\x1f\n" +
+	"\vmanifest_id\x18\x01 \x01(\x03R\n" +
+	"manifestId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"T\n" +
+	"\x1fScanReturnManifestOrderResponse\x121\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x15.order.ReturnManifestR\bmanifest\",\n" +
+	"\x1aCloseReturnManifestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
+	"\x1bCloseReturnManifestResponse\x121\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x15.order.ReturnManifestR\bmanifest\"E\n" +
+	"\x1bExportReturnManifestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"[\n" +
+	"\x1cExportReturnManifestResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\x87\x02\n" +
+	"\fShipmentItem\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12C\n" +
+	"\x0fexpiration_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationTime\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\x12 \n" +
+	"\x04cost\x18\x05 \x01(\v2\f.order.MoneyR\x04cost\x12\x16\n" +
+	"\x06length\x18\x06 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\a \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\b \x01(\x05R\x06height\"\xb8\x02\n" +
+	"\x0fInboundShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tclosed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12)\n" +
+	"\x05items\x18\a \x03(\v2\x13.order.ShipmentItemR\x05items\x12\x18\n" +
+	"\ascanned\x18\b \x03(\x03R\ascanned\"I\n" +
+	"\x14RejectedShipmentItem\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xfb\x01\n" +
+	"\x0eShipmentReport\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\x03R\n" +
+	"shipmentId\x12\x1a\n" +
+	"\breceived\x18\x02 \x03(\x03R\breceived\x12\x18\n" +
+	"\amissing\x18\x03 \x03(\x03R\amissing\x12\x1e\n" +
+	"\n" +
+	"unexpected\x18\x04 \x03(\x03R\n" +
+	"unexpected\x127\n" +
+	"\brejected\x18\x05 \x03(\v2\x1b.order.RejectedShipmentItemR\brejected\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8a\x01\n" +
+	"\x17RegisterShipmentRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12)\n" +
+	"\x05items\x18\x03 \x03(\v2\x13.order.ShipmentItemR\x05items\"N\n" +
+	"\x18RegisterShipmentResponse\x122\n" +
+	"\bshipment\x18\x01 \x01(\v2\x16.order.InboundShipmentR\bshipment\"$\n" +
+	"\x12GetShipmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"I\n" +
+	"\x13GetShipmentResponse\x122\n" +
+	"\bshipment\x18\x01 \x01(\v2\x16.order.InboundShipmentR\bshipment\"V\n" +
+	"\x14ListShipmentsRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"M\n" +
+	"\x15ListShipmentsResponse\x124\n" +
+	"\tshipments\x18\x01 \x03(\v2\x16.order.InboundShipmentR\tshipments\"V\n" +
+	"\x18ScanShipmentOrderRequest\x12\x1f\n" +
+	"\vshipment_id\x18\x01 \x01(\x03R\n" +
+	"shipmentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"O\n" +
+	"\x19ScanShipmentOrderResponse\x122\n" +
+	"\bshipment\x18\x01 \x01(\v2\x16.order.InboundShipmentR\bshipment\"&\n" +
+	"\x14CloseShipmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x82\x01\n" +
+	"\x15CloseShipmentResponse\x12-\n" +
+	"\x06report\x18\x01 \x01(\v2\x15.order.ShipmentReportR\x06report\x12:\n" +
+	"\fpickup_codes\x18\x02 \x03(\v2\x17.order.IssuedPickupCodeR\vpickupCodes\"N\n" +
+	"\x10IssuedPickupCode\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vpickup_code\x18\x02 \x01(\tR\n" +
+	"pickupCode\"*\n" +
+	"\x18GetShipmentReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
+	"\x19GetShipmentReportResponse\x12-\n" +
+	"\x06report\x18\x01 \x01(\v2\x15.order.ShipmentReportR\x06report\"y\n" +
+	"\x11StocktakingResult\x12\x1a\n" +
+	"\bexpected\x18\x01 \x01(\x05R\bexpected\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\x05R\x05found\x12\x18\n" +
+	"\amissing\x18\x03 \x03(\x03R\amissing\x12\x18\n" +
+	"\aunknown\x18\x04 \x03(\x03R\aunknown\"\xcf\x02\n" +
+	"\x12StocktakingSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12#\n" +
+	"\rscanned_count\x18\a \x01(\x05R\fscannedCount\x120\n" +
+	"\x06result\x18\b \x01(\v2\x18.order.StocktakingResultR\x06result\"]\n" +
+	"\x17StartStocktakingRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\"O\n" +
+	"\x18StartStocktakingResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.order.StocktakingSessionR\asession\"R\n" +
+	"\x16ScanStocktakingRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03R\tsessionId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"N\n" +
+	"\x17ScanStocktakingResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.order.StocktakingSessionR\asession\"*\n" +
+	"\x18FinishStocktakingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"P\n" +
+	"\x19FinishStocktakingResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.order.StocktakingSessionR\asession\"'\n" +
+	"\x15GetStocktakingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"M\n" +
+	"\x16GetStocktakingResponse\x123\n" +
+	"\asession\x18\x01 \x01(\v2\x19.order.StocktakingSessionR\asession\"Y\n" +
+	"\x17ListStocktakingsRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"Q\n" +
+	"\x18ListStocktakingsResponse\x125\n" +
+	"\bsessions\x18\x01 \x03(\v2\x19.order.StocktakingSessionR\bsessions\"\xd0\x03\n" +
+	"\bIncident\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\forder_status\x18\a \x01(\tR\vorderStatus\x12\x18\n" +
+	"\aoutcome\x18\b \x01(\tR\aoutcome\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\t \x01(\tR\n" +
+	"resolution\x12\x1f\n" +
+	"\vresolved_by\x18\n" +
+	" \x01(\tR\n" +
+	"resolvedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vresolved_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\x82\x01\n" +
+	"\x13OpenIncidentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\"C\n" +
+	"\x14OpenIncidentResponse\x12+\n" +
+	"\bincident\x18\x01 \x01(\v2\x0f.order.IncidentR\bincident\"$\n" +
+	"\x12GetIncidentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"B\n" +
+	"\x13GetIncidentResponse\x12+\n" +
+	"\bincident\x18\x01 \x01(\v2\x0f.order.IncidentR\bincident\"]\n" +
+	"\x14ListIncidentsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"F\n" +
+	"\x15ListIncidentsResponse\x12-\n" +
+	"\tincidents\x18\x01 \x03(\v2\x0f.order.IncidentR\tincidents\"]\n" +
+	"\x15UpdateIncidentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"E\n" +
+	"\x16UpdateIncidentResponse\x12+\n" +
+	"\bincident\x18\x01 \x01(\v2\x0f.order.IncidentR\bincident\"~\n" +
+	"\x16ResolveIncidentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aoutcome\x18\x02 \x01(\tR\aoutcome\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x03 \x01(\tR\n" +
+	"resolution\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\"F\n" +
+	"\x17ResolveIncidentResponse\x12+\n" +
+	"\bincident\x18\x01 \x01(\v2\x0f.order.IncidentR\bincident\"c\n" +
+	"\x15CancelIncidentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\"E\n" +
+	"\x16CancelIncidentResponse\x12+\n" +
+	"\bincident\x18\x01 \x01(\v2\x0f.order.IncidentR\bincident\"\x81\x01\n" +
+	"\rStorageTariff\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x1b\n" +
+	"\tfree_days\x18\x02 \x01(\x05R\bfreeDays\x12+\n" +
+	"\n" +
+	"daily_rate\x18\x03 \x01(\v2\f.order.MoneyR\tdailyRate\"A\n" +
+	"\x17GetStorageTariffRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\"H\n" +
+	"\x18GetStorageTariffResponse\x12,\n" +
+	"\x06tariff\x18\x01 \x01(\v2\x14.order.StorageTariffR\x06tariff\"\x8b\x01\n" +
+	"\x17SetStorageTariffRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\x12\x1b\n" +
+	"\tfree_days\x18\x02 \x01(\x05R\bfreeDays\x12+\n" +
+	"\n" +
+	"daily_rate\x18\x03 \x01(\v2\f.order.MoneyR\tdailyRate\"H\n" +
+	"\x18SetStorageTariffResponse\x12,\n" +
+	"\x06tariff\x18\x01 \x01(\v2\x14.order.StorageTariffR\x06tariff\"D\n" +
+	"\x1aDeleteStorageTariffRequest\x12&\n" +
+	"\x0fpickup_point_id\x18\x01 \x01(\x03R\rpickupPointId\"\x1d\n" +
+	"\x1bDeleteStorageTariffResponse\"\xc1\x02\n" +
+	"\fDailyRevenue\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12&\n" +
+	"\x0fpickup_point_id\x18\x02 \x01(\x03R\rpickupPointId\x12#\n" +
+	"\rorders_issued\x18\x03 \x01(\x05R\fordersIssued\x123\n" +
+	"\x0eorders_revenue\x18\x04 \x01(\v2\f.order.MoneyR\rordersRevenue\x12*\n" +
+	"\x11storage_fees_paid\x18\x05 \x01(\x05R\x0fstorageFeesPaid\x12/\n" +
+	"\fstorage_fees\x18\x06 \x01(\v2\f.order.MoneyR\vstorageFees\x12\"\n" +
+	"\x05total\x18\a \x01(\v2\f.order.MoneyR\x05total\"\x9c\x01\n" +
+	"\x16GetDailyRevenueRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12&\n" +
+	"\x0fpickup_point_id\x18\x03 \x01(\x03R\rpickupPointId\"B\n" +
+	"\x17GetDailyRevenueResponse\x12'\n" +
+	"\x04days\x18\x01 \x03(\v2\x13.order.DailyRevenueR\x04days\"\xb9\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1b\n" +
+	"\ttask_type\x18\x03 \x01(\tR\btaskType\x12\x19\n" +
+	"\bentry_id\x18\x04 \x01(\x03R\aentryId\x12%\n" +
+	"\x0eattempts_count\x18\x05 \x01(\x05R\rattemptsCount\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\adead_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06deadAt\x12\x14\n" +
+	"\x05entry\x18\t \x01(\tR\x05entry\"5\n" +
+	"\x16ListDeadLettersRequest\x12\x1b\n" +
+	"\ttask_type\x18\x01 \x01(\tR\btaskType\"O\n" +
+	"\x17ListDeadLettersResponse\x124\n" +
+	"\fdead_letters\x18\x01 \x03(\v2\x11.order.DeadLetterR\vdeadLetters\"&\n" +
+	"\x14GetDeadLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"K\n" +
+	"\x15GetDeadLetterResponse\x122\n" +
+	"\vdead_letter\x18\x01 \x01(\v2\x11.order.DeadLetterR\n" +
+	"deadLetter\"*\n" +
+	"\x18RequeueDeadLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
+	"\x19RequeueDeadLetterResponse\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"s\n" +
+	"\x17PurgeDeadLettersRequest\x12\x1b\n" +
+	"\ttask_type\x18\x01 \x01(\tR\btaskType\x12;\n" +
+	"\vdead_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deadBefore\"2\n" +
+	"\x18PurgeDeadLettersResponse\x12\x16\n" +
+	"\x06purged\x18\x01 \x01(\x03R\x06purged2\x96#\n" +
 	"\fOrderService\x12E\n" +
 	"\fConfirmOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12G\n" +
 	"\fGetOrderByID\x12\x1a.order.GetOrderByIDRequest\x1a\x1b.order.GetOrderByIDResponse\x12A\n" +
 	"\n" +
 	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12G\n" +
 	"\fProcessOrder\x12\x1a.order.ProcessOrderRequest\x1a\x1b.order.ProcessOrderResponse\x12D\n" +
-	"\vReturnOrder\x12\x19.order.ReturnOrderRequest\x1a\x1a.order.ReturnOrderResponseB\vZ\t/;orderpbb\x06proto3"
+	"\vReturnOrder\x12\x19.order.ReturnOrderRequest\x1a\x1a.order.ReturnOrderResponse\x12_\n" +
+	"\x14ListOrderTransitions\x12\".order.ListOrderTransitionsRequest\x1a#.order.ListOrderTransitionsResponse\x12G\n" +
+	"\fListPackages\x12\x1a.order.ListPackagesRequest\x1a\x1b.order.ListPackagesResponse\x12J\n" +
+	"\rUpsertPackage\x12\x1b.order.UpsertPackageRequest\x1a\x1c.order.UpsertPackageResponse\x12A\n" +
+	"\n" +
+	"QuoteOrder\x12\x18.order.QuoteOrderRequest\x1a\x19.order.QuoteOrderResponse\x12Y\n" +
+	"\x12RecommendPackaging\x12 .order.RecommendPackagingRequest\x1a!.order.RecommendPackagingResponse\x12V\n" +
+	"\x11CreatePickupPoint\x12\x1f.order.CreatePickupPointRequest\x1a .order.CreatePickupPointResponse\x12M\n" +
+	"\x0eGetPickupPoint\x12\x1c.order.GetPickupPointRequest\x1a\x1d.order.GetPickupPointResponse\x12S\n" +
+	"\x10ListPickupPoints\x12\x1e.order.ListPickupPointsRequest\x1a\x1f.order.ListPickupPointsResponse\x12V\n" +
+	"\x11UpdatePickupPoint\x12\x1f.order.UpdatePickupPointRequest\x1a .order.UpdatePickupPointResponse\x12V\n" +
+	"\x11DeletePickupPoint\x12\x1f.order.DeletePickupPointRequest\x1a .order.DeletePickupPointResponse\x12V\n" +
+	"\x11CreateStorageCell\x12\x1f.order.CreateStorageCellRequest\x1a .order.CreateStorageCellResponse\x12S\n" +
+	"\x10ListStorageCells\x12\x1e.order.ListStorageCellsRequest\x1a\x1f.order.ListStorageCellsResponse\x12\\\n" +
+	"\x13GetStorageOccupancy\x12!.order.GetStorageOccupancyRequest\x1a\".order.GetStorageOccupancyResponse\x12P\n" +
+	"\x0fIssuePickupCode\x12\x1d.order.IssuePickupCodeRequest\x1a\x1e.order.IssuePickupCodeResponse\x12M\n" +
+	"\x0eCompleteOrders\x12\x1c.order.CompleteOrdersRequest\x1a\x1d.order.CompleteOrdersResponse\x12G\n" +
+	"\fRefundOrders\x12\x1a.order.RefundOrdersRequest\x1a\x1b.order.RefundOrdersResponse\x12V\n" +
+	"\x11ListRefundReasons\x12\x1f.order.ListRefundReasonsRequest\x1a .order.ListRefundReasonsResponse\x12_\n" +
+	"\x14SearchArchivedOrders\x12\".order.SearchArchivedOrdersRequest\x1a#.order.SearchArchivedOrdersResponse\x12_\n" +
+	"\x14CreateReturnManifest\x12\".order.CreateReturnManifestRequest\x1a#.order.CreateReturnManifestResponse\x12V\n" +
+	"\x11GetReturnManifest\x12\x1f.order.GetReturnManifestRequest\x1a .order.GetReturnManifestResponse\x12\\\n" +
+	"\x13ListReturnManifests\x12!.order.ListReturnManifestsRequest\x1a\".order.ListReturnManifestsResponse\x12h\n" +
+	"\x17ScanReturnManifestOrder\x12%.order.ScanReturnManifestOrderRequest\x1a&.order.ScanReturnManifestOrderResponse\x12\\\n" +
+	"\x13CloseReturnManifest\x12!.order.CloseReturnManifestRequest\x1a\".order.CloseReturnManifestResponse\x12_\n" +
+	"\x14ExportReturnManifest\x12\".order.ExportReturnManifestRequest\x1a#.order.ExportReturnManifestResponse\x12S\n" +
+	"\x10RegisterShipment\x12\x1e.order.RegisterShipmentRequest\x1a\x1f.order.RegisterShipmentResponse\x12D\n" +
+	"\vGetShipment\x12\x19.order.GetShipmentRequest\x1a\x1a.order.GetShipmentResponse\x12J\n" +
+	"\rListShipments\x12\x1b.order.ListShipmentsRequest\x1a\x1c.order.ListShipmentsResponse\x12V\n" +
+	"\x11ScanShipmentOrder\x12\x1f.order.ScanShipmentOrderRequest\x1a .order.ScanShipmentOrderResponse\x12J\n" +
+	"\rCloseShipment\x12\x1b.order.CloseShipmentRequest\x1a\x1c.order.CloseShipmentResponse\x12V\n" +
+	"\x11GetShipmentReport\x12\x1f.order.GetShipmentReportRequest\x1a .order.GetShipmentReportResponse\x12S\n" +
+	"\x10StartStocktaking\x12\x1e.order.StartStocktakingRequest\x1a\x1f.order.StartStocktakingResponse\x12R\n" +
+	"\x0fScanStocktaking\x12\x1d.order.ScanStocktakingRequest\x1a\x1e.order.ScanStocktakingResponse(\x01\x12V\n" +
+	"\x11FinishStocktaking\x12\x1f.order.FinishStocktakingRequest\x1a .order.FinishStocktakingResponse\x12M\n" +
+	"\x0eGetStocktaking\x12\x1c.order.GetStocktakingRequest\x1a\x1d.order.GetStocktakingResponse\x12S\n" +
+	"\x10ListStocktakings\x12\x1e.order.ListStocktakingsRequest\x1a\x1f.order.ListStocktakingsResponse\x12G\n" +
+	"\fOpenIncident\x12\x1a.order.OpenIncidentRequest\x1a\x1b.order.OpenIncidentResponse\x12D\n" +
+	"\vGetIncident\x12\x19.order.GetIncidentRequest\x1a\x1a.order.GetIncidentResponse\x12J\n" +
+	"\rListIncidents\x12\x1b.order.ListIncidentsRequest\x1a\x1c.order.ListIncidentsResponse\x12M\n" +
+	"\x0eUpdateIncident\x12\x1c.order.UpdateIncidentRequest\x1a\x1d.order.UpdateIncidentResponse\x12P\n" +
+	"\x0fResolveIncident\x12\x1d.order.ResolveIncidentRequest\x1a\x1e.order.ResolveIncidentResponse\x12M\n" +
+	"\x0eCancelIncident\x12\x1c.order.CancelIncidentRequest\x1a\x1d.order.CancelIncidentResponse\x12S\n" +
+	"\x10GetStorageTariff\x12\x1e.order.GetStorageTariffRequest\x1a\x1f.order.GetStorageTariffResponse\x12S\n" +
+	"\x10SetStorageTariff\x12\x1e.order.SetStorageTariffRequest\x1a\x1f.order.SetStorageTariffResponse\x12\\\n" +
+	"\x13DeleteStorageTariff\x12!.order.DeleteStorageTariffRequest\x1a\".order.DeleteStorageTariffResponse\x12P\n" +
+	"\x0fGetDailyRevenue\x12\x1d.order.GetDailyRevenueRequest\x1a\x1e.order.GetDailyRevenueResponse\x12P\n" +
+	"\x0fListDeadLetters\x12\x1d.order.ListDeadLettersRequest\x1a\x1e.order.ListDeadLettersResponse\x12J\n" +
+	"\rGetDeadLetter\x12\x1b.order.GetDeadLetterRequest\x1a\x1c.order.GetDeadLetterResponse\x12V\n" +
+	"\x11RequeueDeadLetter\x12\x1f.order.RequeueDeadLetterRequest\x1a .order.RequeueDeadLetterResponse\x12S\n" +
+	"\x10PurgeDeadLetters\x12\x1e.order.PurgeDeadLettersRequest\x1a\x1f.order.PurgeDeadLettersResponseB\vZ\t/;orderpbb\x06proto3"
 
 var (
 	file_order_service_proto_rawDescOnce sync.Once
@@ -689,41 +8295,368 @@ func file_order_service_proto_rawDescGZIP() []byte {
 	return file_order_service_proto_rawDescData
 }
 
-var file_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 135)
 var file_order_service_proto_goTypes = []any{
-	(*CreateOrderRequest)(nil),    // 0: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),   // 1: order.CreateOrderResponse
-	(*Order)(nil),                 // 2: order.Order
-	(*GetOrderByIDRequest)(nil),   // 3: order.GetOrderByIDRequest
-	(*GetOrderByIDResponse)(nil),  // 4: order.GetOrderByIDResponse
-	(*ListOrdersRequest)(nil),     // 5: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),    // 6: order.ListOrdersResponse
-	(*ProcessOrderRequest)(nil),   // 7: order.ProcessOrderRequest
-	(*ProcessOrderResponse)(nil),  // 8: order.ProcessOrderResponse
-	(*ReturnOrderRequest)(nil),    // 9: order.ReturnOrderRequest
-	(*ReturnOrderResponse)(nil),   // 10: order.ReturnOrderResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*Money)(nil),                           // 0: order.Money
+	(*CreateOrderRequest)(nil),              // 1: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),             // 2: order.CreateOrderResponse
+	(*Order)(nil),                           // 3: order.Order
+	(*StorageFee)(nil),                      // 4: order.StorageFee
+	(*RefundDetails)(nil),                   // 5: order.RefundDetails
+	(*PackagingLayer)(nil),                  // 6: order.PackagingLayer
+	(*GetOrderByIDRequest)(nil),             // 7: order.GetOrderByIDRequest
+	(*GetOrderByIDResponse)(nil),            // 8: order.GetOrderByIDResponse
+	(*ListOrdersRequest)(nil),               // 9: order.ListOrdersRequest
+	(*ListOrdersResponse)(nil),              // 10: order.ListOrdersResponse
+	(*ProcessOrderRequest)(nil),             // 11: order.ProcessOrderRequest
+	(*ProcessOrderResponse)(nil),            // 12: order.ProcessOrderResponse
+	(*ReturnOrderRequest)(nil),              // 13: order.ReturnOrderRequest
+	(*ReturnOrderResponse)(nil),             // 14: order.ReturnOrderResponse
+	(*ListOrderTransitionsRequest)(nil),     // 15: order.ListOrderTransitionsRequest
+	(*OrderTransition)(nil),                 // 16: order.OrderTransition
+	(*ListOrderTransitionsResponse)(nil),    // 17: order.ListOrderTransitionsResponse
+	(*Package)(nil),                         // 18: order.Package
+	(*ListPackagesRequest)(nil),             // 19: order.ListPackagesRequest
+	(*ListPackagesResponse)(nil),            // 20: order.ListPackagesResponse
+	(*UpsertPackageRequest)(nil),            // 21: order.UpsertPackageRequest
+	(*UpsertPackageResponse)(nil),           // 22: order.UpsertPackageResponse
+	(*QuoteOrderRequest)(nil),               // 23: order.QuoteOrderRequest
+	(*QuoteOrderResponse)(nil),              // 24: order.QuoteOrderResponse
+	(*RecommendPackagingRequest)(nil),       // 25: order.RecommendPackagingRequest
+	(*RecommendPackagingResponse)(nil),      // 26: order.RecommendPackagingResponse
+	(*PickupPoint)(nil),                     // 27: order.PickupPoint
+	(*CreatePickupPointRequest)(nil),        // 28: order.CreatePickupPointRequest
+	(*CreatePickupPointResponse)(nil),       // 29: order.CreatePickupPointResponse
+	(*GetPickupPointRequest)(nil),           // 30: order.GetPickupPointRequest
+	(*GetPickupPointResponse)(nil),          // 31: order.GetPickupPointResponse
+	(*ListPickupPointsRequest)(nil),         // 32: order.ListPickupPointsRequest
+	(*ListPickupPointsResponse)(nil),        // 33: order.ListPickupPointsResponse
+	(*UpdatePickupPointRequest)(nil),        // 34: order.UpdatePickupPointRequest
+	(*UpdatePickupPointResponse)(nil),       // 35: order.UpdatePickupPointResponse
+	(*DeletePickupPointRequest)(nil),        // 36: order.DeletePickupPointRequest
+	(*DeletePickupPointResponse)(nil),       // 37: order.DeletePickupPointResponse
+	(*StorageCell)(nil),                     // 38: order.StorageCell
+	(*CreateStorageCellRequest)(nil),        // 39: order.CreateStorageCellRequest
+	(*CreateStorageCellResponse)(nil),       // 40: order.CreateStorageCellResponse
+	(*ListStorageCellsRequest)(nil),         // 41: order.ListStorageCellsRequest
+	(*ListStorageCellsResponse)(nil),        // 42: order.ListStorageCellsResponse
+	(*StorageOccupancy)(nil),                // 43: order.StorageOccupancy
+	(*GetStorageOccupancyRequest)(nil),      // 44: order.GetStorageOccupancyRequest
+	(*GetStorageOccupancyResponse)(nil),     // 45: order.GetStorageOccupancyResponse
+	(*IssuePickupCodeRequest)(nil),          // 46: order.IssuePickupCodeRequest
+	(*IssuePickupCodeResponse)(nil),         // 47: order.IssuePickupCodeResponse
+	(*PickupItem)(nil),                      // 48: order.PickupItem
+	(*CompleteOrdersRequest)(nil),           // 49: order.CompleteOrdersRequest
+	(*BatchOrderResult)(nil),                // 50: order.BatchOrderResult
+	(*CompleteOrdersResponse)(nil),          // 51: order.CompleteOrdersResponse
+	(*RefundOrdersRequest)(nil),             // 52: order.RefundOrdersRequest
+	(*RefundOrdersResponse)(nil),            // 53: order.RefundOrdersResponse
+	(*RefundReason)(nil),                    // 54: order.RefundReason
+	(*ListRefundReasonsRequest)(nil),        // 55: order.ListRefundReasonsRequest
+	(*ListRefundReasonsResponse)(nil),       // 56: order.ListRefundReasonsResponse
+	(*ArchivedOrder)(nil),                   // 57: order.ArchivedOrder
+	(*SearchArchivedOrdersRequest)(nil),     // 58: order.SearchArchivedOrdersRequest
+	(*SearchArchivedOrdersResponse)(nil),    // 59: order.SearchArchivedOrdersResponse
+	(*ReturnManifestItem)(nil),              // 60: order.ReturnManifestItem
+	(*ReturnManifest)(nil),                  // 61: order.ReturnManifest
+	(*CreateReturnManifestRequest)(nil),     // 62: order.CreateReturnManifestRequest
+	(*CreateReturnManifestResponse)(nil),    // 63: order.CreateReturnManifestResponse
+	(*GetReturnManifestRequest)(nil),        // 64: order.GetReturnManifestRequest
+	(*GetReturnManifestResponse)(nil),       // 65: order.GetReturnManifestResponse
+	(*ListReturnManifestsRequest)(nil),      // 66: order.ListReturnManifestsRequest
+	(*ListReturnManifestsResponse)(nil),     // 67: order.ListReturnManifestsResponse
+	(*ScanReturnManifestOrderRequest)(nil),  // 68: order.ScanReturnManifestOrderRequest
+	(*ScanReturnManifestOrderResponse)(nil), // 69: order.ScanReturnManifestOrderResponse
+	(*CloseReturnManifestRequest)(nil),      // 70: order.CloseReturnManifestRequest
+	(*CloseReturnManifestResponse)(nil),     // 71: order.CloseReturnManifestResponse
+	(*ExportReturnManifestRequest)(nil),     // 72: order.ExportReturnManifestRequest
+	(*ExportReturnManifestResponse)(nil),    // 73: order.ExportReturnManifestResponse
+	(*ShipmentItem)(nil),                    // 74: order.ShipmentItem
+	(*InboundShipment)(nil),                 // 75: order.InboundShipment
+	(*RejectedShipmentItem)(nil),            // 76: order.RejectedShipmentItem
+	(*ShipmentReport)(nil),                  // 77: order.ShipmentReport
+	(*RegisterShipmentRequest)(nil),         // 78: order.RegisterShipmentRequest
+	(*RegisterShipmentResponse)(nil),        // 79: order.RegisterShipmentResponse
+	(*GetShipmentRequest)(nil),              // 80: order.GetShipmentRequest
+	(*GetShipmentResponse)(nil),             // 81: order.GetShipmentResponse
+	(*ListShipmentsRequest)(nil),            // 82: order.ListShipmentsRequest
+	(*ListShipmentsResponse)(nil),           // 83: order.ListShipmentsResponse
+	(*ScanShipmentOrderRequest)(nil),        // 84: order.ScanShipmentOrderRequest
+	(*ScanShipmentOrderResponse)(nil),       // 85: order.ScanShipmentOrderResponse
+	(*CloseShipmentRequest)(nil),            // 86: order.CloseShipmentRequest
+	(*CloseShipmentResponse)(nil),           // 87: order.CloseShipmentResponse
+	(*IssuedPickupCode)(nil),                // 88: order.IssuedPickupCode
+	(*GetShipmentReportRequest)(nil),        // 89: order.GetShipmentReportRequest
+	(*GetShipmentReportResponse)(nil),       // 90: order.GetShipmentReportResponse
+	(*StocktakingResult)(nil),               // 91: order.StocktakingResult
+	(*StocktakingSession)(nil),              // 92: order.StocktakingSession
+	(*StartStocktakingRequest)(nil),         // 93: order.StartStocktakingRequest
+	(*StartStocktakingResponse)(nil),        // 94: order.StartStocktakingResponse
+	(*ScanStocktakingRequest)(nil),          // 95: order.ScanStocktakingRequest
+	(*ScanStocktakingResponse)(nil),         // 96: order.ScanStocktakingResponse
+	(*FinishStocktakingRequest)(nil),        // 97: order.FinishStocktakingRequest
+	(*FinishStocktakingResponse)(nil),       // 98: order.FinishStocktakingResponse
+	(*GetStocktakingRequest)(nil),           // 99: order.GetStocktakingRequest
+	(*GetStocktakingResponse)(nil),          // 100: order.GetStocktakingResponse
+	(*ListStocktakingsRequest)(nil),         // 101: order.ListStocktakingsRequest
+	(*ListStocktakingsResponse)(nil),        // 102: order.ListStocktakingsResponse
+	(*Incident)(nil),                        // 103: order.Incident
+	(*OpenIncidentRequest)(nil),             // 104: order.OpenIncidentRequest
+	(*OpenIncidentResponse)(nil),            // 105: order.OpenIncidentResponse
+	(*GetIncidentRequest)(nil),              // 106: order.GetIncidentRequest
+	(*GetIncidentResponse)(nil),             // 107: order.GetIncidentResponse
+	(*ListIncidentsRequest)(nil),            // 108: order.ListIncidentsRequest
+	(*ListIncidentsResponse)(nil),           // 109: order.ListIncidentsResponse
+	(*UpdateIncidentRequest)(nil),           // 110: order.UpdateIncidentRequest
+	(*UpdateIncidentResponse)(nil),          // 111: order.UpdateIncidentResponse
+	(*ResolveIncidentRequest)(nil),          // 112: order.ResolveIncidentRequest
+	(*ResolveIncidentResponse)(nil),         // 113: order.ResolveIncidentResponse
+	(*CancelIncidentRequest)(nil),           // 114: order.CancelIncidentRequest
+	(*CancelIncidentResponse)(nil),          // 115: order.CancelIncidentResponse
+	(*StorageTariff)(nil),                   // 116: order.StorageTariff
+	(*GetStorageTariffRequest)(nil),         // 117: order.GetStorageTariffRequest
+	(*GetStorageTariffResponse)(nil),        // 118: order.GetStorageTariffResponse
+	(*SetStorageTariffRequest)(nil),         // 119: order.SetStorageTariffRequest
+	(*SetStorageTariffResponse)(nil),        // 120: order.SetStorageTariffResponse
+	(*DeleteStorageTariffRequest)(nil),      // 121: order.DeleteStorageTariffRequest
+	(*DeleteStorageTariffResponse)(nil),     // 122: order.DeleteStorageTariffResponse
+	(*DailyRevenue)(nil),                    // 123: order.DailyRevenue
+	(*GetDailyRevenueRequest)(nil),          // 124: order.GetDailyRevenueRequest
+	(*GetDailyRevenueResponse)(nil),         // 125: order.GetDailyRevenueResponse
+	(*DeadLetter)(nil),                      // 126: order.DeadLetter
+	(*ListDeadLettersRequest)(nil),          // 127: order.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),         // 128: order.ListDeadLettersResponse
+	(*GetDeadLetterRequest)(nil),            // 129: order.GetDeadLetterRequest
+	(*GetDeadLetterResponse)(nil),           // 130: order.GetDeadLetterResponse
+	(*RequeueDeadLetterRequest)(nil),        // 131: order.RequeueDeadLetterRequest
+	(*RequeueDeadLetterResponse)(nil),       // 132: order.RequeueDeadLetterResponse
+	(*PurgeDeadLettersRequest)(nil),         // 133: order.PurgeDeadLettersRequest
+	(*PurgeDeadLettersResponse)(nil),        // 134: order.PurgeDeadLettersResponse
+	(*timestamppb.Timestamp)(nil),           // 135: google.protobuf.Timestamp
 }
 var file_order_service_proto_depIdxs = []int32{
-	11, // 0: order.CreateOrderRequest.expiration_time:type_name -> google.protobuf.Timestamp
-	11, // 1: order.Order.expiration_time:type_name -> google.protobuf.Timestamp
-	2,  // 2: order.GetOrderByIDResponse.order:type_name -> order.Order
-	2,  // 3: order.ListOrdersResponse.orders:type_name -> order.Order
-	0,  // 4: order.OrderService.ConfirmOrder:input_type -> order.CreateOrderRequest
-	3,  // 5: order.OrderService.GetOrderByID:input_type -> order.GetOrderByIDRequest
-	5,  // 6: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	7,  // 7: order.OrderService.ProcessOrder:input_type -> order.ProcessOrderRequest
-	9,  // 8: order.OrderService.ReturnOrder:input_type -> order.ReturnOrderRequest
-	1,  // 9: order.OrderService.ConfirmOrder:output_type -> order.CreateOrderResponse
-	4,  // 10: order.OrderService.GetOrderByID:output_type -> order.GetOrderByIDResponse
-	6,  // 11: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	8,  // 12: order.OrderService.ProcessOrder:output_type -> order.ProcessOrderResponse
-	10, // 13: order.OrderService.ReturnOrder:output_type -> order.ReturnOrderResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	135, // 0: order.CreateOrderRequest.expiration_time:type_name -> google.protobuf.Timestamp
+	0,   // 1: order.CreateOrderRequest.cost:type_name -> order.Money
+	135, // 2: order.Order.expiration_time:type_name -> google.protobuf.Timestamp
+	6,   // 3: order.Order.packaging:type_name -> order.PackagingLayer
+	0,   // 4: order.Order.cost:type_name -> order.Money
+	0,   // 5: order.Order.base_cost:type_name -> order.Money
+	0,   // 6: order.Order.packaging_cost:type_name -> order.Money
+	5,   // 7: order.Order.refund:type_name -> order.RefundDetails
+	4,   // 8: order.Order.storage_fee:type_name -> order.StorageFee
+	135, // 9: order.StorageFee.stored_since:type_name -> google.protobuf.Timestamp
+	0,   // 10: order.StorageFee.daily_rate:type_name -> order.Money
+	0,   // 11: order.StorageFee.amount:type_name -> order.Money
+	135, // 12: order.RefundDetails.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 13: order.PackagingLayer.cost:type_name -> order.Money
+	3,   // 14: order.GetOrderByIDResponse.order:type_name -> order.Order
+	3,   // 15: order.ListOrdersResponse.orders:type_name -> order.Order
+	16,  // 16: order.ListOrderTransitionsResponse.transitions:type_name -> order.OrderTransition
+	0,   // 17: order.Package.price:type_name -> order.Money
+	18,  // 18: order.ListPackagesResponse.packages:type_name -> order.Package
+	18,  // 19: order.UpsertPackageRequest.package:type_name -> order.Package
+	18,  // 20: order.UpsertPackageResponse.package:type_name -> order.Package
+	0,   // 21: order.QuoteOrderRequest.cost:type_name -> order.Money
+	6,   // 22: order.QuoteOrderResponse.packaging:type_name -> order.PackagingLayer
+	0,   // 23: order.QuoteOrderResponse.base_cost:type_name -> order.Money
+	0,   // 24: order.QuoteOrderResponse.packaging_cost:type_name -> order.Money
+	0,   // 25: order.QuoteOrderResponse.total_cost:type_name -> order.Money
+	0,   // 26: order.RecommendPackagingRequest.cost:type_name -> order.Money
+	6,   // 27: order.RecommendPackagingResponse.packaging:type_name -> order.PackagingLayer
+	0,   // 28: order.RecommendPackagingResponse.base_cost:type_name -> order.Money
+	0,   // 29: order.RecommendPackagingResponse.packaging_cost:type_name -> order.Money
+	0,   // 30: order.RecommendPackagingResponse.total_cost:type_name -> order.Money
+	27,  // 31: order.CreatePickupPointRequest.pickup_point:type_name -> order.PickupPoint
+	27,  // 32: order.CreatePickupPointResponse.pickup_point:type_name -> order.PickupPoint
+	27,  // 33: order.GetPickupPointResponse.pickup_point:type_name -> order.PickupPoint
+	27,  // 34: order.ListPickupPointsResponse.pickup_points:type_name -> order.PickupPoint
+	27,  // 35: order.UpdatePickupPointRequest.pickup_point:type_name -> order.PickupPoint
+	27,  // 36: order.UpdatePickupPointResponse.pickup_point:type_name -> order.PickupPoint
+	38,  // 37: order.CreateStorageCellRequest.storage_cell:type_name -> order.StorageCell
+	38,  // 38: order.CreateStorageCellResponse.storage_cell:type_name -> order.StorageCell
+	38,  // 39: order.ListStorageCellsResponse.storage_cells:type_name -> order.StorageCell
+	43,  // 40: order.GetStorageOccupancyResponse.occupancy:type_name -> order.StorageOccupancy
+	48,  // 41: order.CompleteOrdersRequest.orders:type_name -> order.PickupItem
+	50,  // 42: order.CompleteOrdersResponse.results:type_name -> order.BatchOrderResult
+	0,   // 43: order.CompleteOrdersResponse.totals:type_name -> order.Money
+	50,  // 44: order.RefundOrdersResponse.results:type_name -> order.BatchOrderResult
+	0,   // 45: order.RefundOrdersResponse.totals:type_name -> order.Money
+	54,  // 46: order.ListRefundReasonsResponse.reasons:type_name -> order.RefundReason
+	3,   // 47: order.ArchivedOrder.order:type_name -> order.Order
+	135, // 48: order.ArchivedOrder.archived_at:type_name -> google.protobuf.Timestamp
+	135, // 49: order.SearchArchivedOrdersRequest.archived_from:type_name -> google.protobuf.Timestamp
+	135, // 50: order.SearchArchivedOrdersRequest.archived_to:type_name -> google.protobuf.Timestamp
+	57,  // 51: order.SearchArchivedOrdersResponse.orders:type_name -> order.ArchivedOrder
+	135, // 52: order.ReturnManifestItem.scanned_at:type_name -> google.protobuf.Timestamp
+	135, // 53: order.ReturnManifest.created_at:type_name -> google.protobuf.Timestamp
+	135, // 54: order.ReturnManifest.closed_at:type_name -> google.protobuf.Timestamp
+	60,  // 55: order.ReturnManifest.items:type_name -> order.ReturnManifestItem
+	61,  // 56: order.CreateReturnManifestResponse.manifest:type_name -> order.ReturnManifest
+	61,  // 57: order.GetReturnManifestResponse.manifest:type_name -> order.ReturnManifest
+	61,  // 58: order.ListReturnManifestsResponse.manifests:type_name -> order.ReturnManifest
+	61,  // 59: order.ScanReturnManifestOrderResponse.manifest:type_name -> order.ReturnManifest
+	61,  // 60: order.CloseReturnManifestResponse.manifest:type_name -> order.ReturnManifest
+	135, // 61: order.ShipmentItem.expiration_time:type_name -> google.protobuf.Timestamp
+	0,   // 62: order.ShipmentItem.cost:type_name -> order.Money
+	135, // 63: order.InboundShipment.created_at:type_name -> google.protobuf.Timestamp
+	135, // 64: order.InboundShipment.closed_at:type_name -> google.protobuf.Timestamp
+	74,  // 65: order.InboundShipment.items:type_name -> order.ShipmentItem
+	76,  // 66: order.ShipmentReport.rejected:type_name -> order.RejectedShipmentItem
+	135, // 67: order.ShipmentReport.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 68: order.RegisterShipmentRequest.items:type_name -> order.ShipmentItem
+	75,  // 69: order.RegisterShipmentResponse.shipment:type_name -> order.InboundShipment
+	75,  // 70: order.GetShipmentResponse.shipment:type_name -> order.InboundShipment
+	75,  // 71: order.ListShipmentsResponse.shipments:type_name -> order.InboundShipment
+	75,  // 72: order.ScanShipmentOrderResponse.shipment:type_name -> order.InboundShipment
+	77,  // 73: order.CloseShipmentResponse.report:type_name -> order.ShipmentReport
+	88,  // 74: order.CloseShipmentResponse.pickup_codes:type_name -> order.IssuedPickupCode
+	77,  // 75: order.GetShipmentReportResponse.report:type_name -> order.ShipmentReport
+	135, // 76: order.StocktakingSession.started_at:type_name -> google.protobuf.Timestamp
+	135, // 77: order.StocktakingSession.finished_at:type_name -> google.protobuf.Timestamp
+	91,  // 78: order.StocktakingSession.result:type_name -> order.StocktakingResult
+	92,  // 79: order.StartStocktakingResponse.session:type_name -> order.StocktakingSession
+	92,  // 80: order.ScanStocktakingResponse.session:type_name -> order.StocktakingSession
+	92,  // 81: order.FinishStocktakingResponse.session:type_name -> order.StocktakingSession
+	92,  // 82: order.GetStocktakingResponse.session:type_name -> order.StocktakingSession
+	92,  // 83: order.ListStocktakingsResponse.sessions:type_name -> order.StocktakingSession
+	135, // 84: order.Incident.created_at:type_name -> google.protobuf.Timestamp
+	135, // 85: order.Incident.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 86: order.Incident.resolved_at:type_name -> google.protobuf.Timestamp
+	103, // 87: order.OpenIncidentResponse.incident:type_name -> order.Incident
+	103, // 88: order.GetIncidentResponse.incident:type_name -> order.Incident
+	103, // 89: order.ListIncidentsResponse.incidents:type_name -> order.Incident
+	103, // 90: order.UpdateIncidentResponse.incident:type_name -> order.Incident
+	103, // 91: order.ResolveIncidentResponse.incident:type_name -> order.Incident
+	103, // 92: order.CancelIncidentResponse.incident:type_name -> order.Incident
+	0,   // 93: order.StorageTariff.daily_rate:type_name -> order.Money
+	116, // 94: order.GetStorageTariffResponse.tariff:type_name -> order.StorageTariff
+	0,   // 95: order.SetStorageTariffRequest.daily_rate:type_name -> order.Money
+	116, // 96: order.SetStorageTariffResponse.tariff:type_name -> order.StorageTariff
+	135, // 97: order.DailyRevenue.date:type_name -> google.protobuf.Timestamp
+	0,   // 98: order.DailyRevenue.orders_revenue:type_name -> order.Money
+	0,   // 99: order.DailyRevenue.storage_fees:type_name -> order.Money
+	0,   // 100: order.DailyRevenue.total:type_name -> order.Money
+	135, // 101: order.GetDailyRevenueRequest.from:type_name -> google.protobuf.Timestamp
+	135, // 102: order.GetDailyRevenueRequest.to:type_name -> google.protobuf.Timestamp
+	123, // 103: order.GetDailyRevenueResponse.days:type_name -> order.DailyRevenue
+	135, // 104: order.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	135, // 105: order.DeadLetter.dead_at:type_name -> google.protobuf.Timestamp
+	126, // 106: order.ListDeadLettersResponse.dead_letters:type_name -> order.DeadLetter
+	126, // 107: order.GetDeadLetterResponse.dead_letter:type_name -> order.DeadLetter
+	135, // 108: order.PurgeDeadLettersRequest.dead_before:type_name -> google.protobuf.Timestamp
+	1,   // 109: order.OrderService.ConfirmOrder:input_type -> order.CreateOrderRequest
+	7,   // 110: order.OrderService.GetOrderByID:input_type -> order.GetOrderByIDRequest
+	9,   // 111: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	11,  // 112: order.OrderService.ProcessOrder:input_type -> order.ProcessOrderRequest
+	13,  // 113: order.OrderService.ReturnOrder:input_type -> order.ReturnOrderRequest
+	15,  // 114: order.OrderService.ListOrderTransitions:input_type -> order.ListOrderTransitionsRequest
+	19,  // 115: order.OrderService.ListPackages:input_type -> order.ListPackagesRequest
+	21,  // 116: order.OrderService.UpsertPackage:input_type -> order.UpsertPackageRequest
+	23,  // 117: order.OrderService.QuoteOrder:input_type -> order.QuoteOrderRequest
+	25,  // 118: order.OrderService.RecommendPackaging:input_type -> order.RecommendPackagingRequest
+	28,  // 119: order.OrderService.CreatePickupPoint:input_type -> order.CreatePickupPointRequest
+	30,  // 120: order.OrderService.GetPickupPoint:input_type -> order.GetPickupPointRequest
+	32,  // 121: order.OrderService.ListPickupPoints:input_type -> order.ListPickupPointsRequest
+	34,  // 122: order.OrderService.UpdatePickupPoint:input_type -> order.UpdatePickupPointRequest
+	36,  // 123: order.OrderService.DeletePickupPoint:input_type -> order.DeletePickupPointRequest
+	39,  // 124: order.OrderService.CreateStorageCell:input_type -> order.CreateStorageCellRequest
+	41,  // 125: order.OrderService.ListStorageCells:input_type -> order.ListStorageCellsRequest
+	44,  // 126: order.OrderService.GetStorageOccupancy:input_type -> order.GetStorageOccupancyRequest
+	46,  // 127: order.OrderService.IssuePickupCode:input_type -> order.IssuePickupCodeRequest
+	49,  // 128: order.OrderService.CompleteOrders:input_type -> order.CompleteOrdersRequest
+	52,  // 129: order.OrderService.RefundOrders:input_type -> order.RefundOrdersRequest
+	55,  // 130: order.OrderService.ListRefundReasons:input_type -> order.ListRefundReasonsRequest
+	58,  // 131: order.OrderService.SearchArchivedOrders:input_type -> order.SearchArchivedOrdersRequest
+	62,  // 132: order.OrderService.CreateReturnManifest:input_type -> order.CreateReturnManifestRequest
+	64,  // 133: order.OrderService.GetReturnManifest:input_type -> order.GetReturnManifestRequest
+	66,  // 134: order.OrderService.ListReturnManifests:input_type -> order.ListReturnManifestsRequest
+	68,  // 135: order.OrderService.ScanReturnManifestOrder:input_type -> order.ScanReturnManifestOrderRequest
+	70,  // 136: order.OrderService.CloseReturnManifest:input_type -> order.CloseReturnManifestRequest
+	72,  // 137: order.OrderService.ExportReturnManifest:input_type -> order.ExportReturnManifestRequest
+	78,  // 138: order.OrderService.RegisterShipment:input_type -> order.RegisterShipmentRequest
+	80,  // 139: order.OrderService.GetShipment:input_type -> order.GetShipmentRequest
+	82,  // 140: order.OrderService.ListShipments:input_type -> order.ListShipmentsRequest
+	84,  // 141: order.OrderService.ScanShipmentOrder:input_type -> order.ScanShipmentOrderRequest
+	86,  // 142: order.OrderService.CloseShipment:input_type -> order.CloseShipmentRequest
+	89,  // 143: order.OrderService.GetShipmentReport:input_type -> order.GetShipmentReportRequest
+	93,  // 144: order.OrderService.StartStocktaking:input_type -> order.StartStocktakingRequest
+	95,  // 145: order.OrderService.ScanStocktaking:input_type -> order.ScanStocktakingRequest
+	97,  // 146: order.OrderService.FinishStocktaking:input_type -> order.FinishStocktakingRequest
+	99,  // 147: order.OrderService.GetStocktaking:input_type -> order.GetStocktakingRequest
+	101, // 148: order.OrderService.ListStocktakings:input_type -> order.ListStocktakingsRequest
+	104, // 149: order.OrderService.OpenIncident:input_type -> order.OpenIncidentRequest
+	106, // 150: order.OrderService.GetIncident:input_type -> order.GetIncidentRequest
+	108, // 151: order.OrderService.ListIncidents:input_type -> order.ListIncidentsRequest
+	110, // 152: order.OrderService.UpdateIncident:input_type -> order.UpdateIncidentRequest
+	112, // 153: order.OrderService.ResolveIncident:input_type -> order.ResolveIncidentRequest
+	114, // 154: order.OrderService.CancelIncident:input_type -> order.CancelIncidentRequest
+	117, // 155: order.OrderService.GetStorageTariff:input_type -> order.GetStorageTariffRequest
+	119, // 156: order.OrderService.SetStorageTariff:input_type -> order.SetStorageTariffRequest
+	121, // 157: order.OrderService.DeleteStorageTariff:input_type -> order.DeleteStorageTariffRequest
+	124, // 158: order.OrderService.GetDailyRevenue:input_type -> order.GetDailyRevenueRequest
+	127, // 159: order.OrderService.ListDeadLetters:input_type -> order.ListDeadLettersRequest
+	129, // 160: order.OrderService.GetDeadLetter:input_type -> order.GetDeadLetterRequest
+	131, // 161: order.OrderService.RequeueDeadLetter:input_type -> order.RequeueDeadLetterRequest
+	133, // 162: order.OrderService.PurgeDeadLetters:input_type -> order.PurgeDeadLettersRequest
+	2,   // 163: order.OrderService.ConfirmOrder:output_type -> order.CreateOrderResponse
+	8,   // 164: order.OrderService.GetOrderByID:output_type -> order.GetOrderByIDResponse
+	10,  // 165: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	12,  // 166: order.OrderService.ProcessOrder:output_type -> order.ProcessOrderResponse
+	14,  // 167: order.OrderService.ReturnOrder:output_type -> order.ReturnOrderResponse
+	17,  // 168: order.OrderService.ListOrderTransitions:output_type -> order.ListOrderTransitionsResponse
+	20,  // 169: order.OrderService.ListPackages:output_type -> order.ListPackagesResponse
+	22,  // 170: order.OrderService.UpsertPackage:output_type -> order.UpsertPackageResponse
+	24,  // 171: order.OrderService.QuoteOrder:output_type -> order.QuoteOrderResponse
+	26,  // 172: order.OrderService.RecommendPackaging:output_type -> order.RecommendPackagingResponse
+	29,  // 173: order.OrderService.CreatePickupPoint:output_type -> order.CreatePickupPointResponse
+	31,  // 174: order.OrderService.GetPickupPoint:output_type -> order.GetPickupPointResponse
+	33,  // 175: order.OrderService.ListPickupPoints:output_type -> order.ListPickupPointsResponse
+	35,  // 176: order.OrderService.UpdatePickupPoint:output_type -> order.UpdatePickupPointResponse
+	37,  // 177: order.OrderService.DeletePickupPoint:output_type -> order.DeletePickupPointResponse
+	40,  // 178: order.OrderService.CreateStorageCell:output_type -> order.CreateStorageCellResponse
+	42,  // 179: order.OrderService.ListStorageCells:output_type -> order.ListStorageCellsResponse
+	45,  // 180: order.OrderService.GetStorageOccupancy:output_type -> order.GetStorageOccupancyResponse
+	47,  // 181: order.OrderService.IssuePickupCode:output_type -> order.IssuePickupCodeResponse
+	51,  // 182: order.OrderService.CompleteOrders:output_type -> order.CompleteOrdersResponse
+	53,  // 183: order.OrderService.RefundOrders:output_type -> order.RefundOrdersResponse
+	56,  // 184: order.OrderService.ListRefundReasons:output_type -> order.ListRefundReasonsResponse
+	59,  // 185: order.OrderService.SearchArchivedOrders:output_type -> order.SearchArchivedOrdersResponse
+	63,  // 186: order.OrderService.CreateReturnManifest:output_type -> order.CreateReturnManifestResponse
+	65,  // 187: order.OrderService.GetReturnManifest:output_type -> order.GetReturnManifestResponse
+	67,  // 188: order.OrderService.ListReturnManifests:output_type -> order.ListReturnManifestsResponse
+	69,  // 189: order.OrderService.ScanReturnManifestOrder:output_type -> order.ScanReturnManifestOrderResponse
+	71,  // 190: order.OrderService.CloseReturnManifest:output_type -> order.CloseReturnManifestResponse
+	73,  // 191: order.OrderService.ExportReturnManifest:output_type -> order.ExportReturnManifestResponse
+	79,  // 192: order.OrderService.RegisterShipment:output_type -> order.RegisterShipmentResponse
+	81,  // 193: order.OrderService.GetShipment:output_type -> order.GetShipmentResponse
+	83,  // 194: order.OrderService.ListShipments:output_type -> order.ListShipmentsResponse
+	85,  // 195: order.OrderService.ScanShipmentOrder:output_type -> order.ScanShipmentOrderResponse
+	87,  // 196: order.OrderService.CloseShipment:output_type -> order.CloseShipmentResponse
+	90,  // 197: order.OrderService.GetShipmentReport:output_type -> order.GetShipmentReportResponse
+	94,  // 198: order.OrderService.StartStocktaking:output_type -> order.StartStocktakingResponse
+	96,  // 199: order.OrderService.ScanStocktaking:output_type -> order.ScanStocktakingResponse
+	98,  // 200: order.OrderService.FinishStocktaking:output_type -> order.FinishStocktakingResponse
+	100, // 201: order.OrderService.GetStocktaking:output_type -> order.GetStocktakingResponse
+	102, // 202: order.OrderService.ListStocktakings:output_type -> order.ListStocktakingsResponse
+	105, // 203: order.OrderService.OpenIncident:output_type -> order.OpenIncidentResponse
+	107, // 204: order.OrderService.GetIncident:output_type -> order.GetIncidentResponse
+	109, // 205: order.OrderService.ListIncidents:output_type -> order.ListIncidentsResponse
+	111, // 206: order.OrderService.UpdateIncident:output_type -> order.UpdateIncidentResponse
+	113, // 207: order.OrderService.ResolveIncident:output_type -> order.ResolveIncidentResponse
+	115, // 208: order.OrderService.CancelIncident:output_type -> order.CancelIncidentResponse
+	118, // 209: order.OrderService.GetStorageTariff:output_type -> order.GetStorageTariffResponse
+	120, // 210: order.OrderService.SetStorageTariff:output_type -> order.SetStorageTariffResponse
+	122, // 211: order.OrderService.DeleteStorageTariff:output_type -> order.DeleteStorageTariffResponse
+	125, // 212: order.OrderService.GetDailyRevenue:output_type -> order.GetDailyRevenueResponse
+	128, // 213: order.OrderService.ListDeadLetters:output_type -> order.ListDeadLettersResponse
+	130, // 214: order.OrderService.GetDeadLetter:output_type -> order.GetDeadLetterResponse
+	132, // 215: order.OrderService.RequeueDeadLetter:output_type -> order.RequeueDeadLetterResponse
+	134, // 216: order.OrderService.PurgeDeadLetters:output_type -> order.PurgeDeadLettersResponse
+	163, // [163:217] is the sub-list for method output_type
+	109, // [109:163] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_order_service_proto_init() }
@@ -737,7 +8670,7 @@ func file_order_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_service_proto_rawDesc), len(file_order_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   135,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,60 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_ConfirmOrder_FullMethodName = "/order.OrderService/ConfirmOrder"
-	OrderService_GetOrderByID_FullMethodName = "/order.OrderService/GetOrderByID"
-	OrderService_ListOrders_FullMethodName   = "/order.OrderService/ListOrders"
-	OrderService_ProcessOrder_FullMethodName = "/order.OrderService/ProcessOrder"
-	OrderService_ReturnOrder_FullMethodName  = "/order.OrderService/ReturnOrder"
+	OrderService_ConfirmOrder_FullMethodName            = "/order.OrderService/ConfirmOrder"
+	OrderService_GetOrderByID_FullMethodName            = "/order.OrderService/GetOrderByID"
+	OrderService_ListOrders_FullMethodName              = "/order.OrderService/ListOrders"
+	OrderService_ProcessOrder_FullMethodName            = "/order.OrderService/ProcessOrder"
+	OrderService_ReturnOrder_FullMethodName             = "/order.OrderService/ReturnOrder"
+	OrderService_ListOrderTransitions_FullMethodName    = "/order.OrderService/ListOrderTransitions"
+	OrderService_ListPackages_FullMethodName            = "/order.OrderService/ListPackages"
+	OrderService_UpsertPackage_FullMethodName           = "/order.OrderService/UpsertPackage"
+	OrderService_QuoteOrder_FullMethodName              = "/order.OrderService/QuoteOrder"
+	OrderService_RecommendPackaging_FullMethodName      = "/order.OrderService/RecommendPackaging"
+	OrderService_CreatePickupPoint_FullMethodName       = "/order.OrderService/CreatePickupPoint"
+	OrderService_GetPickupPoint_FullMethodName          = "/order.OrderService/GetPickupPoint"
+	OrderService_ListPickupPoints_FullMethodName        = "/order.OrderService/ListPickupPoints"
+	OrderService_UpdatePickupPoint_FullMethodName       = "/order.OrderService/UpdatePickupPoint"
+	OrderService_DeletePickupPoint_FullMethodName       = "/order.OrderService/DeletePickupPoint"
+	OrderService_CreateStorageCell_FullMethodName       = "/order.OrderService/CreateStorageCell"
+	OrderService_ListStorageCells_FullMethodName        = "/order.OrderService/ListStorageCells"
+	OrderService_GetStorageOccupancy_FullMethodName     = "/order.OrderService/GetStorageOccupancy"
+	OrderService_IssuePickupCode_FullMethodName         = "/order.OrderService/IssuePickupCode"
+	OrderService_CompleteOrders_FullMethodName          = "/order.OrderService/CompleteOrders"
+	OrderService_RefundOrders_FullMethodName            = "/order.OrderService/RefundOrders"
+	OrderService_ListRefundReasons_FullMethodName       = "/order.OrderService/ListRefundReasons"
+	OrderService_SearchArchivedOrders_FullMethodName    = "/order.OrderService/SearchArchivedOrders"
+	OrderService_CreateReturnManifest_FullMethodName    = "/order.OrderService/CreateReturnManifest"
+	OrderService_GetReturnManifest_FullMethodName       = "/order.OrderService/GetReturnManifest"
+	OrderService_ListReturnManifests_FullMethodName     = "/order.OrderService/ListReturnManifests"
+	OrderService_ScanReturnManifestOrder_FullMethodName = "/order.OrderService/ScanReturnManifestOrder"
+	OrderService_CloseReturnManifest_FullMethodName     = "/order.OrderService/CloseReturnManifest"
+	OrderService_ExportReturnManifest_FullMethodName    = "/order.OrderService/ExportReturnManifest"
+	OrderService_RegisterShipment_FullMethodName        = "/order.OrderService/RegisterShipment"
+	OrderService_GetShipment_FullMethodName             = "/order.OrderService/GetShipment"
+	OrderService_ListShipments_FullMethodName           = "/order.OrderService/ListShipments"
+	OrderService_ScanShipmentOrder_FullMethodName       = "/order.OrderService/ScanShipmentOrder"
+	OrderService_CloseShipment_FullMethodName           = "/order.OrderService/CloseShipment"
+	OrderService_GetShipmentReport_FullMethodName       = "/order.OrderService/GetShipmentReport"
+	OrderService_StartStocktaking_FullMethodName        = "/order.OrderService/StartStocktaking"
+	OrderService_ScanStocktaking_FullMethodName         = "/order.OrderService/ScanStocktaking"
+	OrderService_FinishStocktaking_FullMethodName       = "/order.OrderService/FinishStocktaking"
+	OrderService_GetStocktaking_FullMethodName          = "/order.OrderService/GetStocktaking"
+	OrderService_ListStocktakings_FullMethodName        = "/order.OrderService/ListStocktakings"
+	OrderService_OpenIncident_FullMethodName            = "/order.OrderService/OpenIncident"
+	OrderService_GetIncident_FullMethodName             = "/order.OrderService/GetIncident"
+	OrderService_ListIncidents_FullMethodName           = "/order.OrderService/ListIncidents"
+	OrderService_UpdateIncident_FullMethodName          = "/order.OrderService/UpdateIncident"
+	OrderService_ResolveIncident_FullMethodName         = "/order.OrderService/ResolveIncident"
+	OrderService_CancelIncident_FullMethodName          = "/order.OrderService/CancelIncident"
+	OrderService_GetStorageTariff_FullMethodName        = "/order.OrderService/GetStorageTariff"
+	OrderService_SetStorageTariff_FullMethodName        = "/order.OrderService/SetStorageTariff"
+	OrderService_DeleteStorageTariff_FullMethodName     = "/order.OrderService/DeleteStorageTariff"
+	OrderService_GetDailyRevenue_FullMethodName         = "/order.OrderService/GetDailyRevenue"
+	OrderService_ListDeadLetters_FullMethodName         = "/order.OrderService/ListDeadLetters"
+	OrderService_GetDeadLetter_FullMethodName           = "/order.OrderService/GetDeadLetter"
+	OrderService_RequeueDeadLetter_FullMethodName       = "/order.OrderService/RequeueDeadLetter"
+	OrderService_PurgeDeadLetters_FullMethodName        = "/order.OrderService/PurgeDeadLetters"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -35,6 +84,55 @@ type OrderServiceClient interface {
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	ProcessOrder(ctx context.Context, in *ProcessOrderRequest, opts ...grpc.CallOption) (*ProcessOrderResponse, error)
 	ReturnOrder(ctx context.Context, in *ReturnOrderRequest, opts ...grpc.CallOption) (*ReturnOrderResponse, error)
+	ListOrderTransitions(ctx context.Context, in *ListOrderTransitionsRequest, opts ...grpc.CallOption) (*ListOrderTransitionsResponse, error)
+	ListPackages(ctx context.Context, in *ListPackagesRequest, opts ...grpc.CallOption) (*ListPackagesResponse, error)
+	UpsertPackage(ctx context.Context, in *UpsertPackageRequest, opts ...grpc.CallOption) (*UpsertPackageResponse, error)
+	QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error)
+	RecommendPackaging(ctx context.Context, in *RecommendPackagingRequest, opts ...grpc.CallOption) (*RecommendPackagingResponse, error)
+	CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*CreatePickupPointResponse, error)
+	GetPickupPoint(ctx context.Context, in *GetPickupPointRequest, opts ...grpc.CallOption) (*GetPickupPointResponse, error)
+	ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error)
+	UpdatePickupPoint(ctx context.Context, in *UpdatePickupPointRequest, opts ...grpc.CallOption) (*UpdatePickupPointResponse, error)
+	DeletePickupPoint(ctx context.Context, in *DeletePickupPointRequest, opts ...grpc.CallOption) (*DeletePickupPointResponse, error)
+	CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*CreateStorageCellResponse, error)
+	ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*ListStorageCellsResponse, error)
+	GetStorageOccupancy(ctx context.Context, in *GetStorageOccupancyRequest, opts ...grpc.CallOption) (*GetStorageOccupancyResponse, error)
+	IssuePickupCode(ctx context.Context, in *IssuePickupCodeRequest, opts ...grpc.CallOption) (*IssuePickupCodeResponse, error)
+	CompleteOrders(ctx context.Context, in *CompleteOrdersRequest, opts ...grpc.CallOption) (*CompleteOrdersResponse, error)
+	RefundOrders(ctx context.Context, in *RefundOrdersRequest, opts ...grpc.CallOption) (*RefundOrdersResponse, error)
+	ListRefundReasons(ctx context.Context, in *ListRefundReasonsRequest, opts ...grpc.CallOption) (*ListRefundReasonsResponse, error)
+	SearchArchivedOrders(ctx context.Context, in *SearchArchivedOrdersRequest, opts ...grpc.CallOption) (*SearchArchivedOrdersResponse, error)
+	CreateReturnManifest(ctx context.Context, in *CreateReturnManifestRequest, opts ...grpc.CallOption) (*CreateReturnManifestResponse, error)
+	GetReturnManifest(ctx context.Context, in *GetReturnManifestRequest, opts ...grpc.CallOption) (*GetReturnManifestResponse, error)
+	ListReturnManifests(ctx context.Context, in *ListReturnManifestsRequest, opts ...grpc.CallOption) (*ListReturnManifestsResponse, error)
+	ScanReturnManifestOrder(ctx context.Context, in *ScanReturnManifestOrderRequest, opts ...grpc.CallOption) (*ScanReturnManifestOrderResponse, error)
+	CloseReturnManifest(ctx context.Context, in *CloseReturnManifestRequest, opts ...grpc.CallOption) (*CloseReturnManifestResponse, error)
+	ExportReturnManifest(ctx context.Context, in *ExportReturnManifestRequest, opts ...grpc.CallOption) (*ExportReturnManifestResponse, error)
+	RegisterShipment(ctx context.Context, in *RegisterShipmentRequest, opts ...grpc.CallOption) (*RegisterShipmentResponse, error)
+	GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*GetShipmentResponse, error)
+	ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error)
+	ScanShipmentOrder(ctx context.Context, in *ScanShipmentOrderRequest, opts ...grpc.CallOption) (*ScanShipmentOrderResponse, error)
+	CloseShipment(ctx context.Context, in *CloseShipmentRequest, opts ...grpc.CallOption) (*CloseShipmentResponse, error)
+	GetShipmentReport(ctx context.Context, in *GetShipmentReportRequest, opts ...grpc.CallOption) (*GetShipmentReportResponse, error)
+	StartStocktaking(ctx context.Context, in *StartStocktakingRequest, opts ...grpc.CallOption) (*StartStocktakingResponse, error)
+	ScanStocktaking(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ScanStocktakingRequest, ScanStocktakingResponse], error)
+	FinishStocktaking(ctx context.Context, in *FinishStocktakingRequest, opts ...grpc.CallOption) (*FinishStocktakingResponse, error)
+	GetStocktaking(ctx context.Context, in *GetStocktakingRequest, opts ...grpc.CallOption) (*GetStocktakingResponse, error)
+	ListStocktakings(ctx context.Context, in *ListStocktakingsRequest, opts ...grpc.CallOption) (*ListStocktakingsResponse, error)
+	OpenIncident(ctx context.Context, in *OpenIncidentRequest, opts ...grpc.CallOption) (*OpenIncidentResponse, error)
+	GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*GetIncidentResponse, error)
+	ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error)
+	UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*UpdateIncidentResponse, error)
+	ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*ResolveIncidentResponse, error)
+	CancelIncident(ctx context.Context, in *CancelIncidentRequest, opts ...grpc.CallOption) (*CancelIncidentResponse, error)
+	GetStorageTariff(ctx context.Context, in *GetStorageTariffRequest, opts ...grpc.CallOption) (*GetStorageTariffResponse, error)
+	SetStorageTariff(ctx context.Context, in *SetStorageTariffRequest, opts ...grpc.CallOption) (*SetStorageTariffResponse, error)
+	DeleteStorageTariff(ctx context.Context, in *DeleteStorageTariffRequest, opts ...grpc.CallOption) (*DeleteStorageTariffResponse, error)
+	GetDailyRevenue(ctx context.Context, in *GetDailyRevenueRequest, opts ...grpc.CallOption) (*GetDailyRevenueResponse, error)
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*GetDeadLetterResponse, error)
+	RequeueDeadLetter(ctx context.Context, in *RequeueDeadLetterRequest, opts ...grpc.CallOption) (*RequeueDeadLetterResponse, error)
+	PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersResponse, error)
 }
 
 type orderServiceClient struct {
@@ -95,6 +193,499 @@ func (c *orderServiceClient) ReturnOrder(ctx context.Context, in *ReturnOrderReq
 	return out, nil
 }
 
+func (c *orderServiceClient) ListOrderTransitions(ctx context.Context, in *ListOrderTransitionsRequest, opts ...grpc.CallOption) (*ListOrderTransitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrderTransitionsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListOrderTransitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListPackages(ctx context.Context, in *ListPackagesRequest, opts ...grpc.CallOption) (*ListPackagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPackagesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListPackages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpsertPackage(ctx context.Context, in *UpsertPackageRequest, opts ...grpc.CallOption) (*UpsertPackageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpsertPackageResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpsertPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) QuoteOrder(ctx context.Context, in *QuoteOrderRequest, opts ...grpc.CallOption) (*QuoteOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_QuoteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RecommendPackaging(ctx context.Context, in *RecommendPackagingRequest, opts ...grpc.CallOption) (*RecommendPackagingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendPackagingResponse)
+	err := c.cc.Invoke(ctx, OrderService_RecommendPackaging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreatePickupPoint(ctx context.Context, in *CreatePickupPointRequest, opts ...grpc.CallOption) (*CreatePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePickupPointResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPickupPoint(ctx context.Context, in *GetPickupPointRequest, opts ...grpc.CallOption) (*GetPickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPickupPointResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetPickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListPickupPoints(ctx context.Context, in *ListPickupPointsRequest, opts ...grpc.CallOption) (*ListPickupPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPickupPointsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListPickupPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdatePickupPoint(ctx context.Context, in *UpdatePickupPointRequest, opts ...grpc.CallOption) (*UpdatePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePickupPointResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpdatePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeletePickupPoint(ctx context.Context, in *DeletePickupPointRequest, opts ...grpc.CallOption) (*DeletePickupPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePickupPointResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeletePickupPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateStorageCell(ctx context.Context, in *CreateStorageCellRequest, opts ...grpc.CallOption) (*CreateStorageCellResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStorageCellResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateStorageCell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListStorageCells(ctx context.Context, in *ListStorageCellsRequest, opts ...grpc.CallOption) (*ListStorageCellsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStorageCellsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListStorageCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetStorageOccupancy(ctx context.Context, in *GetStorageOccupancyRequest, opts ...grpc.CallOption) (*GetStorageOccupancyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorageOccupancyResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetStorageOccupancy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) IssuePickupCode(ctx context.Context, in *IssuePickupCodeRequest, opts ...grpc.CallOption) (*IssuePickupCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssuePickupCodeResponse)
+	err := c.cc.Invoke(ctx, OrderService_IssuePickupCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CompleteOrders(ctx context.Context, in *CompleteOrdersRequest, opts ...grpc.CallOption) (*CompleteOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_CompleteOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundOrders(ctx context.Context, in *RefundOrdersRequest, opts ...grpc.CallOption) (*RefundOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListRefundReasons(ctx context.Context, in *ListRefundReasonsRequest, opts ...grpc.CallOption) (*ListRefundReasonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundReasonsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListRefundReasons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SearchArchivedOrders(ctx context.Context, in *SearchArchivedOrdersRequest, opts ...grpc.CallOption) (*SearchArchivedOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArchivedOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderService_SearchArchivedOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateReturnManifest(ctx context.Context, in *CreateReturnManifestRequest, opts ...grpc.CallOption) (*CreateReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReturnManifestResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetReturnManifest(ctx context.Context, in *GetReturnManifestRequest, opts ...grpc.CallOption) (*GetReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReturnManifestResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListReturnManifests(ctx context.Context, in *ListReturnManifestsRequest, opts ...grpc.CallOption) (*ListReturnManifestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReturnManifestsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListReturnManifests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ScanReturnManifestOrder(ctx context.Context, in *ScanReturnManifestOrderRequest, opts ...grpc.CallOption) (*ScanReturnManifestOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanReturnManifestOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_ScanReturnManifestOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CloseReturnManifest(ctx context.Context, in *CloseReturnManifestRequest, opts ...grpc.CallOption) (*CloseReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseReturnManifestResponse)
+	err := c.cc.Invoke(ctx, OrderService_CloseReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ExportReturnManifest(ctx context.Context, in *ExportReturnManifestRequest, opts ...grpc.CallOption) (*ExportReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportReturnManifestResponse)
+	err := c.cc.Invoke(ctx, OrderService_ExportReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RegisterShipment(ctx context.Context, in *RegisterShipmentRequest, opts ...grpc.CallOption) (*RegisterShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterShipmentResponse)
+	err := c.cc.Invoke(ctx, OrderService_RegisterShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetShipment(ctx context.Context, in *GetShipmentRequest, opts ...grpc.CallOption) (*GetShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShipmentResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShipmentsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListShipments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ScanShipmentOrder(ctx context.Context, in *ScanShipmentOrderRequest, opts ...grpc.CallOption) (*ScanShipmentOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanShipmentOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_ScanShipmentOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CloseShipment(ctx context.Context, in *CloseShipmentRequest, opts ...grpc.CallOption) (*CloseShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseShipmentResponse)
+	err := c.cc.Invoke(ctx, OrderService_CloseShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetShipmentReport(ctx context.Context, in *GetShipmentReportRequest, opts ...grpc.CallOption) (*GetShipmentReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShipmentReportResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetShipmentReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) StartStocktaking(ctx context.Context, in *StartStocktakingRequest, opts ...grpc.CallOption) (*StartStocktakingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartStocktakingResponse)
+	err := c.cc.Invoke(ctx, OrderService_StartStocktaking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ScanStocktaking(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ScanStocktakingRequest, ScanStocktakingResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_ScanStocktaking_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ScanStocktakingRequest, ScanStocktakingResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ScanStocktakingClient = grpc.ClientStreamingClient[ScanStocktakingRequest, ScanStocktakingResponse]
+
+func (c *orderServiceClient) FinishStocktaking(ctx context.Context, in *FinishStocktakingRequest, opts ...grpc.CallOption) (*FinishStocktakingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishStocktakingResponse)
+	err := c.cc.Invoke(ctx, OrderService_FinishStocktaking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetStocktaking(ctx context.Context, in *GetStocktakingRequest, opts ...grpc.CallOption) (*GetStocktakingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStocktakingResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetStocktaking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListStocktakings(ctx context.Context, in *ListStocktakingsRequest, opts ...grpc.CallOption) (*ListStocktakingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStocktakingsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListStocktakings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) OpenIncident(ctx context.Context, in *OpenIncidentRequest, opts ...grpc.CallOption) (*OpenIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenIncidentResponse)
+	err := c.cc.Invoke(ctx, OrderService_OpenIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetIncident(ctx context.Context, in *GetIncidentRequest, opts ...grpc.CallOption) (*GetIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncidentResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListIncidents(ctx context.Context, in *ListIncidentsRequest, opts ...grpc.CallOption) (*ListIncidentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncidentsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListIncidents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdateIncident(ctx context.Context, in *UpdateIncidentRequest, opts ...grpc.CallOption) (*UpdateIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateIncidentResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpdateIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ResolveIncident(ctx context.Context, in *ResolveIncidentRequest, opts ...grpc.CallOption) (*ResolveIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveIncidentResponse)
+	err := c.cc.Invoke(ctx, OrderService_ResolveIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelIncident(ctx context.Context, in *CancelIncidentRequest, opts ...grpc.CallOption) (*CancelIncidentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelIncidentResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelIncident_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetStorageTariff(ctx context.Context, in *GetStorageTariffRequest, opts ...grpc.CallOption) (*GetStorageTariffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorageTariffResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetStorageTariff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetStorageTariff(ctx context.Context, in *SetStorageTariffRequest, opts ...grpc.CallOption) (*SetStorageTariffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStorageTariffResponse)
+	err := c.cc.Invoke(ctx, OrderService_SetStorageTariff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeleteStorageTariff(ctx context.Context, in *DeleteStorageTariffRequest, opts ...grpc.CallOption) (*DeleteStorageTariffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStorageTariffResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeleteStorageTariff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetDailyRevenue(ctx context.Context, in *GetDailyRevenueRequest, opts ...grpc.CallOption) (*GetDailyRevenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDailyRevenueResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetDailyRevenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetDeadLetter(ctx context.Context, in *GetDeadLetterRequest, opts ...grpc.CallOption) (*GetDeadLetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeadLetterResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RequeueDeadLetter(ctx context.Context, in *RequeueDeadLetterRequest, opts ...grpc.CallOption) (*RequeueDeadLetterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeueDeadLetterResponse)
+	err := c.cc.Invoke(ctx, OrderService_RequeueDeadLetter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, OrderService_PurgeDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -104,138 +695,1205 @@ type OrderServiceServer interface {
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	ProcessOrder(context.Context, *ProcessOrderRequest) (*ProcessOrderResponse, error)
 	ReturnOrder(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error)
+	ListOrderTransitions(context.Context, *ListOrderTransitionsRequest) (*ListOrderTransitionsResponse, error)
+	ListPackages(context.Context, *ListPackagesRequest) (*ListPackagesResponse, error)
+	UpsertPackage(context.Context, *UpsertPackageRequest) (*UpsertPackageResponse, error)
+	QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error)
+	RecommendPackaging(context.Context, *RecommendPackagingRequest) (*RecommendPackagingResponse, error)
+	CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*CreatePickupPointResponse, error)
+	GetPickupPoint(context.Context, *GetPickupPointRequest) (*GetPickupPointResponse, error)
+	ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error)
+	UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error)
+	DeletePickupPoint(context.Context, *DeletePickupPointRequest) (*DeletePickupPointResponse, error)
+	CreateStorageCell(context.Context, *CreateStorageCellRequest) (*CreateStorageCellResponse, error)
+	ListStorageCells(context.Context, *ListStorageCellsRequest) (*ListStorageCellsResponse, error)
+	GetStorageOccupancy(context.Context, *GetStorageOccupancyRequest) (*GetStorageOccupancyResponse, error)
+	IssuePickupCode(context.Context, *IssuePickupCodeRequest) (*IssuePickupCodeResponse, error)
+	CompleteOrders(context.Context, *CompleteOrdersRequest) (*CompleteOrdersResponse, error)
+	RefundOrders(context.Context, *RefundOrdersRequest) (*RefundOrdersResponse, error)
+	ListRefundReasons(context.Context, *ListRefundReasonsRequest) (*ListRefundReasonsResponse, error)
+	SearchArchivedOrders(context.Context, *SearchArchivedOrdersRequest) (*SearchArchivedOrdersResponse, error)
+	CreateReturnManifest(context.Context, *CreateReturnManifestRequest) (*CreateReturnManifestResponse, error)
+	GetReturnManifest(context.Context, *GetReturnManifestRequest) (*GetReturnManifestResponse, error)
+	ListReturnManifests(context.Context, *ListReturnManifestsRequest) (*ListReturnManifestsResponse, error)
+	ScanReturnManifestOrder(context.Context, *ScanReturnManifestOrderRequest) (*ScanReturnManifestOrderResponse, error)
+	CloseReturnManifest(context.Context, *CloseReturnManifestRequest) (*CloseReturnManifestResponse, error)
+	ExportReturnManifest(context.Context, *ExportReturnManifestRequest) (*ExportReturnManifestResponse, error)
+	RegisterShipment(context.Context, *RegisterShipmentRequest) (*RegisterShipmentResponse, error)
+	GetShipment(context.Context, *GetShipmentRequest) (*GetShipmentResponse, error)
+	ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error)
+	ScanShipmentOrder(context.Context, *ScanShipmentOrderRequest) (*ScanShipmentOrderResponse, error)
+	CloseShipment(context.Context, *CloseShipmentRequest) (*CloseShipmentResponse, error)
+	GetShipmentReport(context.Context, *GetShipmentReportRequest) (*GetShipmentReportResponse, error)
+	StartStocktaking(context.Context, *StartStocktakingRequest) (*StartStocktakingResponse, error)
+	ScanStocktaking(grpc.ClientStreamingServer[ScanStocktakingRequest, ScanStocktakingResponse]) error
+	FinishStocktaking(context.Context, *FinishStocktakingRequest) (*FinishStocktakingResponse, error)
+	GetStocktaking(context.Context, *GetStocktakingRequest) (*GetStocktakingResponse, error)
+	ListStocktakings(context.Context, *ListStocktakingsRequest) (*ListStocktakingsResponse, error)
+	OpenIncident(context.Context, *OpenIncidentRequest) (*OpenIncidentResponse, error)
+	GetIncident(context.Context, *GetIncidentRequest) (*GetIncidentResponse, error)
+	ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error)
+	UpdateIncident(context.Context, *UpdateIncidentRequest) (*UpdateIncidentResponse, error)
+	ResolveIncident(context.Context, *ResolveIncidentRequest) (*ResolveIncidentResponse, error)
+	CancelIncident(context.Context, *CancelIncidentRequest) (*CancelIncidentResponse, error)
+	GetStorageTariff(context.Context, *GetStorageTariffRequest) (*GetStorageTariffResponse, error)
+	SetStorageTariff(context.Context, *SetStorageTariffRequest) (*SetStorageTariffResponse, error)
+	DeleteStorageTariff(context.Context, *DeleteStorageTariffRequest) (*DeleteStorageTariffResponse, error)
+	GetDailyRevenue(context.Context, *GetDailyRevenueRequest) (*GetDailyRevenueResponse, error)
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	GetDeadLetter(context.Context, *GetDeadLetterRequest) (*GetDeadLetterResponse, error)
+	RequeueDeadLetter(context.Context, *RequeueDeadLetterRequest) (*RequeueDeadLetterResponse, error)
+	PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
-// UnimplementedOrderServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedOrderServiceServer struct{}
+// UnimplementedOrderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrderServiceServer struct{}
+
+func (UnimplementedOrderServiceServer) ConfirmOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+}
+func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) ProcessOrder(context.Context, *ProcessOrderRequest) (*ProcessOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) ReturnOrder(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) ListOrderTransitions(context.Context, *ListOrderTransitionsRequest) (*ListOrderTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderTransitions not implemented")
+}
+func (UnimplementedOrderServiceServer) ListPackages(context.Context, *ListPackagesRequest) (*ListPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPackages not implemented")
+}
+func (UnimplementedOrderServiceServer) UpsertPackage(context.Context, *UpsertPackageRequest) (*UpsertPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertPackage not implemented")
+}
+func (UnimplementedOrderServiceServer) QuoteOrder(context.Context, *QuoteOrderRequest) (*QuoteOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) RecommendPackaging(context.Context, *RecommendPackagingRequest) (*RecommendPackagingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendPackaging not implemented")
+}
+func (UnimplementedOrderServiceServer) CreatePickupPoint(context.Context, *CreatePickupPointRequest) (*CreatePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePickupPoint not implemented")
+}
+func (UnimplementedOrderServiceServer) GetPickupPoint(context.Context, *GetPickupPointRequest) (*GetPickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupPoint not implemented")
+}
+func (UnimplementedOrderServiceServer) ListPickupPoints(context.Context, *ListPickupPointsRequest) (*ListPickupPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPickupPoints not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdatePickupPoint(context.Context, *UpdatePickupPointRequest) (*UpdatePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePickupPoint not implemented")
+}
+func (UnimplementedOrderServiceServer) DeletePickupPoint(context.Context, *DeletePickupPointRequest) (*DeletePickupPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePickupPoint not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateStorageCell(context.Context, *CreateStorageCellRequest) (*CreateStorageCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStorageCell not implemented")
+}
+func (UnimplementedOrderServiceServer) ListStorageCells(context.Context, *ListStorageCellsRequest) (*ListStorageCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageCells not implemented")
+}
+func (UnimplementedOrderServiceServer) GetStorageOccupancy(context.Context, *GetStorageOccupancyRequest) (*GetStorageOccupancyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageOccupancy not implemented")
+}
+func (UnimplementedOrderServiceServer) IssuePickupCode(context.Context, *IssuePickupCodeRequest) (*IssuePickupCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuePickupCode not implemented")
+}
+func (UnimplementedOrderServiceServer) CompleteOrders(context.Context, *CompleteOrdersRequest) (*CompleteOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundOrders(context.Context, *RefundOrdersRequest) (*RefundOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) ListRefundReasons(context.Context, *ListRefundReasonsRequest) (*ListRefundReasonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefundReasons not implemented")
+}
+func (UnimplementedOrderServiceServer) SearchArchivedOrders(context.Context, *SearchArchivedOrdersRequest) (*SearchArchivedOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArchivedOrders not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateReturnManifest(context.Context, *CreateReturnManifestRequest) (*CreateReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturnManifest not implemented")
+}
+func (UnimplementedOrderServiceServer) GetReturnManifest(context.Context, *GetReturnManifestRequest) (*GetReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnManifest not implemented")
+}
+func (UnimplementedOrderServiceServer) ListReturnManifests(context.Context, *ListReturnManifestsRequest) (*ListReturnManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReturnManifests not implemented")
+}
+func (UnimplementedOrderServiceServer) ScanReturnManifestOrder(context.Context, *ScanReturnManifestOrderRequest) (*ScanReturnManifestOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanReturnManifestOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) CloseReturnManifest(context.Context, *CloseReturnManifestRequest) (*CloseReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseReturnManifest not implemented")
+}
+func (UnimplementedOrderServiceServer) ExportReturnManifest(context.Context, *ExportReturnManifestRequest) (*ExportReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportReturnManifest not implemented")
+}
+func (UnimplementedOrderServiceServer) RegisterShipment(context.Context, *RegisterShipmentRequest) (*RegisterShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterShipment not implemented")
+}
+func (UnimplementedOrderServiceServer) GetShipment(context.Context, *GetShipmentRequest) (*GetShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipment not implemented")
+}
+func (UnimplementedOrderServiceServer) ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShipments not implemented")
+}
+func (UnimplementedOrderServiceServer) ScanShipmentOrder(context.Context, *ScanShipmentOrderRequest) (*ScanShipmentOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanShipmentOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) CloseShipment(context.Context, *CloseShipmentRequest) (*CloseShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseShipment not implemented")
+}
+func (UnimplementedOrderServiceServer) GetShipmentReport(context.Context, *GetShipmentReportRequest) (*GetShipmentReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipmentReport not implemented")
+}
+func (UnimplementedOrderServiceServer) StartStocktaking(context.Context, *StartStocktakingRequest) (*StartStocktakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStocktaking not implemented")
+}
+func (UnimplementedOrderServiceServer) ScanStocktaking(grpc.ClientStreamingServer[ScanStocktakingRequest, ScanStocktakingResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ScanStocktaking not implemented")
+}
+func (UnimplementedOrderServiceServer) FinishStocktaking(context.Context, *FinishStocktakingRequest) (*FinishStocktakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishStocktaking not implemented")
+}
+func (UnimplementedOrderServiceServer) GetStocktaking(context.Context, *GetStocktakingRequest) (*GetStocktakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStocktaking not implemented")
+}
+func (UnimplementedOrderServiceServer) ListStocktakings(context.Context, *ListStocktakingsRequest) (*ListStocktakingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStocktakings not implemented")
+}
+func (UnimplementedOrderServiceServer) OpenIncident(context.Context, *OpenIncidentRequest) (*OpenIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenIncident not implemented")
+}
+func (UnimplementedOrderServiceServer) GetIncident(context.Context, *GetIncidentRequest) (*GetIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncident not implemented")
+}
+func (UnimplementedOrderServiceServer) ListIncidents(context.Context, *ListIncidentsRequest) (*ListIncidentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncidents not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdateIncident(context.Context, *UpdateIncidentRequest) (*UpdateIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIncident not implemented")
+}
+func (UnimplementedOrderServiceServer) ResolveIncident(context.Context, *ResolveIncidentRequest) (*ResolveIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveIncident not implemented")
+}
+func (UnimplementedOrderServiceServer) CancelIncident(context.Context, *CancelIncidentRequest) (*CancelIncidentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIncident not implemented")
+}
+func (UnimplementedOrderServiceServer) GetStorageTariff(context.Context, *GetStorageTariffRequest) (*GetStorageTariffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageTariff not implemented")
+}
+func (UnimplementedOrderServiceServer) SetStorageTariff(context.Context, *SetStorageTariffRequest) (*SetStorageTariffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStorageTariff not implemented")
+}
+func (UnimplementedOrderServiceServer) DeleteStorageTariff(context.Context, *DeleteStorageTariffRequest) (*DeleteStorageTariffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStorageTariff not implemented")
+}
+func (UnimplementedOrderServiceServer) GetDailyRevenue(context.Context, *GetDailyRevenueRequest) (*GetDailyRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyRevenue not implemented")
+}
+func (UnimplementedOrderServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedOrderServiceServer) GetDeadLetter(context.Context, *GetDeadLetterRequest) (*GetDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeadLetter not implemented")
+}
+func (UnimplementedOrderServiceServer) RequeueDeadLetter(context.Context, *RequeueDeadLetterRequest) (*RequeueDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueDeadLetter not implemented")
+}
+func (UnimplementedOrderServiceServer) PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
+func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
+func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderServiceServer will
+// result in compilation errors.
+type UnsafeOrderServiceServer interface {
+	mustEmbedUnimplementedOrderServiceServer()
+}
+
+func RegisterOrderServiceServer(s grpc.ServiceRegistrar, srv OrderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOrderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrderService_ServiceDesc, srv)
+}
+
+func _OrderService_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ConfirmOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ConfirmOrder(ctx, req.(*CreateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderByID(ctx, req.(*GetOrderByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ProcessOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ProcessOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ProcessOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ProcessOrder(ctx, req.(*ProcessOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ReturnOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ReturnOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ReturnOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ReturnOrder(ctx, req.(*ReturnOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListOrderTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListOrderTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListOrderTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListOrderTransitions(ctx, req.(*ListOrderTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListPackages(ctx, req.(*ListPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpsertPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpsertPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpsertPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpsertPackage(ctx, req.(*UpsertPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_QuoteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteOrder(ctx, req.(*QuoteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RecommendPackaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendPackagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RecommendPackaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RecommendPackaging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RecommendPackaging(ctx, req.(*RecommendPackagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreatePickupPoint(ctx, req.(*CreatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetPickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPickupPoint(ctx, req.(*GetPickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListPickupPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListPickupPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListPickupPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListPickupPoints(ctx, req.(*ListPickupPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdatePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdatePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdatePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdatePickupPoint(ctx, req.(*UpdatePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeletePickupPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePickupPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeletePickupPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeletePickupPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeletePickupPoint(ctx, req.(*DeletePickupPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateStorageCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStorageCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateStorageCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateStorageCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateStorageCell(ctx, req.(*CreateStorageCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListStorageCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListStorageCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListStorageCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListStorageCells(ctx, req.(*ListStorageCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetStorageOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetStorageOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetStorageOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetStorageOccupancy(ctx, req.(*GetStorageOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_IssuePickupCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuePickupCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).IssuePickupCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_IssuePickupCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).IssuePickupCode(ctx, req.(*IssuePickupCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CompleteOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompleteOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CompleteOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompleteOrders(ctx, req.(*CompleteOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundOrders(ctx, req.(*RefundOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListRefundReasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundReasonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListRefundReasons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListRefundReasons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListRefundReasons(ctx, req.(*ListRefundReasonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SearchArchivedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArchivedOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SearchArchivedOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SearchArchivedOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SearchArchivedOrders(ctx, req.(*SearchArchivedOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateReturnManifest(ctx, req.(*CreateReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetReturnManifest(ctx, req.(*GetReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListReturnManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReturnManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListReturnManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListReturnManifests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListReturnManifests(ctx, req.(*ListReturnManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ScanReturnManifestOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanReturnManifestOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ScanReturnManifestOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ScanReturnManifestOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ScanReturnManifestOrder(ctx, req.(*ScanReturnManifestOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CloseReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CloseReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CloseReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CloseReturnManifest(ctx, req.(*CloseReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ExportReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ExportReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ExportReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ExportReturnManifest(ctx, req.(*ExportReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RegisterShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RegisterShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RegisterShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RegisterShipment(ctx, req.(*RegisterShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetShipment(ctx, req.(*GetShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShipmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListShipments(ctx, req.(*ListShipmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ScanShipmentOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanShipmentOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ScanShipmentOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ScanShipmentOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ScanShipmentOrder(ctx, req.(*ScanShipmentOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CloseShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CloseShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CloseShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CloseShipment(ctx, req.(*CloseShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetShipmentReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetShipmentReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetShipmentReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetShipmentReport(ctx, req.(*GetShipmentReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_StartStocktaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartStocktakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).StartStocktaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_StartStocktaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).StartStocktaking(ctx, req.(*StartStocktakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ScanStocktaking_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrderServiceServer).ScanStocktaking(&grpc.GenericServerStream[ScanStocktakingRequest, ScanStocktakingResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_ScanStocktakingServer = grpc.ClientStreamingServer[ScanStocktakingRequest, ScanStocktakingResponse]
+
+func _OrderService_FinishStocktaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishStocktakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).FinishStocktaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_FinishStocktaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).FinishStocktaking(ctx, req.(*FinishStocktakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetStocktaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStocktakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetStocktaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetStocktaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetStocktaking(ctx, req.(*GetStocktakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListStocktakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStocktakingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListStocktakings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListStocktakings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListStocktakings(ctx, req.(*ListStocktakingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_OpenIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OpenIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OpenIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OpenIncident(ctx, req.(*OpenIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetIncident(ctx, req.(*GetIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedOrderServiceServer) ConfirmOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+func _OrderService_ListIncidents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncidentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListIncidents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListIncidents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListIncidents(ctx, req.(*ListIncidentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrderServiceServer) GetOrderByID(context.Context, *GetOrderByIDRequest) (*GetOrderByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByID not implemented")
+
+func _OrderService_UpdateIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateIncident(ctx, req.(*UpdateIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrderServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+
+func _OrderService_ResolveIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ResolveIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ResolveIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ResolveIncident(ctx, req.(*ResolveIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrderServiceServer) ProcessOrder(context.Context, *ProcessOrderRequest) (*ProcessOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessOrder not implemented")
+
+func _OrderService_CancelIncident_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelIncidentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelIncident(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelIncident_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelIncident(ctx, req.(*CancelIncidentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrderServiceServer) ReturnOrder(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrder not implemented")
+
+func _OrderService_GetStorageTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageTariffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetStorageTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetStorageTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetStorageTariff(ctx, req.(*GetStorageTariffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
-func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OrderServiceServer will
-// result in compilation errors.
-type UnsafeOrderServiceServer interface {
-	mustEmbedUnimplementedOrderServiceServer()
+func _OrderService_SetStorageTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStorageTariffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SetStorageTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SetStorageTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SetStorageTariff(ctx, req.(*SetStorageTariffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterOrderServiceServer(s grpc.ServiceRegistrar, srv OrderServiceServer) {
-	// If the following call pancis, it indicates UnimplementedOrderServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _OrderService_DeleteStorageTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStorageTariffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&OrderService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeleteStorageTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeleteStorageTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeleteStorageTariff(ctx, req.(*DeleteStorageTariffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrderRequest)
+func _OrderService_GetDailyRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyRevenueRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).ConfirmOrder(ctx, in)
+		return srv.(OrderServiceServer).GetDailyRevenue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_ConfirmOrder_FullMethodName,
+		FullMethod: OrderService_GetDailyRevenue_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).ConfirmOrder(ctx, req.(*CreateOrderRequest))
+		return srv.(OrderServiceServer).GetDailyRevenue(ctx, req.(*GetDailyRevenueRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_GetOrderByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderByIDRequest)
+func _OrderService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).GetOrderByID(ctx, in)
+		return srv.(OrderServiceServer).ListDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_GetOrderByID_FullMethodName,
+		FullMethod: OrderService_ListDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).GetOrderByID(ctx, req.(*GetOrderByIDRequest))
+		return srv.(OrderServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrdersRequest)
+func _OrderService_GetDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeadLetterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).ListOrders(ctx, in)
+		return srv.(OrderServiceServer).GetDeadLetter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_ListOrders_FullMethodName,
+		FullMethod: OrderService_GetDeadLetter_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+		return srv.(OrderServiceServer).GetDeadLetter(ctx, req.(*GetDeadLetterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_ProcessOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProcessOrderRequest)
+func _OrderService_RequeueDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueDeadLetterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).ProcessOrder(ctx, in)
+		return srv.(OrderServiceServer).RequeueDeadLetter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_ProcessOrder_FullMethodName,
+		FullMethod: OrderService_RequeueDeadLetter_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).ProcessOrder(ctx, req.(*ProcessOrderRequest))
+		return srv.(OrderServiceServer).RequeueDeadLetter(ctx, req.(*RequeueDeadLetterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_ReturnOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReturnOrderRequest)
+func _OrderService_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).ReturnOrder(ctx, in)
+		return srv.(OrderServiceServer).PurgeDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_ReturnOrder_FullMethodName,
+		FullMethod: OrderService_PurgeDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).ReturnOrder(ctx, req.(*ReturnOrderRequest))
+		return srv.(OrderServiceServer).PurgeDeadLetters(ctx, req.(*PurgeDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -267,7 +1925,205 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReturnOrder",
 			Handler:    _OrderService_ReturnOrder_Handler,
 		},
+		{
+			MethodName: "ListOrderTransitions",
+			Handler:    _OrderService_ListOrderTransitions_Handler,
+		},
+		{
+			MethodName: "ListPackages",
+			Handler:    _OrderService_ListPackages_Handler,
+		},
+		{
+			MethodName: "UpsertPackage",
+			Handler:    _OrderService_UpsertPackage_Handler,
+		},
+		{
+			MethodName: "QuoteOrder",
+			Handler:    _OrderService_QuoteOrder_Handler,
+		},
+		{
+			MethodName: "RecommendPackaging",
+			Handler:    _OrderService_RecommendPackaging_Handler,
+		},
+		{
+			MethodName: "CreatePickupPoint",
+			Handler:    _OrderService_CreatePickupPoint_Handler,
+		},
+		{
+			MethodName: "GetPickupPoint",
+			Handler:    _OrderService_GetPickupPoint_Handler,
+		},
+		{
+			MethodName: "ListPickupPoints",
+			Handler:    _OrderService_ListPickupPoints_Handler,
+		},
+		{
+			MethodName: "UpdatePickupPoint",
+			Handler:    _OrderService_UpdatePickupPoint_Handler,
+		},
+		{
+			MethodName: "DeletePickupPoint",
+			Handler:    _OrderService_DeletePickupPoint_Handler,
+		},
+		{
+			MethodName: "CreateStorageCell",
+			Handler:    _OrderService_CreateStorageCell_Handler,
+		},
+		{
+			MethodName: "ListStorageCells",
+			Handler:    _OrderService_ListStorageCells_Handler,
+		},
+		{
+			MethodName: "GetStorageOccupancy",
+			Handler:    _OrderService_GetStorageOccupancy_Handler,
+		},
+		{
+			MethodName: "IssuePickupCode",
+			Handler:    _OrderService_IssuePickupCode_Handler,
+		},
+		{
+			MethodName: "CompleteOrders",
+			Handler:    _OrderService_CompleteOrders_Handler,
+		},
+		{
+			MethodName: "RefundOrders",
+			Handler:    _OrderService_RefundOrders_Handler,
+		},
+		{
+			MethodName: "ListRefundReasons",
+			Handler:    _OrderService_ListRefundReasons_Handler,
+		},
+		{
+			MethodName: "SearchArchivedOrders",
+			Handler:    _OrderService_SearchArchivedOrders_Handler,
+		},
+		{
+			MethodName: "CreateReturnManifest",
+			Handler:    _OrderService_CreateReturnManifest_Handler,
+		},
+		{
+			MethodName: "GetReturnManifest",
+			Handler:    _OrderService_GetReturnManifest_Handler,
+		},
+		{
+			MethodName: "ListReturnManifests",
+			Handler:    _OrderService_ListReturnManifests_Handler,
+		},
+		{
+			MethodName: "ScanReturnManifestOrder",
+			Handler:    _OrderService_ScanReturnManifestOrder_Handler,
+		},
+		{
+			MethodName: "CloseReturnManifest",
+			Handler:    _OrderService_CloseReturnManifest_Handler,
+		},
+		{
+			MethodName: "ExportReturnManifest",
+			Handler:    _OrderService_ExportReturnManifest_Handler,
+		},
+		{
+			MethodName: "RegisterShipment",
+			Handler:    _OrderService_RegisterShipment_Handler,
+		},
+		{
+			MethodName: "GetShipment",
+			Handler:    _OrderService_GetShipment_Handler,
+		},
+		{
+			MethodName: "ListShipments",
+			Handler:    _OrderService_ListShipments_Handler,
+		},
+		{
+			MethodName: "ScanShipmentOrder",
+			Handler:    _OrderService_ScanShipmentOrder_Handler,
+		},
+		{
+			MethodName: "CloseShipment",
+			Handler:    _OrderService_CloseShipment_Handler,
+		},
+		{
+			MethodName: "GetShipmentReport",
+			Handler:    _OrderService_GetShipmentReport_Handler,
+		},
+		{
+			MethodName: "StartStocktaking",
+			Handler:    _OrderService_StartStocktaking_Handler,
+		},
+		{
+			MethodName: "FinishStocktaking",
+			Handler:    _OrderService_FinishStocktaking_Handler,
+		},
+		{
+			MethodName: "GetStocktaking",
+			Handler:    _OrderService_GetStocktaking_Handler,
+		},
+		{
+			MethodName: "ListStocktakings",
+			Handler:    _OrderService_ListStocktakings_Handler,
+		},
+		{
+			MethodName: "OpenIncident",
+			Handler:    _OrderService_OpenIncident_Handler,
+		},
+		{
+			MethodName: "GetIncident",
+			Handler:    _OrderService_GetIncident_Handler,
+		},
+		{
+			MethodName: "ListIncidents",
+			Handler:    _OrderService_ListIncidents_Handler,
+		},
+		{
+			MethodName: "UpdateIncident",
+			Handler:    _OrderService_UpdateIncident_Handler,
+		},
+		{
+			MethodName: "ResolveIncident",
+			Handler:    _OrderService_ResolveIncident_Handler,
+		},
+		{
+			MethodName: "CancelIncident",
+			Handler:    _OrderService_CancelIncident_Handler,
+		},
+		{
+			MethodName: "GetStorageTariff",
+			Handler:    _OrderService_GetStorageTariff_Handler,
+		},
+		{
+			MethodName: "SetStorageTariff",
+			Handler:    _OrderService_SetStorageTariff_Handler,
+		},
+		{
+			MethodName: "DeleteStorageTariff",
+			Handler:    _OrderService_DeleteStorageTariff_Handler,
+		},
+		{
+			MethodName: "GetDailyRevenue",
+			Handler:    _OrderService_GetDailyRevenue_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _OrderService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetDeadLetter",
+			Handler:    _OrderService_GetDeadLetter_Handler,
+		},
+		{
+			MethodName: "RequeueDeadLetter",
+			Handler:    _OrderService_RequeueDeadLetter_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _OrderService_PurgeDeadLetters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ScanStocktaking",
+			Handler:       _OrderService_ScanStocktaking_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order_service.proto",
 }
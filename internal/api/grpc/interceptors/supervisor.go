@@ -0,0 +1,35 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
+)
+
+// SupervisorInterceptor authenticates the supervisor token of the call, if
+// there is one, and rejects an unknown token with Unauthenticated.
+func SupervisorInterceptor(supervisors *service.Supervisors) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		tokens := metadata.ValueFromIncomingContext(ctx, strings.ToLower(service.SupervisorTokenHeader))
+		if len(tokens) == 0 || tokens[0] == "" {
+			return handler(ctx, req)
+		}
+		supervisorID, err := supervisors.Authenticate(tokens[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(service.ContextWithSupervisor(ctx, supervisorID), req)
+	}
+}
@@ -1,9 +1,9 @@
 package server
 
 import (
+	"context"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/app"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/cache"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository/postgresql"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/workers"
 	"google.golang.org/grpc/reflection"
@@ -18,24 +18,26 @@ import (
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/interceptors"
 	grpcservice "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/service"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/logger"
-	service "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
 )
 
-func Run(config config.Config, mng *tx_manager.TxManager, workersManager *workers.WorkerManager) {
+func Run(ctx context.Context, config config.Config, mng *tx_manager.TxManager) {
 	tracer := otel.Tracer("order-service")
 
 	interceptor := interceptors.MetricsAndLoggingInterceptor(logger.ZapLogger, tracer)
 
+	orders, err := app.NewOrderService(ctx, config, mng)
+	if err != nil {
+		logger.ZapLogger.Fatal("failed to build order service", zap.Error(err))
+	}
+
 	s := grpc.NewServer(
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(interceptor)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(interceptor, interceptors.SupervisorInterceptor(orders.Supervisors))),
 	)
 
 	reflection.Register(s)
 
-	client := cache.NewCacheClient(&config, 500)
-	orderRepo := postgresql.NewOrdersRepo(mng, client)
-	orderService := service.NewOrderServiceImpl(orderRepo, *mng, workersManager)
-	orderServer := grpcservice.NewOrderServiceServer(orderService, config)
+	workers.NewExpirySweeper(orders.Service, config.ExpirySweeper).Start(ctx)
+	orderServer := grpcservice.NewOrderServiceServer(orders.Service, config)
 
 	orderpb.RegisterOrderServiceServer(s, orderServer)
 
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+)
+
+func (s *OrderServiceServer) CompleteOrders(ctx context.Context, req *orderpb.CompleteOrdersRequest) (*orderpb.CompleteOrdersResponse, error) {
+	mode, err := domain.ParseBatchMode(req.GetMode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	items := make([]domain.PickupItem, 0, len(req.GetOrders()))
+	for _, item := range req.GetOrders() {
+		items = append(items, domain.PickupItem{
+			OrderID:        item.GetOrderId(),
+			PickupCode:     item.GetPickupCode(),
+			StorageFeePaid: item.GetStorageFeePaid(),
+		})
+	}
+
+	result, err := s.service.CompleteOrders(ctx, req.GetUserId(), items, mode)
+	if err != nil {
+		return nil, batchError(err)
+	}
+
+	results, totals := convertBatchResult(result)
+
+	return &orderpb.CompleteOrdersResponse{Results: results, Totals: totals}, nil
+}
+
+func convertBatchResult(result domain.BatchResult) ([]*orderpb.BatchOrderResult, []*orderpb.Money) {
+	results := make([]*orderpb.BatchOrderResult, 0, len(result.Items))
+	for _, item := range result.Items {
+		res := &orderpb.BatchOrderResult{OrderId: item.OrderID, Ok: item.OK()}
+		if item.Err != nil {
+			res.Error = item.Err.Error()
+		}
+		results = append(results, res)
+	}
+
+	totals := make([]*orderpb.Money, 0, len(result.Totals))
+	for _, total := range result.Totals {
+		totals = append(totals, convertMoneyResponse(total))
+	}
+
+	return results, totals
+}
+
+func batchError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBatchIsEmpty),
+		errors.Is(err, domain.ErrBatchTooLarge),
+		errors.Is(err, domain.ErrBatchHasDuplicates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
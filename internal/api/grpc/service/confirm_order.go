@@ -15,9 +15,24 @@ func (s *OrderServiceServer) ConfirmOrder(ctx context.Context, req *orderpb.Crea
 		return nil, status.Error(codes.InvalidArgument, "order is not valid")
 	}
 
-	packageTypeFromString, err := domain.GetPackageTypeFromString(req.GetPackageType())
+	packaging := packagingFromRequest(req.GetPackageType(), req.GetIsAdditionalFilm(), req.GetPackagingLayers())
+	for _, packageType := range packaging {
+		if packageType == domain.Auto {
+			continue
+		}
+		if _, err := s.service.ResolvePackage(packageType); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	dimensions, err := domain.NewDimensions(int(req.GetLength()), int(req.GetWidth()), int(req.GetHeight()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cost, err := convertMoneyRequest(req.GetCost())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "unable to retrieve order package type")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	dto := service.OrderDto{
@@ -25,18 +40,48 @@ func (s *OrderServiceServer) ConfirmOrder(ctx context.Context, req *orderpb.Crea
 		UserID:         req.GetUserId(),
 		ExpirationTime: req.GetExpirationTime().AsTime(),
 		Weight:         int(req.GetWeight()),
-		Cost:           int(req.GetCost()),
+		Cost:           cost,
+		Dimensions:     dimensions,
+		PickupPointID:  req.GetPickupPointId(),
 	}
 
-	order, err := s.service.AddOrder(ctx, dto, packageTypeFromString, req.GetIsAdditionalFilm())
+	order, err := s.service.AddOrder(ctx, dto, packaging)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrOrderAlreadyExists):
 			return nil, status.Error(codes.Internal, "order already exists")
+		case errors.Is(err, domain.ErrPickupPointNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrNoFreeStorageCell):
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case errors.Is(err, domain.ErrIncorrectWeightForApplyPackage),
+			errors.Is(err, domain.ErrIncorrectSizeForApplyPackage),
+			errors.Is(err, domain.ErrNoSuitablePackage),
+			errors.Is(err, domain.ErrCurrencyMismatch),
+			errors.Is(err, domain.ErrPackageNotExists),
+			errors.Is(err, domain.ErrPackageNotActive),
+			errors.Is(err, domain.ErrPackageCannotWrap),
+			errors.Is(err, domain.ErrPackagingCompositionNotAllowed):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, "unable to create an order")
 		}
 	}
 
-	return &orderpb.CreateOrderResponse{OrderId: order.OrderID}, nil
+	return &orderpb.CreateOrderResponse{OrderId: order.OrderID, PickupCode: order.PickupCode}, nil
+}
+
+// packagingFromRequest prefers explicit layers and falls back to the legacy
+// package type with the additional film flag.
+func packagingFromRequest(packageType string, isAdditionalFilm bool, layers []string) []domain.PackageType {
+	if len(layers) == 0 {
+		return domain.NewPackagingLayers(domain.PackageType(packageType), isAdditionalFilm)
+	}
+
+	packaging := make([]domain.PackageType, len(layers))
+	for i, layer := range layers {
+		packaging[i] = domain.PackageType(layer)
+	}
+
+	return packaging
 }
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) ListDeadLetters(
+	ctx context.Context,
+	req *orderpb.ListDeadLettersRequest,
+) (*orderpb.ListDeadLettersResponse, error) {
+	var filter domain.DeadLetterFilter
+	if req.GetTaskType() != "" {
+		taskType, err := domain.ParseTaskType(req.GetTaskType())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.TaskType = &taskType
+	}
+
+	deadLetters, err := s.service.ListDeadLetters(ctx, filter)
+	if err != nil {
+		return nil, deadLetterError(err)
+	}
+
+	resp := make([]*orderpb.DeadLetter, len(deadLetters))
+	for i, deadLetter := range deadLetters {
+		resp[i] = convertDeadLetter(deadLetter)
+	}
+
+	return &orderpb.ListDeadLettersResponse{DeadLetters: resp}, nil
+}
+
+func (s *OrderServiceServer) GetDeadLetter(
+	ctx context.Context,
+	req *orderpb.GetDeadLetterRequest,
+) (*orderpb.GetDeadLetterResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	deadLetter, err := s.service.GetDeadLetter(ctx, req.GetId())
+	if err != nil {
+		return nil, deadLetterError(err)
+	}
+
+	return &orderpb.GetDeadLetterResponse{DeadLetter: convertDeadLetter(deadLetter)}, nil
+}
+
+func (s *OrderServiceServer) RequeueDeadLetter(
+	ctx context.Context,
+	req *orderpb.RequeueDeadLetterRequest,
+) (*orderpb.RequeueDeadLetterResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	taskID, err := s.service.RequeueDeadLetter(ctx, req.GetId())
+	if err != nil {
+		return nil, deadLetterError(err)
+	}
+
+	return &orderpb.RequeueDeadLetterResponse{TaskId: taskID}, nil
+}
+
+func (s *OrderServiceServer) PurgeDeadLetters(
+	ctx context.Context,
+	req *orderpb.PurgeDeadLettersRequest,
+) (*orderpb.PurgeDeadLettersResponse, error) {
+	var filter domain.DeadLetterFilter
+	if req.GetTaskType() != "" {
+		taskType, err := domain.ParseTaskType(req.GetTaskType())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.TaskType = &taskType
+	}
+	if req.GetDeadBefore() != nil {
+		deadBefore := req.GetDeadBefore().AsTime()
+		filter.DeadBefore = &deadBefore
+	}
+
+	purged, err := s.service.PurgeDeadLetters(ctx, filter)
+	if err != nil {
+		return nil, deadLetterError(err)
+	}
+
+	return &orderpb.PurgeDeadLettersResponse{Purged: purged}, nil
+}
+
+func deadLetterError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrDeadLetterNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertDeadLetter(deadLetter domain.DeadLetter) *orderpb.DeadLetter {
+	return &orderpb.DeadLetter{
+		Id:            deadLetter.ID,
+		TaskId:        deadLetter.TaskID,
+		TaskType:      string(deadLetter.TaskType),
+		EntryId:       deadLetter.EntryID,
+		AttemptsCount: int32(deadLetter.AttemptsCount),
+		LastError:     deadLetter.LastError,
+		CreatedAt:     timestamppb.New(deadLetter.CreatedAt),
+		DeadAt:        timestamppb.New(deadLetter.DeadAt),
+		Entry:         string(deadLetter.Entry),
+	}
+}
@@ -22,12 +22,24 @@ func (s *OrderServiceServer) GetOrderByID(ctx context.Context, req *orderpb.GetO
 	}
 
 	respOrder := &orderpb.Order{
-		OrderId:        order.OrderID,
-		UserId:         order.UserID,
-		ExpirationTime: timestamppb.New(order.ExpirationTime),
-		Status:         domain.GetStringFromStatus(order.Status),
-		Weight:         int32(order.Weight),
-		Cost:           int32(order.Cost),
+		OrderId:          order.OrderID,
+		UserId:           order.UserID,
+		ExpirationTime:   timestamppb.New(order.ExpirationTime),
+		Status:           domain.GetStringFromStatus(order.Status),
+		Weight:           int32(order.Weight),
+		Cost:             convertMoneyResponse(order.Cost),
+		Packaging:        convertPackagingResponse(order.Packaging),
+		PackageType:      string(order.PackageType),
+		IsAdditionalFilm: order.IsAdditionalFilm,
+		BaseCost:         convertMoneyResponse(order.BaseCost),
+		PackagingCost:    convertMoneyResponse(order.PackagingCost),
+		Length:           int32(order.Length),
+		Width:            int32(order.Width),
+		Height:           int32(order.Height),
+		PickupPointId:    order.PickupPointID,
+		StorageCell:      order.StorageCell,
+		Refund:           convertRefundResponse(order.Refund),
+		StorageFee:       convertStorageFee(order.StorageFee),
 	}
 
 	return &orderpb.GetOrderByIDResponse{Order: respOrder}, nil
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) RegisterShipment(
+	ctx context.Context,
+	req *orderpb.RegisterShipmentRequest,
+) (*orderpb.RegisterShipmentResponse, error) {
+	items := make([]domain.ShipmentItem, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		cost, err := convertMoneyRequest(item.GetCost())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		items[i] = domain.ShipmentItem{
+			OrderID:        item.GetOrderId(),
+			UserID:         item.GetUserId(),
+			ExpirationTime: item.GetExpirationTime().AsTime(),
+			Weight:         int(item.GetWeight()),
+			Cost:           cost,
+			Dimensions: domain.Dimensions{
+				Length: int(item.GetLength()),
+				Width:  int(item.GetWidth()),
+				Height: int(item.GetHeight()),
+			},
+		}
+	}
+
+	shipment, err := s.service.RegisterShipment(ctx, req.GetPickupPointId(), req.GetReference(), items)
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	return &orderpb.RegisterShipmentResponse{Shipment: convertShipment(shipment)}, nil
+}
+
+func (s *OrderServiceServer) GetShipment(
+	ctx context.Context,
+	req *orderpb.GetShipmentRequest,
+) (*orderpb.GetShipmentResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	shipment, err := s.service.GetShipment(ctx, req.GetId())
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	return &orderpb.GetShipmentResponse{Shipment: convertShipment(shipment)}, nil
+}
+
+func (s *OrderServiceServer) ListShipments(
+	ctx context.Context,
+	req *orderpb.ListShipmentsRequest,
+) (*orderpb.ListShipmentsResponse, error) {
+	var filter domain.ShipmentFilter
+	if req.GetPickupPointId() > 0 {
+		pickupPointID := req.GetPickupPointId()
+		filter.PickupPointID = &pickupPointID
+	}
+	if req.GetStatus() != "" {
+		shipmentStatus, err := domain.ParseShipmentStatus(req.GetStatus())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Status = &shipmentStatus
+	}
+
+	shipments, err := s.service.ListShipments(ctx, filter)
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	resp := make([]*orderpb.InboundShipment, len(shipments))
+	for i, shipment := range shipments {
+		resp[i] = convertShipment(shipment)
+	}
+
+	return &orderpb.ListShipmentsResponse{Shipments: resp}, nil
+}
+
+func (s *OrderServiceServer) ScanShipmentOrder(
+	ctx context.Context,
+	req *orderpb.ScanShipmentOrderRequest,
+) (*orderpb.ScanShipmentOrderResponse, error) {
+	if req.GetShipmentId() <= 0 || req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "shipment_id and order_id are required and must be positive")
+	}
+
+	shipment, err := s.service.ScanShipmentOrder(ctx, req.GetShipmentId(), req.GetOrderId())
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	return &orderpb.ScanShipmentOrderResponse{Shipment: convertShipment(shipment)}, nil
+}
+
+func (s *OrderServiceServer) CloseShipment(
+	ctx context.Context,
+	req *orderpb.CloseShipmentRequest,
+) (*orderpb.CloseShipmentResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	report, err := s.service.CloseShipment(ctx, req.GetId())
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	pickupCodes := make([]*orderpb.IssuedPickupCode, len(report.PickupCodes))
+	for i, code := range report.PickupCodes {
+		pickupCodes[i] = &orderpb.IssuedPickupCode{OrderId: code.OrderID, PickupCode: code.PickupCode}
+	}
+
+	return &orderpb.CloseShipmentResponse{Report: convertShipmentReport(report), PickupCodes: pickupCodes}, nil
+}
+
+func (s *OrderServiceServer) GetShipmentReport(
+	ctx context.Context,
+	req *orderpb.GetShipmentReportRequest,
+) (*orderpb.GetShipmentReportResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	report, err := s.service.GetShipmentReport(ctx, req.GetId())
+	if err != nil {
+		return nil, shipmentError(err)
+	}
+
+	return &orderpb.GetShipmentReportResponse{Report: convertShipmentReport(report)}, nil
+}
+
+func shipmentError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrShipmentFieldsAreIncorrect),
+		errors.Is(err, domain.ErrShipmentIsEmpty),
+		errors.Is(err, domain.ErrShipmentTooLarge),
+		errors.Is(err, domain.ErrShipmentHasDuplicates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrShipmentNotFound),
+		errors.Is(err, domain.ErrShipmentReportNotFound),
+		errors.Is(err, domain.ErrPickupPointNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrShipmentIsClosed),
+		errors.Is(err, domain.ErrNoFreeStorageCell):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertShipment(shipment domain.InboundShipment) *orderpb.InboundShipment {
+	items := make([]*orderpb.ShipmentItem, len(shipment.Items))
+	for i, item := range shipment.Items {
+		items[i] = &orderpb.ShipmentItem{
+			OrderId:        item.OrderID,
+			UserId:         item.UserID,
+			ExpirationTime: timestamppb.New(item.ExpirationTime),
+			Weight:         int32(item.Weight),
+			Cost:           convertMoneyResponse(item.Cost),
+			Length:         int32(item.Length),
+			Width:          int32(item.Width),
+			Height:         int32(item.Height),
+		}
+	}
+
+	return &orderpb.InboundShipment{
+		Id:            shipment.ID,
+		PickupPointId: shipment.PickupPointID,
+		Reference:     shipment.Reference,
+		Status:        string(shipment.Status),
+		CreatedAt:     timestamppb.New(shipment.CreatedAt),
+		ClosedAt:      optionalTimestamp(shipment.ClosedAt),
+		Items:         items,
+		Scanned:       shipment.Scanned,
+	}
+}
+
+func convertShipmentReport(report domain.ShipmentReport) *orderpb.ShipmentReport {
+	rejected := make([]*orderpb.RejectedShipmentItem, len(report.Rejected))
+	for i, item := range report.Rejected {
+		rejected[i] = &orderpb.RejectedShipmentItem{OrderId: item.OrderID, Reason: item.Reason}
+	}
+
+	return &orderpb.ShipmentReport{
+		ShipmentId: report.ShipmentID,
+		Received:   report.Received,
+		Missing:    report.Missing,
+		Unexpected: report.Unexpected,
+		Rejected:   rejected,
+		CreatedAt:  timestamppb.New(report.CreatedAt),
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) OpenIncident(
+	ctx context.Context,
+	req *orderpb.OpenIncidentRequest,
+) (*orderpb.OpenIncidentResponse, error) {
+	incident, err := s.service.OpenIncident(
+		ctx,
+		req.GetOrderId(),
+		domain.IncidentType(req.GetType()),
+		req.GetDescription(),
+		req.GetOperator(),
+	)
+	if err != nil {
+		return nil, incidentError(err)
+	}
+
+	return &orderpb.OpenIncidentResponse{Incident: convertIncident(incident)}, nil
+}
+
+func (s *OrderServiceServer) GetIncident(
+	ctx context.Context,
+	req *orderpb.GetIncidentRequest,
+) (*orderpb.GetIncidentResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	incident, err := s.service.GetIncident(ctx, req.GetId())
+	if err != nil {
+		return nil, incidentError(err)
+	}
+
+	return &orderpb.GetIncidentResponse{Incident: convertIncident(incident)}, nil
+}
+
+func (s *OrderServiceServer) ListIncidents(
+	ctx context.Context,
+	req *orderpb.ListIncidentsRequest,
+) (*orderpb.ListIncidentsResponse, error) {
+	var filter domain.IncidentFilter
+	if req.GetOrderId() > 0 {
+		orderID := req.GetOrderId()
+		filter.OrderID = &orderID
+	}
+	if req.GetType() != "" {
+		incidentType, err := domain.ParseIncidentType(req.GetType())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Type = &incidentType
+	}
+	if req.GetStatus() != "" {
+		incidentStatus, err := domain.ParseIncidentStatus(req.GetStatus())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Status = &incidentStatus
+	}
+
+	incidents, err := s.service.ListIncidents(ctx, filter)
+	if err != nil {
+		return nil, incidentError(err)
+	}
+
+	resp := make([]*orderpb.Incident, len(incidents))
+	for i, incident := range incidents {
+		resp[i] = convertIncident(incident)
+	}
+
+	return &orderpb.ListIncidentsResponse{Incidents: resp}, nil
+}
+
+func (s *OrderServiceServer) UpdateIncident(
+	ctx context.Context,
+	req *orderpb.UpdateIncidentRequest,
+) (*orderpb.UpdateIncidentResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	var update domain.IncidentUpdate
+	if req.GetType() != "" {
+		incidentType := domain.IncidentType(req.GetType())
+		update.Type = &incidentType
+	}
+	if req.GetDescription() != "" {
+		description := req.GetDescription()
+		update.Description = &description
+	}
+
+	incident, err := s.service.UpdateIncident(ctx, req.GetId(), update)
+	if err != nil {
+		return nil, incidentError(err)
+	}
+
+	return &orderpb.UpdateIncidentResponse{Incident: convertIncident(incident)}, nil
+}
+
+func (s *OrderServiceServer) ResolveIncident(
+	ctx context.Context,
+	req *orderpb.ResolveIncidentRequest,
+) (*orderpb.ResolveIncidentResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	incident, err := s.service.ResolveIncident(
+		ctx,
+		req.GetId(),
+		domain.IncidentOutcome(req.GetOutcome()),
+		req.GetResolution(),
+		req.GetOperator(),
+	)
+	if err != nil {
+		return nil, incidentError(err)
+	}
+
+	return &orderpb.ResolveIncidentResponse{Incident: convertIncident(incident)}, nil
+}
+
+func (s *OrderServiceServer) CancelIncident(
+	ctx context.Context,
+	req *orderpb.CancelIncidentRequest,
+) (*orderpb.CancelIncidentResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	incident, err := s.service.CancelIncident(ctx, req.GetId(), req.GetResolution(), req.GetOperator())
+	if err != nil {
+		return nil, incidentError(err)
+	}
+
+	return &orderpb.CancelIncidentResponse{Incident: convertIncident(incident)}, nil
+}
+
+func incidentError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrIncidentFieldsAreIncorrect),
+		errors.Is(err, domain.ErrUnknownIncidentType),
+		errors.Is(err, domain.ErrUnknownIncidentOutcome):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrIncidentNotFound),
+		errors.Is(err, domain.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrOrderIsBlocked),
+		errors.Is(err, domain.ErrIncidentIsClosed),
+		errors.Is(err, domain.ErrOrderIsInReturnManifest),
+		errors.Is(err, domain.ErrTransitionNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertIncident(incident domain.Incident) *orderpb.Incident {
+	resp := &orderpb.Incident{
+		Id:          incident.ID,
+		OrderId:     incident.OrderID,
+		Type:        string(incident.Type),
+		Description: incident.Description,
+		Operator:    incident.Operator,
+		Status:      string(incident.Status),
+		OrderStatus: string(incident.OrderStatus),
+		Resolution:  incident.Resolution,
+		CreatedAt:   timestamppb.New(incident.CreatedAt),
+		UpdatedAt:   timestamppb.New(incident.UpdatedAt),
+		ResolvedAt:  optionalTimestamp(incident.ResolvedAt),
+	}
+	if incident.Outcome != nil {
+		resp.Outcome = string(*incident.Outcome)
+	}
+	if incident.ResolvedBy != nil {
+		resp.ResolvedBy = *incident.ResolvedBy
+	}
+
+	return resp
+}
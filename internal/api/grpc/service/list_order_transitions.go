@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderServiceServer) ListOrderTransitions(
+	ctx context.Context,
+	req *orderpb.ListOrderTransitionsRequest,
+) (*orderpb.ListOrderTransitionsResponse, error) {
+	if req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required and must be positive")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required and must be positive")
+	}
+
+	transitions, err := s.service.ListAllowedTransitions(ctx, req.GetOrderId(), req.GetUserId(), s.config.OrderExpirationDays)
+	if errors.Is(err, domain.ErrOrderNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	respTransitions := make([]*orderpb.OrderTransition, len(transitions))
+	for i, t := range transitions {
+		respTransitions[i] = &orderpb.OrderTransition{
+			Action:     string(t.Action),
+			FromStatus: domain.GetStringFromStatus(t.From),
+			ToStatus:   domain.GetStringFromStatus(t.To),
+		}
+	}
+
+	return &orderpb.ListOrderTransitionsResponse{Transitions: respTransitions}, nil
+}
@@ -20,7 +20,11 @@ func (s *OrderServiceServer) ListOrders(ctx context.Context, req *orderpb.ListOr
 		}
 	}
 
-	if req.GetUserId() > 0 {
+	if req.GetItemCondition() != "" && !domain.ItemCondition(req.GetItemCondition()).IsValid() {
+		return nil, status.Error(codes.InvalidArgument, domain.ErrUnknownItemCondition.Error())
+	}
+
+	if req.GetUserId() > 0 && req.GetPickupPointId() == 0 && req.GetRefundReason() == "" && req.GetItemCondition() == "" {
 		var limitVal *int
 		var lastIDVal *int64
 		if req.GetLimit() != 0 {
@@ -54,6 +58,21 @@ func (s *OrderServiceServer) ListOrders(ctx context.Context, req *orderpb.ListOr
 		searchFilter.UserID = &userID
 	}
 
+	if req.GetPickupPointId() > 0 {
+		pickupPointID := req.GetPickupPointId()
+		searchFilter.PickupPointID = &pickupPointID
+	}
+
+	if req.GetRefundReason() != "" {
+		reason := req.GetRefundReason()
+		searchFilter.RefundReason = &reason
+	}
+
+	if req.GetItemCondition() != "" {
+		condition := req.GetItemCondition()
+		searchFilter.ItemCondition = &condition
+	}
+
 	var limitVal *int
 	var lastIDVal *int64
 	if req.GetLimit() > 0 {
@@ -75,15 +94,31 @@ func (s *OrderServiceServer) ListOrders(ctx context.Context, req *orderpb.ListOr
 func convertOrdersResponse(orders []domain.Order) *orderpb.ListOrdersResponse {
 	respOrders := make([]*orderpb.Order, len(orders))
 	for i, o := range orders {
-		respOrders[i] = &orderpb.Order{
-			OrderId:        o.OrderID,
-			UserId:         o.UserID,
-			ExpirationTime: timestamppb.New(o.ExpirationTime),
-			Status:         domain.GetStringFromStatus(o.Status),
-			Weight:         int32(o.Weight),
-			Cost:           int32(o.Cost),
-		}
+		respOrders[i] = convertOrder(o)
 	}
 
 	return &orderpb.ListOrdersResponse{Orders: respOrders}
 }
+
+func convertOrder(o domain.Order) *orderpb.Order {
+	return &orderpb.Order{
+		OrderId:          o.OrderID,
+		UserId:           o.UserID,
+		ExpirationTime:   timestamppb.New(o.ExpirationTime),
+		Status:           domain.GetStringFromStatus(o.Status),
+		Weight:           int32(o.Weight),
+		Cost:             convertMoneyResponse(o.Cost),
+		Packaging:        convertPackagingResponse(o.Packaging),
+		PackageType:      string(o.PackageType),
+		IsAdditionalFilm: o.IsAdditionalFilm,
+		BaseCost:         convertMoneyResponse(o.BaseCost),
+		PackagingCost:    convertMoneyResponse(o.PackagingCost),
+		Length:           int32(o.Length),
+		Width:            int32(o.Width),
+		Height:           int32(o.Height),
+		PickupPointId:    o.PickupPointID,
+		StorageCell:      o.StorageCell,
+		Refund:           convertRefundResponse(o.Refund),
+		StorageFee:       convertStorageFee(o.StorageFee),
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+func convertMoneyRequest(m *orderpb.Money) (domain.Money, error) {
+	return domain.NewMoney(m.GetAmount(), domain.Currency(m.GetCurrency()))
+}
+
+func convertMoneyResponse(m domain.Money) *orderpb.Money {
+	return &orderpb.Money{
+		Amount:   m.Amount,
+		Currency: string(m.Currency),
+	}
+}
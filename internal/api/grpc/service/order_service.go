@@ -12,18 +12,46 @@ import (
 type OrderService interface {
 	AddOrder(ctx context.Context,
 		orderDto service.OrderDto,
-		packageType domain.PackageType,
-		isAdditionalFilm bool) (domain.Order, error)
+		packaging []domain.PackageType) (domain.Order, error)
+	QuoteOrder(weight int,
+		cost domain.Money,
+		dimensions domain.Dimensions,
+		packaging []domain.PackageType) (domain.Quote, error)
+	RecommendPackaging(weight int,
+		cost domain.Money,
+		dimensions domain.Dimensions) (domain.Quote, error)
 	RetrieveOrdersFromFile(ctx context.Context,
-		data []byte) error
+		data []byte) ([]domain.IssuedPickupCode, error)
 	CompleteOrder(ctx context.Context,
 		orderID int64,
-		userID int64) error
+		userID int64,
+		pickupCode string,
+		storageFeePaid bool) error
+	CompleteOrders(ctx context.Context,
+		userID int64,
+		items []domain.PickupItem,
+		mode domain.BatchMode) (domain.BatchResult, error)
+	OverrideCompleteOrder(ctx context.Context,
+		orderID int64,
+		userID int64,
+		reason string,
+		storageFeePaid bool) error
+	IssuePickupCode(ctx context.Context,
+		orderID int64,
+		userID int64) (string, error)
 	ReturnOrder(ctx context.Context,
 		orderID int64) error
 	RefundOrder(ctx context.Context,
 		orderID int64,
-		expirationDays int) error
+		userID int64,
+		expirationDays int,
+		details domain.RefundDetails) error
+	RefundOrders(ctx context.Context,
+		userID int64,
+		orderIDs []int64,
+		expirationDays int,
+		details domain.RefundDetails,
+		mode domain.BatchMode) (domain.BatchResult, error)
 	GetOrdersByUserID(ctx context.Context,
 		userID int64,
 		limit *int,
@@ -39,6 +67,118 @@ type OrderService interface {
 	GetRefundedOrders(ctx context.Context,
 		lastID *int64,
 		limit *int) ([]domain.Order, error)
+	ListAllowedTransitions(ctx context.Context,
+		orderID int64,
+		userID int64,
+		expirationDays int) ([]domain.Transition, error)
+	ListRefundReasons(ctx context.Context) []domain.RefundReason
+	SearchArchivedOrders(ctx context.Context,
+		filter domain.ArchiveFilter,
+		lastID *int64,
+		limit *int) ([]domain.ArchivedOrder, error)
+	CreateReturnManifest(ctx context.Context,
+		pickupPointID int64,
+		courierName string) (domain.ReturnManifest, error)
+	GetReturnManifest(ctx context.Context,
+		id int64) (domain.ReturnManifest, error)
+	ListReturnManifests(ctx context.Context,
+		filter domain.ManifestFilter) ([]domain.ReturnManifest, error)
+	ScanReturnManifestOrder(ctx context.Context,
+		manifestID int64,
+		orderID int64) (domain.ReturnManifest, error)
+	CloseReturnManifest(ctx context.Context,
+		id int64) (domain.ReturnManifest, error)
+	ExportReturnManifest(ctx context.Context,
+		id int64,
+		format domain.ExportFormat) ([]byte, error)
+	RegisterShipment(ctx context.Context,
+		pickupPointID int64,
+		reference string,
+		items []domain.ShipmentItem) (domain.InboundShipment, error)
+	RegisterShipmentFromFile(ctx context.Context,
+		pickupPointID int64,
+		reference string,
+		data []byte) (domain.InboundShipment, error)
+	GetShipment(ctx context.Context,
+		id int64) (domain.InboundShipment, error)
+	ListShipments(ctx context.Context,
+		filter domain.ShipmentFilter) ([]domain.InboundShipment, error)
+	ScanShipmentOrder(ctx context.Context,
+		shipmentID int64,
+		orderID int64) (domain.InboundShipment, error)
+	CloseShipment(ctx context.Context,
+		id int64) (domain.ShipmentReport, error)
+	GetShipmentReport(ctx context.Context,
+		id int64) (domain.ShipmentReport, error)
+	StartStocktaking(ctx context.Context,
+		pickupPointID int64,
+		operator string) (domain.StocktakingSession, error)
+	AddStocktakingScans(ctx context.Context,
+		sessionID int64,
+		orderIDs []int64) (domain.StocktakingSession, error)
+	FinishStocktaking(ctx context.Context,
+		id int64) (domain.StocktakingSession, error)
+	GetStocktaking(ctx context.Context,
+		id int64) (domain.StocktakingSession, error)
+	ListStocktakings(ctx context.Context,
+		filter domain.StocktakingFilter) ([]domain.StocktakingSession, error)
+	OpenIncident(ctx context.Context,
+		orderID int64,
+		incidentType domain.IncidentType,
+		description string,
+		operator string) (domain.Incident, error)
+	GetIncident(ctx context.Context,
+		id int64) (domain.Incident, error)
+	ListIncidents(ctx context.Context,
+		filter domain.IncidentFilter) ([]domain.Incident, error)
+	UpdateIncident(ctx context.Context,
+		id int64,
+		update domain.IncidentUpdate) (domain.Incident, error)
+	ResolveIncident(ctx context.Context,
+		id int64,
+		outcome domain.IncidentOutcome,
+		resolution string,
+		operator string) (domain.Incident, error)
+	CancelIncident(ctx context.Context,
+		id int64,
+		resolution string,
+		operator string) (domain.Incident, error)
+	GetStorageTariff(ctx context.Context,
+		pickupPointID int64) (domain.StorageTariff, error)
+	SetStorageTariff(ctx context.Context,
+		pickupPointID int64,
+		freeDays int,
+		dailyRate domain.Money) (domain.StorageTariff, error)
+	DeleteStorageTariff(ctx context.Context,
+		pickupPointID int64) error
+	GetDailyRevenue(ctx context.Context,
+		filter domain.RevenueFilter) ([]domain.DailyRevenue, error)
+	ListDeadLetters(ctx context.Context,
+		filter domain.DeadLetterFilter) ([]domain.DeadLetter, error)
+	GetDeadLetter(ctx context.Context,
+		id int64) (domain.DeadLetter, error)
+	RequeueDeadLetter(ctx context.Context,
+		id int64) (int64, error)
+	PurgeDeadLetters(ctx context.Context,
+		filter domain.DeadLetterFilter) (int64, error)
+	ResolvePackage(packageType domain.PackageType) (domain.PackageSpec, error)
+	ListPackages(ctx context.Context) []domain.PackageSpec
+	SavePackage(ctx context.Context,
+		spec domain.PackageSpec) error
+	CreatePickupPoint(ctx context.Context,
+		point domain.PickupPoint) (domain.PickupPoint, error)
+	GetPickupPoint(ctx context.Context,
+		id int64) (domain.PickupPoint, error)
+	ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error)
+	UpdatePickupPoint(ctx context.Context,
+		point domain.PickupPoint) (domain.PickupPoint, error)
+	DeletePickupPoint(ctx context.Context,
+		id int64) error
+	CreateStorageCell(ctx context.Context,
+		cell domain.StorageCell) (domain.StorageCell, error)
+	ListStorageCells(ctx context.Context,
+		pickupPointID int64) ([]domain.StorageCell, error)
+	GetStorageOccupancy(ctx context.Context) ([]domain.StorageOccupancy, error)
 }
 
 type OrderServiceServer struct {
@@ -55,7 +195,7 @@ func (s *OrderServiceServer) isOrderRequestValid(req *orderpb.CreateOrderRequest
 	if req.GetUserId() <= 0 {
 		return false
 	}
-	if req.GetCost() <= 0 {
+	if req.GetCost().GetAmount() <= 0 {
 		return false
 	}
 	if req.GetWeight() <= 0 {
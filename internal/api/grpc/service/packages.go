@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderServiceServer) ListPackages(ctx context.Context, _ *orderpb.ListPackagesRequest) (*orderpb.ListPackagesResponse, error) {
+	specs := s.service.ListPackages(ctx)
+
+	packages := make([]*orderpb.Package, len(specs))
+	for i, spec := range specs {
+		packages[i] = convertPackageResponse(spec)
+	}
+
+	return &orderpb.ListPackagesResponse{Packages: packages}, nil
+}
+
+func (s *OrderServiceServer) UpsertPackage(ctx context.Context, req *orderpb.UpsertPackageRequest) (*orderpb.UpsertPackageResponse, error) {
+	p := req.GetPackage()
+	price, err := convertMoneyRequest(p.GetPrice())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spec, err := domain.NewPackageSpec(
+		p.GetName(),
+		int(p.GetMaxWeight()),
+		int(p.GetMaxVolume()),
+		int(p.GetMaxLength()),
+		price,
+		p.GetCanWrap(),
+		p.GetIsActive(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.service.SavePackage(ctx, spec); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &orderpb.UpsertPackageResponse{Package: convertPackageResponse(spec)}, nil
+}
+
+func convertPackageResponse(spec domain.PackageSpec) *orderpb.Package {
+	return &orderpb.Package{
+		Name:      string(spec.Name),
+		MaxWeight: int32(spec.MaxWeight),
+		MaxVolume: int32(spec.MaxVolume),
+		MaxLength: int32(spec.MaxLength),
+		Price:     convertMoneyResponse(spec.Price),
+		CanWrap:   spec.CanWrap,
+		IsActive:  spec.IsActive,
+	}
+}
+
+func convertPackagingResponse(layers []domain.PackagingLayer) []*orderpb.PackagingLayer {
+	packaging := make([]*orderpb.PackagingLayer, len(layers))
+	for i, layer := range layers {
+		packaging[i] = &orderpb.PackagingLayer{
+			Position:    int32(layer.Position),
+			PackageType: string(layer.PackageType),
+			Cost:        convertMoneyResponse(layer.Cost),
+		}
+	}
+
+	return packaging
+}
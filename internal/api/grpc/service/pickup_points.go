@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderServiceServer) CreatePickupPoint(
+	ctx context.Context,
+	req *orderpb.CreatePickupPointRequest,
+) (*orderpb.CreatePickupPointResponse, error) {
+	point, err := convertPickupPointRequest(req.GetPickupPoint())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	point, err = s.service.CreatePickupPoint(ctx, point)
+	if err != nil {
+		return nil, pickupPointError(err)
+	}
+
+	return &orderpb.CreatePickupPointResponse{PickupPoint: convertPickupPointResponse(point)}, nil
+}
+
+func (s *OrderServiceServer) GetPickupPoint(
+	ctx context.Context,
+	req *orderpb.GetPickupPointRequest,
+) (*orderpb.GetPickupPointResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	point, err := s.service.GetPickupPoint(ctx, req.GetId())
+	if err != nil {
+		return nil, pickupPointError(err)
+	}
+
+	return &orderpb.GetPickupPointResponse{PickupPoint: convertPickupPointResponse(point)}, nil
+}
+
+func (s *OrderServiceServer) ListPickupPoints(
+	ctx context.Context,
+	_ *orderpb.ListPickupPointsRequest,
+) (*orderpb.ListPickupPointsResponse, error) {
+	points, err := s.service.ListPickupPoints(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := make([]*orderpb.PickupPoint, len(points))
+	for i, point := range points {
+		resp[i] = convertPickupPointResponse(point)
+	}
+
+	return &orderpb.ListPickupPointsResponse{PickupPoints: resp}, nil
+}
+
+func (s *OrderServiceServer) UpdatePickupPoint(
+	ctx context.Context,
+	req *orderpb.UpdatePickupPointRequest,
+) (*orderpb.UpdatePickupPointResponse, error) {
+	if req.GetPickupPoint().GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	point, err := convertPickupPointRequest(req.GetPickupPoint())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	point.ID = req.GetPickupPoint().GetId()
+
+	point, err = s.service.UpdatePickupPoint(ctx, point)
+	if err != nil {
+		return nil, pickupPointError(err)
+	}
+
+	return &orderpb.UpdatePickupPointResponse{PickupPoint: convertPickupPointResponse(point)}, nil
+}
+
+func (s *OrderServiceServer) DeletePickupPoint(
+	ctx context.Context,
+	req *orderpb.DeletePickupPointRequest,
+) (*orderpb.DeletePickupPointResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	if err := s.service.DeletePickupPoint(ctx, req.GetId()); err != nil {
+		return nil, pickupPointError(err)
+	}
+
+	return &orderpb.DeletePickupPointResponse{}, nil
+}
+
+func pickupPointError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPickupPointNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrPickupPointHasOrders),
+		errors.Is(err, domain.ErrDefaultPickupPoint):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertPickupPointRequest(p *orderpb.PickupPoint) (domain.PickupPoint, error) {
+	return domain.NewPickupPoint(
+		p.GetName(),
+		p.GetAddress(),
+		p.GetTimezone(),
+		p.GetOpensAt(),
+		p.GetClosesAt(),
+		int(p.GetOrderExpirationDays()),
+	)
+}
+
+func convertPickupPointResponse(point domain.PickupPoint) *orderpb.PickupPoint {
+	return &orderpb.PickupPoint{
+		Id:                  point.ID,
+		Name:                point.Name,
+		Address:             point.Address,
+		Timezone:            point.Timezone,
+		OpensAt:             point.OpensAt,
+		ClosesAt:            point.ClosesAt,
+		OrderExpirationDays: int32(point.OrderExpirationDays),
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -12,14 +14,22 @@ import (
 func (s *OrderServiceServer) ProcessOrder(ctx context.Context, req *orderpb.ProcessOrderRequest) (*orderpb.ProcessOrderResponse, error) {
 	switch req.GetAction() {
 	case "complete":
-		err := s.service.CompleteOrder(ctx, req.GetOrderId(), req.GetUserId())
+		err := s.service.CompleteOrder(ctx, req.GetOrderId(), req.GetUserId(), req.GetPickupCode(), req.GetStorageFeePaid())
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, completeOrderError(err)
+		}
+	case "override":
+		err := s.service.OverrideCompleteOrder(ctx, req.GetOrderId(), req.GetUserId(), req.GetOverrideReason(), req.GetStorageFeePaid())
+		if err != nil {
+			return nil, completeOrderError(err)
 		}
 	case "refund":
-		err := s.service.RefundOrder(ctx, req.GetOrderId(), s.config.OrderExpirationDays)
+		details, err := domain.NewRefundDetails(req.GetRefundReason(), req.GetRefundComment(), req.GetItemCondition())
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.service.RefundOrder(ctx, req.GetOrderId(), req.GetUserId(), s.config.OrderExpirationDays, details); err != nil {
+			return nil, refundOrderError(err)
 		}
 	default:
 		return nil, status.Error(codes.InvalidArgument, "Invalid action")
@@ -27,3 +37,48 @@ func (s *OrderServiceServer) ProcessOrder(ctx context.Context, req *orderpb.Proc
 
 	return &orderpb.ProcessOrderResponse{}, nil
 }
+
+func (s *OrderServiceServer) IssuePickupCode(ctx context.Context, req *orderpb.IssuePickupCodeRequest) (*orderpb.IssuePickupCodeResponse, error) {
+	if req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required and must be positive")
+	}
+	if req.GetUserId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required and must be positive")
+	}
+
+	code, err := s.service.IssuePickupCode(ctx, req.GetOrderId(), req.GetUserId())
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOrderNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrOrderNotBelongToUser), errors.Is(err, domain.ErrSupervisorRequired):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrTransitionNotAllowed):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &orderpb.IssuePickupCodeResponse{PickupCode: code}, nil
+}
+
+func completeOrderError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPickupCodeRequired),
+		errors.Is(err, domain.ErrPickupOverrideFieldsAreIncorrect):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrSupervisorRequired),
+		errors.Is(err, domain.ErrPickupCodeInvalid),
+		errors.Is(err, domain.ErrPickupCodeUsed),
+		errors.Is(err, domain.ErrPickupCodeNotIssued):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrPickupCodeLocked):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, domain.ErrOrderIsBlocked),
+		errors.Is(err, domain.ErrStorageFeeNotPaid):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderServiceServer) QuoteOrder(_ context.Context, req *orderpb.QuoteOrderRequest) (*orderpb.QuoteOrderResponse, error) {
+	packaging := packagingFromRequest(req.GetPackageType(), req.GetIsAdditionalFilm(), req.GetPackagingLayers())
+
+	dimensions := domain.Dimensions{Length: int(req.GetLength()), Width: int(req.GetWidth()), Height: int(req.GetHeight())}
+
+	cost, err := convertMoneyRequest(req.GetCost())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	quote, err := s.service.QuoteOrder(int(req.GetWeight()), cost, dimensions, packaging)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOrderFieldsAreIncorrect),
+			errors.Is(err, domain.ErrIncorrectWeightForApplyPackage),
+			errors.Is(err, domain.ErrIncorrectSizeForApplyPackage),
+			errors.Is(err, domain.ErrNoSuitablePackage),
+			errors.Is(err, domain.ErrCurrencyMismatch),
+			errors.Is(err, domain.ErrPackageNotExists),
+			errors.Is(err, domain.ErrPackageNotActive),
+			errors.Is(err, domain.ErrPackageCannotWrap),
+			errors.Is(err, domain.ErrPackagingCompositionNotAllowed):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &orderpb.QuoteOrderResponse{
+		BaseCost:      convertMoneyResponse(quote.BaseCost),
+		Packaging:     convertPackagingResponse(quote.Packaging),
+		PackagingCost: convertMoneyResponse(quote.PackagingCost),
+		TotalCost:     convertMoneyResponse(quote.TotalCost),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderServiceServer) RecommendPackaging(
+	_ context.Context,
+	req *orderpb.RecommendPackagingRequest,
+) (*orderpb.RecommendPackagingResponse, error) {
+	dimensions := domain.Dimensions{Length: int(req.GetLength()), Width: int(req.GetWidth()), Height: int(req.GetHeight())}
+
+	cost, err := convertMoneyRequest(req.GetCost())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	quote, err := s.service.RecommendPackaging(int(req.GetWeight()), cost, dimensions)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOrderFieldsAreIncorrect):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrNoSuitablePackage):
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	var packageType string
+	if len(quote.Packaging) > 0 {
+		packageType = string(quote.Packaging[0].PackageType)
+	}
+
+	return &orderpb.RecommendPackagingResponse{
+		PackageType:   packageType,
+		BaseCost:      convertMoneyResponse(quote.BaseCost),
+		Packaging:     convertPackagingResponse(quote.Packaging),
+		PackagingCost: convertMoneyResponse(quote.PackagingCost),
+		TotalCost:     convertMoneyResponse(quote.TotalCost),
+	}, nil
+}
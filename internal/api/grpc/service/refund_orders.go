@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+)
+
+func (s *OrderServiceServer) RefundOrders(ctx context.Context, req *orderpb.RefundOrdersRequest) (*orderpb.RefundOrdersResponse, error) {
+	mode, err := domain.ParseBatchMode(req.GetMode())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	details, err := domain.NewRefundDetails(req.GetRefundReason(), req.GetRefundComment(), req.GetItemCondition())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.service.RefundOrders(ctx, req.GetUserId(), req.GetOrderIds(), s.config.OrderExpirationDays, details, mode)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnknownRefundReason) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, batchError(err)
+	}
+
+	results, totals := convertBatchResult(result)
+
+	return &orderpb.RefundOrdersResponse{Results: results, Totals: totals}, nil
+}
+
+func (s *OrderServiceServer) ListRefundReasons(ctx context.Context, _ *orderpb.ListRefundReasonsRequest) (*orderpb.ListRefundReasonsResponse, error) {
+	reasons := s.service.ListRefundReasons(ctx)
+	resp := make([]*orderpb.RefundReason, 0, len(reasons))
+	for _, r := range reasons {
+		resp = append(resp, &orderpb.RefundReason{Code: r.Code, Description: r.Description})
+	}
+
+	return &orderpb.ListRefundReasonsResponse{Reasons: resp}, nil
+}
+
+func convertRefundResponse(details *domain.RefundDetails) *orderpb.RefundDetails {
+	if details == nil {
+		return nil
+	}
+
+	return &orderpb.RefundDetails{
+		ReasonCode:    details.ReasonCode,
+		Comment:       details.Comment,
+		ItemCondition: string(details.Condition),
+		CreatedAt:     timestamppb.New(details.CreatedAt),
+	}
+}
+
+func refundOrderError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnknownRefundReason):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrOrderNotBelongToUser):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrOrderNotCompleted),
+		errors.Is(err, domain.ErrOrderCannotBeRefunded),
+		errors.Is(err, domain.ErrOrderIsBlocked),
+		errors.Is(err, domain.ErrNoFreeStorageCell):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) CreateReturnManifest(
+	ctx context.Context,
+	req *orderpb.CreateReturnManifestRequest,
+) (*orderpb.CreateReturnManifestResponse, error) {
+	manifest, err := s.service.CreateReturnManifest(ctx, req.GetPickupPointId(), req.GetCourierName())
+	if err != nil {
+		return nil, returnManifestError(err)
+	}
+
+	return &orderpb.CreateReturnManifestResponse{Manifest: convertReturnManifest(manifest)}, nil
+}
+
+func (s *OrderServiceServer) GetReturnManifest(
+	ctx context.Context,
+	req *orderpb.GetReturnManifestRequest,
+) (*orderpb.GetReturnManifestResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	manifest, err := s.service.GetReturnManifest(ctx, req.GetId())
+	if err != nil {
+		return nil, returnManifestError(err)
+	}
+
+	return &orderpb.GetReturnManifestResponse{Manifest: convertReturnManifest(manifest)}, nil
+}
+
+func (s *OrderServiceServer) ListReturnManifests(
+	ctx context.Context,
+	req *orderpb.ListReturnManifestsRequest,
+) (*orderpb.ListReturnManifestsResponse, error) {
+	var filter domain.ManifestFilter
+	if req.GetPickupPointId() > 0 {
+		pickupPointID := req.GetPickupPointId()
+		filter.PickupPointID = &pickupPointID
+	}
+	if req.GetStatus() != "" {
+		manifestStatus, err := domain.ParseManifestStatus(req.GetStatus())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Status = &manifestStatus
+	}
+
+	manifests, err := s.service.ListReturnManifests(ctx, filter)
+	if err != nil {
+		return nil, returnManifestError(err)
+	}
+
+	resp := make([]*orderpb.ReturnManifest, len(manifests))
+	for i, manifest := range manifests {
+		resp[i] = convertReturnManifest(manifest)
+	}
+
+	return &orderpb.ListReturnManifestsResponse{Manifests: resp}, nil
+}
+
+func (s *OrderServiceServer) ScanReturnManifestOrder(
+	ctx context.Context,
+	req *orderpb.ScanReturnManifestOrderRequest,
+) (*orderpb.ScanReturnManifestOrderResponse, error) {
+	if req.GetManifestId() <= 0 || req.GetOrderId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "manifest_id and order_id are required and must be positive")
+	}
+
+	manifest, err := s.service.ScanReturnManifestOrder(ctx, req.GetManifestId(), req.GetOrderId())
+	if err != nil {
+		return nil, returnManifestError(err)
+	}
+
+	return &orderpb.ScanReturnManifestOrderResponse{Manifest: convertReturnManifest(manifest)}, nil
+}
+
+func (s *OrderServiceServer) CloseReturnManifest(
+	ctx context.Context,
+	req *orderpb.CloseReturnManifestRequest,
+) (*orderpb.CloseReturnManifestResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	manifest, err := s.service.CloseReturnManifest(ctx, req.GetId())
+	if err != nil {
+		return nil, returnManifestError(err)
+	}
+
+	return &orderpb.CloseReturnManifestResponse{Manifest: convertReturnManifest(manifest)}, nil
+}
+
+func (s *OrderServiceServer) ExportReturnManifest(
+	ctx context.Context,
+	req *orderpb.ExportReturnManifestRequest,
+) (*orderpb.ExportReturnManifestResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+	format, err := domain.ParseExportFormat(req.GetFormat())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	content, err := s.service.ExportReturnManifest(ctx, req.GetId(), format)
+	if err != nil {
+		return nil, returnManifestError(err)
+	}
+
+	return &orderpb.ExportReturnManifestResponse{Content: content, ContentType: format.ContentType()}, nil
+}
+
+func returnManifestError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrReturnManifestFieldsAreIncorrect),
+		errors.Is(err, domain.ErrUnknownExportFormat):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrReturnManifestNotFound),
+		errors.Is(err, domain.ErrPickupPointNotFound),
+		errors.Is(err, domain.ErrOrderNotInManifest):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrReturnManifestIsClosed),
+		errors.Is(err, domain.ErrNothingToReturn),
+		errors.Is(err, domain.ErrNoScannedOrders),
+		errors.Is(err, domain.ErrOrderNotFound),
+		errors.Is(err, domain.ErrTransitionNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertReturnManifest(manifest domain.ReturnManifest) *orderpb.ReturnManifest {
+	items := make([]*orderpb.ReturnManifestItem, len(manifest.Items))
+	for i, item := range manifest.Items {
+		items[i] = &orderpb.ReturnManifestItem{
+			OrderId:     item.OrderID,
+			UserId:      item.UserID,
+			OrderStatus: domain.GetStringFromStatus(item.OrderStatus),
+			ScannedAt:   optionalTimestamp(item.ScannedAt),
+		}
+	}
+
+	return &orderpb.ReturnManifest{
+		Id:            manifest.ID,
+		PickupPointId: manifest.PickupPointID,
+		CourierName:   manifest.CourierName,
+		Status:        string(manifest.Status),
+		CreatedAt:     timestamppb.New(manifest.CreatedAt),
+		ClosedAt:      optionalTimestamp(manifest.ClosedAt),
+		Items:         items,
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
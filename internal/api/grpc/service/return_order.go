@@ -15,6 +15,9 @@ func (s *OrderServiceServer) ReturnOrder(ctx context.Context, req *orderpb.Retur
 	if errors.Is(err, domain.ErrOrderNotFound) {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	if errors.Is(err, domain.ErrOrderIsInReturnManifest) || errors.Is(err, domain.ErrOrderIsBlocked) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) SearchArchivedOrders(
+	ctx context.Context,
+	req *orderpb.SearchArchivedOrdersRequest,
+) (*orderpb.SearchArchivedOrdersResponse, error) {
+	if req.GetLimit() < 0 || req.GetLastId() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and last_id should not be negative")
+	}
+
+	filter, err := domain.NewArchiveFilter(
+		positiveOrNil(req.GetOrderId()),
+		positiveOrNil(req.GetUserId()),
+		positiveOrNil(req.GetPickupPointId()),
+		timestampOrNil(req.GetArchivedFrom()),
+		timestampOrNil(req.GetArchivedTo()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var limitVal *int
+	if req.GetLimit() > 0 {
+		l := int(req.GetLimit())
+		limitVal = &l
+	}
+	orders, err := s.service.SearchArchivedOrders(ctx, filter, positiveOrNil(req.GetLastId()), limitVal)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	respOrders := make([]*orderpb.ArchivedOrder, len(orders))
+	for i, o := range orders {
+		respOrders[i] = &orderpb.ArchivedOrder{
+			Order:      convertOrder(o.Order),
+			ArchivedAt: timestamppb.New(o.ArchivedAt),
+		}
+	}
+
+	return &orderpb.SearchArchivedOrdersResponse{Orders: respOrders}, nil
+}
+
+func positiveOrNil(value int64) *int64 {
+	if value <= 0 {
+		return nil
+	}
+
+	return &value
+}
+
+func timestampOrNil(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+
+	return &t
+}
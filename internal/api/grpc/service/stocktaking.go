@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) StartStocktaking(
+	ctx context.Context,
+	req *orderpb.StartStocktakingRequest,
+) (*orderpb.StartStocktakingResponse, error) {
+	session, err := s.service.StartStocktaking(ctx, req.GetPickupPointId(), req.GetOperator())
+	if err != nil {
+		return nil, stocktakingError(err)
+	}
+
+	return &orderpb.StartStocktakingResponse{Session: convertStocktakingSession(session)}, nil
+}
+
+// ScanStocktaking stores streamed scans in batches and answers with the
+// session once the client closes the stream.
+func (s *OrderServiceServer) ScanStocktaking(
+	stream grpc.ClientStreamingServer[orderpb.ScanStocktakingRequest, orderpb.ScanStocktakingResponse],
+) error {
+	var (
+		sessionID int64
+		batch     = make([]int64, 0, service.StocktakingScanBatchSize)
+	)
+	flush := func() (domain.StocktakingSession, error) {
+		session, err := s.service.AddStocktakingScans(stream.Context(), sessionID, batch)
+		batch = batch[:0]
+
+		return session, err
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetSessionId() <= 0 || req.GetOrderId() <= 0 {
+			return status.Error(codes.InvalidArgument, "session_id and order_id are required and must be positive")
+		}
+		if sessionID == 0 {
+			sessionID = req.GetSessionId()
+		}
+		if req.GetSessionId() != sessionID {
+			return status.Error(codes.InvalidArgument, "all scans of a stream must belong to one session")
+		}
+
+		batch = append(batch, req.GetOrderId())
+		if len(batch) == service.StocktakingScanBatchSize {
+			if _, err := flush(); err != nil {
+				return stocktakingError(err)
+			}
+		}
+	}
+	if sessionID == 0 {
+		return status.Error(codes.InvalidArgument, "no scans were sent")
+	}
+
+	session, err := flush()
+	if err != nil {
+		return stocktakingError(err)
+	}
+
+	return stream.SendAndClose(&orderpb.ScanStocktakingResponse{Session: convertStocktakingSession(session)})
+}
+
+func (s *OrderServiceServer) FinishStocktaking(
+	ctx context.Context,
+	req *orderpb.FinishStocktakingRequest,
+) (*orderpb.FinishStocktakingResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	session, err := s.service.FinishStocktaking(ctx, req.GetId())
+	if err != nil {
+		return nil, stocktakingError(err)
+	}
+
+	return &orderpb.FinishStocktakingResponse{Session: convertStocktakingSession(session)}, nil
+}
+
+func (s *OrderServiceServer) GetStocktaking(
+	ctx context.Context,
+	req *orderpb.GetStocktakingRequest,
+) (*orderpb.GetStocktakingResponse, error) {
+	if req.GetId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required and must be positive")
+	}
+
+	session, err := s.service.GetStocktaking(ctx, req.GetId())
+	if err != nil {
+		return nil, stocktakingError(err)
+	}
+
+	return &orderpb.GetStocktakingResponse{Session: convertStocktakingSession(session)}, nil
+}
+
+func (s *OrderServiceServer) ListStocktakings(
+	ctx context.Context,
+	req *orderpb.ListStocktakingsRequest,
+) (*orderpb.ListStocktakingsResponse, error) {
+	var filter domain.StocktakingFilter
+	if req.GetPickupPointId() > 0 {
+		pickupPointID := req.GetPickupPointId()
+		filter.PickupPointID = &pickupPointID
+	}
+	if req.GetStatus() != "" {
+		sessionStatus, err := domain.ParseStocktakingStatus(req.GetStatus())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		filter.Status = &sessionStatus
+	}
+
+	sessions, err := s.service.ListStocktakings(ctx, filter)
+	if err != nil {
+		return nil, stocktakingError(err)
+	}
+
+	resp := make([]*orderpb.StocktakingSession, len(sessions))
+	for i, session := range sessions {
+		resp[i] = convertStocktakingSession(session)
+	}
+
+	return &orderpb.ListStocktakingsResponse{Sessions: resp}, nil
+}
+
+func stocktakingError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrStocktakingFieldsAreIncorrect):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrStocktakingNotFound),
+		errors.Is(err, domain.ErrPickupPointNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrStocktakingAlreadyOpen):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrStocktakingIsFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertStocktakingSession(session domain.StocktakingSession) *orderpb.StocktakingSession {
+	resp := &orderpb.StocktakingSession{
+		Id:            session.ID,
+		PickupPointId: session.PickupPointID,
+		Operator:      session.Operator,
+		Status:        string(session.Status),
+		StartedAt:     timestamppb.New(session.StartedAt),
+		FinishedAt:    optionalTimestamp(session.FinishedAt),
+		ScannedCount:  int32(session.ScannedCount),
+	}
+	if session.Result != nil {
+		resp.Result = &orderpb.StocktakingResult{
+			Expected: int32(session.Result.Expected),
+			Found:    int32(session.Result.Found),
+			Missing:  session.Result.Missing,
+			Unknown:  session.Result.Unknown,
+		}
+	}
+
+	return resp
+}
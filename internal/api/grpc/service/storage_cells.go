@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *OrderServiceServer) CreateStorageCell(
+	ctx context.Context,
+	req *orderpb.CreateStorageCellRequest,
+) (*orderpb.CreateStorageCellResponse, error) {
+	c := req.GetStorageCell()
+	cell, err := domain.NewStorageCell(
+		c.GetPickupPointId(),
+		c.GetCode(),
+		int(c.GetMaxWeight()),
+		int(c.GetMaxVolume()),
+		int(c.GetMaxLength()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cell, err = s.service.CreateStorageCell(ctx, cell)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPickupPointNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrStorageCellAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &orderpb.CreateStorageCellResponse{StorageCell: convertStorageCellResponse(cell)}, nil
+}
+
+func (s *OrderServiceServer) ListStorageCells(
+	ctx context.Context,
+	req *orderpb.ListStorageCellsRequest,
+) (*orderpb.ListStorageCellsResponse, error) {
+	if req.GetPickupPointId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "pickup_point_id is required and must be positive")
+	}
+
+	cells, err := s.service.ListStorageCells(ctx, req.GetPickupPointId())
+	if err != nil {
+		return nil, pickupPointError(err)
+	}
+
+	resp := make([]*orderpb.StorageCell, len(cells))
+	for i, cell := range cells {
+		resp[i] = convertStorageCellResponse(cell)
+	}
+
+	return &orderpb.ListStorageCellsResponse{StorageCells: resp}, nil
+}
+
+func (s *OrderServiceServer) GetStorageOccupancy(
+	ctx context.Context,
+	_ *orderpb.GetStorageOccupancyRequest,
+) (*orderpb.GetStorageOccupancyResponse, error) {
+	occupancy, err := s.service.GetStorageOccupancy(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := make([]*orderpb.StorageOccupancy, len(occupancy))
+	for i, oc := range occupancy {
+		resp[i] = &orderpb.StorageOccupancy{
+			PickupPointId: oc.PickupPointID,
+			Total:         int32(oc.Total),
+			Occupied:      int32(oc.Occupied),
+			FillRatio:     oc.FillRatio(),
+		}
+	}
+
+	return &orderpb.GetStorageOccupancyResponse{Occupancy: resp}, nil
+}
+
+func convertStorageCellResponse(cell domain.StorageCell) *orderpb.StorageCell {
+	resp := &orderpb.StorageCell{
+		Id:            cell.ID,
+		PickupPointId: cell.PickupPointID,
+		Code:          cell.Code,
+		MaxWeight:     int32(cell.MaxWeight),
+		MaxVolume:     int32(cell.MaxVolume),
+		MaxLength:     int32(cell.MaxLength),
+	}
+	if cell.OrderID != nil {
+		resp.OrderId = *cell.OrderID
+	}
+
+	return resp
+}
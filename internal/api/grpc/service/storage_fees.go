@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orderpb "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/grpc/generated"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *OrderServiceServer) GetStorageTariff(
+	ctx context.Context,
+	req *orderpb.GetStorageTariffRequest,
+) (*orderpb.GetStorageTariffResponse, error) {
+	if req.GetPickupPointId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "pickup_point_id is required and must be positive")
+	}
+
+	tariff, err := s.service.GetStorageTariff(ctx, req.GetPickupPointId())
+	if err != nil {
+		return nil, storageTariffError(err)
+	}
+
+	return &orderpb.GetStorageTariffResponse{Tariff: convertStorageTariff(tariff)}, nil
+}
+
+func (s *OrderServiceServer) SetStorageTariff(
+	ctx context.Context,
+	req *orderpb.SetStorageTariffRequest,
+) (*orderpb.SetStorageTariffResponse, error) {
+	dailyRate, err := convertMoneyRequest(req.GetDailyRate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tariff, err := s.service.SetStorageTariff(ctx, req.GetPickupPointId(), int(req.GetFreeDays()), dailyRate)
+	if err != nil {
+		return nil, storageTariffError(err)
+	}
+
+	return &orderpb.SetStorageTariffResponse{Tariff: convertStorageTariff(tariff)}, nil
+}
+
+func (s *OrderServiceServer) DeleteStorageTariff(
+	ctx context.Context,
+	req *orderpb.DeleteStorageTariffRequest,
+) (*orderpb.DeleteStorageTariffResponse, error) {
+	if req.GetPickupPointId() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "pickup_point_id is required and must be positive")
+	}
+
+	if err := s.service.DeleteStorageTariff(ctx, req.GetPickupPointId()); err != nil {
+		return nil, storageTariffError(err)
+	}
+
+	return &orderpb.DeleteStorageTariffResponse{}, nil
+}
+
+func (s *OrderServiceServer) GetDailyRevenue(
+	ctx context.Context,
+	req *orderpb.GetDailyRevenueRequest,
+) (*orderpb.GetDailyRevenueResponse, error) {
+	var pickupPointID *int64
+	if req.GetPickupPointId() > 0 {
+		id := req.GetPickupPointId()
+		pickupPointID = &id
+	}
+	filter, err := domain.NewRevenueFilter(req.GetFrom().AsTime(), req.GetTo().AsTime(), pickupPointID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revenue, err := s.service.GetDailyRevenue(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	days := make([]*orderpb.DailyRevenue, len(revenue))
+	for i, day := range revenue {
+		days[i] = &orderpb.DailyRevenue{
+			Date:            timestamppb.New(day.Date),
+			PickupPointId:   day.PickupPointID,
+			OrdersIssued:    int32(day.OrdersIssued),
+			OrdersRevenue:   convertMoneyResponse(day.OrdersRevenue),
+			StorageFeesPaid: int32(day.StorageFeesPaid),
+			StorageFees:     convertMoneyResponse(day.StorageFees),
+			Total:           convertMoneyResponse(day.Total),
+		}
+	}
+
+	return &orderpb.GetDailyRevenueResponse{Days: days}, nil
+}
+
+func storageTariffError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrStorageTariffFieldsAreIncorrect):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPickupPointNotFound),
+		errors.Is(err, domain.ErrStorageTariffNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func convertStorageTariff(tariff domain.StorageTariff) *orderpb.StorageTariff {
+	resp := &orderpb.StorageTariff{
+		FreeDays:  int32(tariff.FreeDays),
+		DailyRate: convertMoneyResponse(tariff.DailyRate),
+	}
+	if tariff.PickupPointID != nil {
+		resp.PickupPointId = *tariff.PickupPointID
+	}
+
+	return resp
+}
+
+func convertStorageFee(fee *domain.StorageFee) *orderpb.StorageFee {
+	if fee == nil {
+		return nil
+	}
+
+	return &orderpb.StorageFee{
+		StoredSince: timestamppb.New(fee.StoredSince),
+		StoredDays:  int32(fee.StoredDays),
+		FreeDays:    int32(fee.FreeDays),
+		ChargedDays: int32(fee.ChargedDays),
+		DailyRate:   convertMoneyResponse(fee.DailyRate),
+		Amount:      convertMoneyResponse(fee.Amount),
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type PickupItemRequest struct {
+	OrderID        int64  `json:"order_id"`
+	PickupCode     string `json:"pickup_code"`
+	StorageFeePaid bool   `json:"storage_fee_paid"`
+}
+
+type CompleteOrdersRequest struct {
+	UserID int64               `json:"user_id"`
+	Mode   string              `json:"mode"`
+	Orders []PickupItemRequest `json:"orders"`
+}
+
+type BatchOrderResult struct {
+	OrderID int64  `json:"order_id"`
+	OK      bool   `json:"ok"`
+	Error   string `json:"error,omitempty"`
+}
+
+type BatchOrdersResponse struct {
+	Results []BatchOrderResult `json:"results"`
+	Totals  []domain.Money     `json:"totals"`
+}
+
+func (h *OrderHandler) CompleteOrders(w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var cr CompleteOrdersRequest
+	if err := json.Unmarshal(body, &cr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	mode, err := domain.ParseBatchMode(cr.Mode)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	items := make([]domain.PickupItem, 0, len(cr.Orders))
+	for _, item := range cr.Orders {
+		items = append(items, domain.PickupItem{
+			OrderID:        item.OrderID,
+			PickupCode:     item.PickupCode,
+			StorageFeePaid: item.StorageFeePaid,
+		})
+	}
+
+	result, err := h.service.CompleteOrders(r.Context(), cr.UserID, items, mode)
+	if err != nil {
+		h.writeBatchError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(newBatchOrdersResponse(result), w)
+}
+
+func newBatchOrdersResponse(result domain.BatchResult) BatchOrdersResponse {
+	resp := BatchOrdersResponse{
+		Results: make([]BatchOrderResult, 0, len(result.Items)),
+		Totals:  result.Totals,
+	}
+	if resp.Totals == nil {
+		resp.Totals = []domain.Money{}
+	}
+	for _, item := range result.Items {
+		res := BatchOrderResult{OrderID: item.OrderID, OK: item.OK()}
+		if item.Err != nil {
+			res.Error = item.Err.Error()
+		}
+		resp.Results = append(resp.Results, res)
+	}
+
+	return resp
+}
+
+func (h *OrderHandler) writeBatchError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrBatchIsEmpty),
+		errors.Is(err, domain.ErrBatchTooLarge),
+		errors.Is(err, domain.ErrBatchHasDuplicates):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -11,18 +11,24 @@ import (
 )
 
 type CreateOrderRequest struct {
-	OrderID          int64     `json:"order_id"`
-	UserID           int64     `json:"user_id"`
-	ExpirationTime   time.Time `json:"expiration_time"`
-	Status           string    `json:"status"`
-	Weight           int       `json:"weight"`
-	Cost             int       `json:"cost"`
-	PackageType      string    `json:"package_type"`
-	IsAdditionalFilm bool      `json:"is_additional_film"`
+	OrderID          int64        `json:"order_id"`
+	UserID           int64        `json:"user_id"`
+	ExpirationTime   time.Time    `json:"expiration_time"`
+	Status           string       `json:"status"`
+	Weight           int          `json:"weight"`
+	Cost             domain.Money `json:"cost"`
+	PackageType      string       `json:"package_type"`
+	IsAdditionalFilm bool         `json:"is_additional_film"`
+	PackagingLayers  []string     `json:"packaging_layers"`
+	Length           int          `json:"length"`
+	Width            int          `json:"width"`
+	Height           int          `json:"height"`
+	PickupPointID    int64        `json:"pickup_point_id"`
 }
 
 type CreateOrderResponse struct {
-	OrderID int64
+	OrderID    int64
+	PickupCode string `json:"pickup_code"`
 }
 
 func (h *OrderHandler) ConfirmOrder(w http.ResponseWriter, req *http.Request) {
@@ -46,7 +52,26 @@ func (h *OrderHandler) ConfirmOrder(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	packageTypeFromString, err := domain.GetPackageTypeFromString(oc.PackageType)
+	packaging := packagingFromRequest(oc.PackageType, oc.IsAdditionalFilm, oc.PackagingLayers)
+	for _, packageType := range packaging {
+		if packageType == domain.Auto {
+			continue
+		}
+		if _, err := h.service.ResolvePackage(packageType); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	dimensions, err := domain.NewDimensions(oc.Length, oc.Width, oc.Height)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	cost, err := domain.NewMoney(oc.Cost.Amount, oc.Cost.Currency)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 
@@ -58,15 +83,36 @@ func (h *OrderHandler) ConfirmOrder(w http.ResponseWriter, req *http.Request) {
 		UserID:         oc.UserID,
 		ExpirationTime: oc.ExpirationTime,
 		Weight:         oc.Weight,
-		Cost:           oc.Cost,
+		Cost:           cost,
+		Dimensions:     dimensions,
+		PickupPointID:  oc.PickupPointID,
 	}
 
-	order, err := h.service.AddOrder(req.Context(), dto, packageTypeFromString, oc.IsAdditionalFilm)
+	order, err := h.service.AddOrder(req.Context(), dto, packaging)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrOrderAlreadyExists):
 			http.Error(w, err.Error(), http.StatusConflict)
 
+			return
+		case errors.Is(err, domain.ErrPickupPointNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+
+			return
+		case errors.Is(err, domain.ErrNoFreeStorageCell):
+			http.Error(w, err.Error(), http.StatusConflict)
+
+			return
+		case errors.Is(err, domain.ErrIncorrectWeightForApplyPackage),
+			errors.Is(err, domain.ErrIncorrectSizeForApplyPackage),
+			errors.Is(err, domain.ErrNoSuitablePackage),
+			errors.Is(err, domain.ErrCurrencyMismatch),
+			errors.Is(err, domain.ErrPackageNotExists),
+			errors.Is(err, domain.ErrPackageNotActive),
+			errors.Is(err, domain.ErrPackageCannotWrap),
+			errors.Is(err, domain.ErrPackagingCompositionNotAllowed):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
 			return
 		default:
 			http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -76,7 +122,23 @@ func (h *OrderHandler) ConfirmOrder(w http.ResponseWriter, req *http.Request) {
 	}
 
 	response := CreateOrderResponse{
-		OrderID: order.OrderID,
+		OrderID:    order.OrderID,
+		PickupCode: order.PickupCode,
 	}
 	_ = h.writeResponseToHeader(response, w)
 }
+
+// packagingFromRequest prefers explicit layers and falls back to the legacy
+// package type with the additional film flag.
+func packagingFromRequest(packageType string, isAdditionalFilm bool, layers []string) []domain.PackageType {
+	if len(layers) == 0 {
+		return domain.NewPackagingLayers(domain.PackageType(packageType), isAdditionalFilm)
+	}
+
+	packaging := make([]domain.PackageType, len(layers))
+	for i, layer := range layers {
+		packaging[i] = domain.PackageType(layer)
+	}
+
+	return packaging
+}
@@ -1,10 +1,19 @@
 package handler
 
 import (
+	"errors"
 	"io"
 	"net/http"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
 )
 
+// ConfirmOrdersResponse holds the pickup codes of the accepted orders, they
+// are handed to the customers and cannot be read again.
+type ConfirmOrdersResponse struct {
+	PickupCodes []domain.IssuedPickupCode `json:"pickup_codes"`
+}
+
 func (h *OrderHandler) ConfirmOrders(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseMultipartForm(10 << 20) // 10 MB
 	if err != nil {
@@ -28,12 +37,18 @@ func (h *OrderHandler) ConfirmOrders(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.service.RetrieveOrdersFromFile(r.Context(), fileBytes)
+	codes, err := h.service.RetrieveOrdersFromFile(r.Context(), fileBytes)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, domain.ErrCostCurrencyRequired),
+			errors.Is(err, domain.ErrUnknownCurrency):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
 
 		return
 	}
 
-	h.writeOkResponseToHeader(w)
+	_ = h.writeResponseToHeader(ConfirmOrdersResponse{PickupCodes: codes}, w)
 }
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type DeadLettersListResponse struct {
+	DeadLetters []domain.DeadLetter `json:"dead_letters"`
+}
+
+type RequeueDeadLetterResponse struct {
+	TaskID int64 `json:"task_id"`
+}
+
+type PurgeDeadLettersResponse struct {
+	Purged int64 `json:"purged"`
+}
+
+func (h *OrderHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	filter, err := readDeadLetterFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	deadLetters, err := h.service.ListDeadLetters(r.Context(), filter)
+	if err != nil {
+		h.writeDeadLetterError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(DeadLettersListResponse{DeadLetters: deadLetters}, w)
+}
+
+func (h *OrderHandler) GetDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	deadLetter, err := h.service.GetDeadLetter(r.Context(), id)
+	if err != nil {
+		h.writeDeadLetterError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(deadLetter, w)
+}
+
+func (h *OrderHandler) RequeueDeadLetter(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	taskID, err := h.service.RequeueDeadLetter(r.Context(), id)
+	if err != nil {
+		h.writeDeadLetterError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(RequeueDeadLetterResponse{TaskID: taskID}, w)
+}
+
+// PurgeDeadLetters removes dead letters matching task_type and dead_before,
+// an RFC 3339 time. Without parameters every dead letter is removed.
+func (h *OrderHandler) PurgeDeadLetters(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter, err := readDeadLetterFilter(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	filter.DeadBefore, err = optionalTimeParam(query, "dead_before")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	purged, err := h.service.PurgeDeadLetters(r.Context(), filter)
+	if err != nil {
+		h.writeDeadLetterError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(PurgeDeadLettersResponse{Purged: purged}, w)
+}
+
+func readDeadLetterFilter(query url.Values) (domain.DeadLetterFilter, error) {
+	var filter domain.DeadLetterFilter
+	if raw := query.Get("task_type"); raw != "" {
+		taskType, err := domain.ParseTaskType(raw)
+		if err != nil {
+			return domain.DeadLetterFilter{}, err
+		}
+		filter.TaskType = &taskType
+	}
+
+	return filter, nil
+}
+
+func (h *OrderHandler) writeDeadLetterError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrDeadLetterNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type ShipmentsListResponse struct {
+	Shipments []domain.InboundShipment `json:"shipments"`
+}
+
+type ScanShipmentOrderRequest struct {
+	OrderID int64 `json:"order_id"`
+}
+
+// RegisterShipment takes the expected manifest as a multipart upload: the
+// "file" field in the orders file format, "pickup_point_id" and "reference".
+func (h *OrderHandler) RegisterShipment(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB
+		http.Error(w, "Error parsing form data", http.StatusBadRequest)
+
+		return
+	}
+	pickupPointID, err := strconv.ParseInt(r.FormValue("pickup_point_id"), 10, 64)
+	if err != nil || pickupPointID <= 0 {
+		http.Error(w, "pickup_point_id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "File not found in form data", http.StatusBadRequest)
+
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read file", http.StatusInternalServerError)
+
+		return
+	}
+
+	shipment, err := h.service.RegisterShipmentFromFile(r.Context(), pickupPointID, r.FormValue("reference"), fileBytes)
+	if err != nil {
+		h.writeShipmentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(shipment, w)
+}
+
+func (h *OrderHandler) ListShipments(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var filter domain.ShipmentFilter
+	if raw := query.Get("pickup_point_id"); raw != "" {
+		pickupPointID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || pickupPointID <= 0 {
+			http.Error(w, "pickup_point_id is not valid", http.StatusBadRequest)
+
+			return
+		}
+		filter.PickupPointID = &pickupPointID
+	}
+	if raw := query.Get("status"); raw != "" {
+		status, err := domain.ParseShipmentStatus(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		filter.Status = &status
+	}
+
+	shipments, err := h.service.ListShipments(r.Context(), filter)
+	if err != nil {
+		h.writeShipmentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(ShipmentsListResponse{Shipments: shipments}, w)
+}
+
+func (h *OrderHandler) GetShipment(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	shipment, err := h.service.GetShipment(r.Context(), id)
+	if err != nil {
+		h.writeShipmentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(shipment, w)
+}
+
+func (h *OrderHandler) ScanShipmentOrder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var sr ScanShipmentOrderRequest
+	if err := json.Unmarshal(body, &sr); err != nil || sr.OrderID <= 0 {
+		http.Error(w, "order_id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	shipment, err := h.service.ScanShipmentOrder(r.Context(), id, sr.OrderID)
+	if err != nil {
+		h.writeShipmentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(shipment, w)
+}
+
+func (h *OrderHandler) CloseShipment(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	report, err := h.service.CloseShipment(r.Context(), id)
+	if err != nil {
+		h.writeShipmentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(report, w)
+}
+
+func (h *OrderHandler) GetShipmentReport(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	report, err := h.service.GetShipmentReport(r.Context(), id)
+	if err != nil {
+		h.writeShipmentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(report, w)
+}
+
+func (h *OrderHandler) writeShipmentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrShipmentFieldsAreIncorrect),
+		errors.Is(err, domain.ErrShipmentIsEmpty),
+		errors.Is(err, domain.ErrShipmentTooLarge),
+		errors.Is(err, domain.ErrShipmentHasDuplicates),
+		errors.Is(err, domain.ErrUnknownCurrency),
+		errors.Is(err, domain.ErrCostCurrencyRequired):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrShipmentNotFound),
+		errors.Is(err, domain.ErrShipmentReportNotFound),
+		errors.Is(err, domain.ErrPickupPointNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrShipmentIsClosed),
+		errors.Is(err, domain.ErrNoFreeStorageCell):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,218 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type IncidentsListResponse struct {
+	Incidents []domain.Incident `json:"incidents"`
+}
+
+type OpenIncidentRequest struct {
+	OrderID     int64               `json:"order_id"`
+	Type        domain.IncidentType `json:"type"`
+	Description string              `json:"description"`
+	Operator    string              `json:"operator"`
+}
+
+type UpdateIncidentRequest struct {
+	Type        *domain.IncidentType `json:"type"`
+	Description *string              `json:"description"`
+}
+
+type CloseIncidentRequest struct {
+	Outcome    domain.IncidentOutcome `json:"outcome"`
+	Resolution string                 `json:"resolution"`
+	Operator   string                 `json:"operator"`
+}
+
+func (h *OrderHandler) OpenIncident(w http.ResponseWriter, r *http.Request) {
+	var ir OpenIncidentRequest
+	if !h.readIncidentRequest(w, r, &ir) {
+		return
+	}
+
+	incident, err := h.service.OpenIncident(r.Context(), ir.OrderID, ir.Type, ir.Description, ir.Operator)
+	if err != nil {
+		h.writeIncidentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(incident, w)
+}
+
+func (h *OrderHandler) GetIncident(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	incident, err := h.service.GetIncident(r.Context(), id)
+	if err != nil {
+		h.writeIncidentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(incident, w)
+}
+
+func (h *OrderHandler) ListIncidents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var filter domain.IncidentFilter
+	if raw := query.Get("order_id"); raw != "" {
+		orderID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || orderID <= 0 {
+			http.Error(w, "order_id is not valid", http.StatusBadRequest)
+
+			return
+		}
+		filter.OrderID = &orderID
+	}
+	if raw := query.Get("type"); raw != "" {
+		incidentType, err := domain.ParseIncidentType(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		filter.Type = &incidentType
+	}
+	if raw := query.Get("status"); raw != "" {
+		status, err := domain.ParseIncidentStatus(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		filter.Status = &status
+	}
+
+	incidents, err := h.service.ListIncidents(r.Context(), filter)
+	if err != nil {
+		h.writeIncidentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(IncidentsListResponse{Incidents: incidents}, w)
+}
+
+func (h *OrderHandler) UpdateIncident(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	var ur UpdateIncidentRequest
+	if !h.readIncidentRequest(w, r, &ur) {
+		return
+	}
+
+	incident, err := h.service.UpdateIncident(r.Context(), id, domain.IncidentUpdate{
+		Type:        ur.Type,
+		Description: ur.Description,
+	})
+	if err != nil {
+		h.writeIncidentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(incident, w)
+}
+
+func (h *OrderHandler) ResolveIncident(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	var cr CloseIncidentRequest
+	if !h.readIncidentRequest(w, r, &cr) {
+		return
+	}
+
+	incident, err := h.service.ResolveIncident(r.Context(), id, cr.Outcome, cr.Resolution, cr.Operator)
+	if err != nil {
+		h.writeIncidentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(incident, w)
+}
+
+// CancelIncident withdraws the incident, the body carries the operator and
+// an optional resolution, the outcome is ignored.
+func (h *OrderHandler) CancelIncident(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	var cr CloseIncidentRequest
+	if !h.readIncidentRequest(w, r, &cr) {
+		return
+	}
+
+	incident, err := h.service.CancelIncident(r.Context(), id, cr.Resolution, cr.Operator)
+	if err != nil {
+		h.writeIncidentError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(incident, w)
+}
+
+func (h *OrderHandler) readIncidentRequest(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return false
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return false
+	}
+
+	return true
+}
+
+func (h *OrderHandler) writeIncidentError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrIncidentFieldsAreIncorrect),
+		errors.Is(err, domain.ErrUnknownIncidentType),
+		errors.Is(err, domain.ErrUnknownIncidentOutcome):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrIncidentNotFound),
+		errors.Is(err, domain.ErrOrderNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrOrderIsBlocked),
+		errors.Is(err, domain.ErrIncidentIsClosed),
+		errors.Is(err, domain.ErrOrderIsInReturnManifest),
+		errors.Is(err, domain.ErrTransitionNotAllowed):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type OrderTransitionResponse struct {
+	Action string `json:"action"`
+	From   string `json:"from_status"`
+	To     string `json:"to_status"`
+}
+
+type OrderTransitionsResponse struct {
+	Transitions []OrderTransitionResponse `json:"transitions"`
+}
+
+func (h *OrderHandler) ListOrderTransitions(config config.Config, w http.ResponseWriter, r *http.Request) {
+	orderIDInt, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid order ID format", http.StatusBadRequest)
+
+		return
+	}
+
+	var userIDInt int64
+	if userID := r.URL.Query().Get("user_id"); userID != "" {
+		userIDInt, err = strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid user ID format", http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	transitions, err := h.service.ListAllowedTransitions(r.Context(), orderIDInt, userIDInt, config.OrderExpirationDays)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserIDRequired):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrOrderNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	response := OrderTransitionsResponse{Transitions: make([]OrderTransitionResponse, 0, len(transitions))}
+	for _, t := range transitions {
+		response.Transitions = append(response.Transitions, OrderTransitionResponse{
+			Action: string(t.Action),
+			From:   domain.GetStringFromStatus(t.From),
+			To:     domain.GetStringFromStatus(t.To),
+		})
+	}
+
+	_ = h.writeResponseToHeader(response, w)
+}
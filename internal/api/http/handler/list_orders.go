@@ -6,6 +6,7 @@ import (
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
 	"log"
 	"net/http"
+	"strconv"
 )
 
 type OrdersListResponse struct {
@@ -25,6 +26,9 @@ func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 	lastID := vars["last_id"]
 	limit := query.Get("limit")
 	searchTerm := query.Get("search")
+	pickupPointID := query.Get("pickup_point_id")
+	refundReason := query.Get("refund_reason")
+	itemCondition := query.Get("item_condition")
 
 	if status != "" {
 		if domain.IsStatusValid(status) {
@@ -34,7 +38,13 @@ func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if userID != "" {
+	if itemCondition != "" && !domain.ItemCondition(itemCondition).IsValid() {
+		http.Error(w, domain.ErrUnknownItemCondition.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if userID != "" && pickupPointID == "" && refundReason == "" && itemCondition == "" {
 		err := h.listOrdersByUserID(w, r, userID, lastID, limit)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError) // (BadRequest or InternalError) -> TODO: process error in repo and switch case error.Is
@@ -48,6 +58,29 @@ func (h *OrderHandler) ListOrders(w http.ResponseWriter, r *http.Request) {
 		sf := service.SearchFilter{SearchTerm: &searchTerm}
 		searchFilter = &sf
 	}
+	if pickupPointID != "" {
+		pointID, err := strconv.ParseInt(pickupPointID, 10, 64)
+		if err != nil || pointID <= 0 {
+			http.Error(w, "pickup_point_id is not valid", http.StatusBadRequest)
+
+			return
+		}
+		if searchFilter == nil {
+			searchFilter = &service.SearchFilter{}
+		}
+		searchFilter.PickupPointID = &pointID
+	}
+	if refundReason != "" || itemCondition != "" {
+		if searchFilter == nil {
+			searchFilter = &service.SearchFilter{}
+		}
+		if refundReason != "" {
+			searchFilter.RefundReason = &refundReason
+		}
+		if itemCondition != "" {
+			searchFilter.ItemCondition = &itemCondition
+		}
+	}
 
 	lastIDInt, limitInt, err := h.getPaginationVars(lastID, limit)
 	if err != nil {
@@ -37,61 +37,284 @@ func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
 }
 
 // AddOrder mocks base method.
-func (m *MockOrderService) AddOrder(ctx context.Context, orderDto service.OrderDto, packageType domain.PackageType, isAdditionalFilm bool) (domain.Order, error) {
+func (m *MockOrderService) AddOrder(ctx context.Context, orderDto service.OrderDto, packaging []domain.PackageType) (domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrder", ctx, orderDto, packageType, isAdditionalFilm)
+	ret := m.ctrl.Call(m, "AddOrder", ctx, orderDto, packaging)
 	ret0, _ := ret[0].(domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddOrder indicates an expected call of AddOrder.
-func (mr *MockOrderServiceMockRecorder) AddOrder(ctx, orderDto, packageType, isAdditionalFilm interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) AddOrder(ctx, orderDto, packaging interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockOrderService)(nil).AddOrder), ctx, orderDto, packageType, isAdditionalFilm)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockOrderService)(nil).AddOrder), ctx, orderDto, packaging)
+}
+
+// AddStocktakingScans mocks base method.
+func (m *MockOrderService) AddStocktakingScans(ctx context.Context, sessionID int64, orderIDs []int64) (domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStocktakingScans", ctx, sessionID, orderIDs)
+	ret0, _ := ret[0].(domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddStocktakingScans indicates an expected call of AddStocktakingScans.
+func (mr *MockOrderServiceMockRecorder) AddStocktakingScans(ctx, sessionID, orderIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStocktakingScans", reflect.TypeOf((*MockOrderService)(nil).AddStocktakingScans), ctx, sessionID, orderIDs)
+}
+
+// CancelIncident mocks base method.
+func (m *MockOrderService) CancelIncident(ctx context.Context, id int64, resolution, operator string) (domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelIncident", ctx, id, resolution, operator)
+	ret0, _ := ret[0].(domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelIncident indicates an expected call of CancelIncident.
+func (mr *MockOrderServiceMockRecorder) CancelIncident(ctx, id, resolution, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelIncident", reflect.TypeOf((*MockOrderService)(nil).CancelIncident), ctx, id, resolution, operator)
+}
+
+// CloseReturnManifest mocks base method.
+func (m *MockOrderService) CloseReturnManifest(ctx context.Context, id int64) (domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseReturnManifest", ctx, id)
+	ret0, _ := ret[0].(domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseReturnManifest indicates an expected call of CloseReturnManifest.
+func (mr *MockOrderServiceMockRecorder) CloseReturnManifest(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseReturnManifest", reflect.TypeOf((*MockOrderService)(nil).CloseReturnManifest), ctx, id)
+}
+
+// CloseShipment mocks base method.
+func (m *MockOrderService) CloseShipment(ctx context.Context, id int64) (domain.ShipmentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShipment", ctx, id)
+	ret0, _ := ret[0].(domain.ShipmentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShipment indicates an expected call of CloseShipment.
+func (mr *MockOrderServiceMockRecorder) CloseShipment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShipment", reflect.TypeOf((*MockOrderService)(nil).CloseShipment), ctx, id)
 }
 
 // CompleteOrder mocks base method.
-func (m *MockOrderService) CompleteOrder(ctx context.Context, orderId, userId int64) error {
+func (m *MockOrderService) CompleteOrder(ctx context.Context, orderID, userID int64, pickupCode string, storageFeePaid bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompleteOrder", ctx, orderId, userId)
+	ret := m.ctrl.Call(m, "CompleteOrder", ctx, orderID, userID, pickupCode, storageFeePaid)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CompleteOrder indicates an expected call of CompleteOrder.
-func (mr *MockOrderServiceMockRecorder) CompleteOrder(ctx, orderId, userId interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) CompleteOrder(ctx, orderID, userID, pickupCode, storageFeePaid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOrder", reflect.TypeOf((*MockOrderService)(nil).CompleteOrder), ctx, orderID, userID, pickupCode, storageFeePaid)
+}
+
+// CompleteOrders mocks base method.
+func (m *MockOrderService) CompleteOrders(ctx context.Context, userID int64, items []domain.PickupItem, mode domain.BatchMode) (domain.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOrders", ctx, userID, items, mode)
+	ret0, _ := ret[0].(domain.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteOrders indicates an expected call of CompleteOrders.
+func (mr *MockOrderServiceMockRecorder) CompleteOrders(ctx, userID, items, mode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOrder", reflect.TypeOf((*MockOrderService)(nil).CompleteOrder), ctx, orderId, userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOrders", reflect.TypeOf((*MockOrderService)(nil).CompleteOrders), ctx, userID, items, mode)
+}
+
+// CreatePickupPoint mocks base method.
+func (m *MockOrderService) CreatePickupPoint(ctx context.Context, point domain.PickupPoint) (domain.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePickupPoint", ctx, point)
+	ret0, _ := ret[0].(domain.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePickupPoint indicates an expected call of CreatePickupPoint.
+func (mr *MockOrderServiceMockRecorder) CreatePickupPoint(ctx, point interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePickupPoint", reflect.TypeOf((*MockOrderService)(nil).CreatePickupPoint), ctx, point)
+}
+
+// CreateReturnManifest mocks base method.
+func (m *MockOrderService) CreateReturnManifest(ctx context.Context, pickupPointID int64, courierName string) (domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReturnManifest", ctx, pickupPointID, courierName)
+	ret0, _ := ret[0].(domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReturnManifest indicates an expected call of CreateReturnManifest.
+func (mr *MockOrderServiceMockRecorder) CreateReturnManifest(ctx, pickupPointID, courierName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReturnManifest", reflect.TypeOf((*MockOrderService)(nil).CreateReturnManifest), ctx, pickupPointID, courierName)
+}
+
+// CreateStorageCell mocks base method.
+func (m *MockOrderService) CreateStorageCell(ctx context.Context, cell domain.StorageCell) (domain.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageCell", ctx, cell)
+	ret0, _ := ret[0].(domain.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageCell indicates an expected call of CreateStorageCell.
+func (mr *MockOrderServiceMockRecorder) CreateStorageCell(ctx, cell interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageCell", reflect.TypeOf((*MockOrderService)(nil).CreateStorageCell), ctx, cell)
+}
+
+// DeletePickupPoint mocks base method.
+func (m *MockOrderService) DeletePickupPoint(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupPoint", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupPoint indicates an expected call of DeletePickupPoint.
+func (mr *MockOrderServiceMockRecorder) DeletePickupPoint(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupPoint", reflect.TypeOf((*MockOrderService)(nil).DeletePickupPoint), ctx, id)
+}
+
+// DeleteStorageTariff mocks base method.
+func (m *MockOrderService) DeleteStorageTariff(ctx context.Context, pickupPointID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageTariff", ctx, pickupPointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStorageTariff indicates an expected call of DeleteStorageTariff.
+func (mr *MockOrderServiceMockRecorder) DeleteStorageTariff(ctx, pickupPointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageTariff", reflect.TypeOf((*MockOrderService)(nil).DeleteStorageTariff), ctx, pickupPointID)
+}
+
+// ExportReturnManifest mocks base method.
+func (m *MockOrderService) ExportReturnManifest(ctx context.Context, id int64, format domain.ExportFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportReturnManifest", ctx, id, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportReturnManifest indicates an expected call of ExportReturnManifest.
+func (mr *MockOrderServiceMockRecorder) ExportReturnManifest(ctx, id, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportReturnManifest", reflect.TypeOf((*MockOrderService)(nil).ExportReturnManifest), ctx, id, format)
+}
+
+// FinishStocktaking mocks base method.
+func (m *MockOrderService) FinishStocktaking(ctx context.Context, id int64) (domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishStocktaking", ctx, id)
+	ret0, _ := ret[0].(domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishStocktaking indicates an expected call of FinishStocktaking.
+func (mr *MockOrderServiceMockRecorder) FinishStocktaking(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishStocktaking", reflect.TypeOf((*MockOrderService)(nil).FinishStocktaking), ctx, id)
+}
+
+// GetDailyRevenue mocks base method.
+func (m *MockOrderService) GetDailyRevenue(ctx context.Context, filter domain.RevenueFilter) ([]domain.DailyRevenue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyRevenue", ctx, filter)
+	ret0, _ := ret[0].([]domain.DailyRevenue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyRevenue indicates an expected call of GetDailyRevenue.
+func (mr *MockOrderServiceMockRecorder) GetDailyRevenue(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyRevenue", reflect.TypeOf((*MockOrderService)(nil).GetDailyRevenue), ctx, filter)
+}
+
+// GetDeadLetter mocks base method.
+func (m *MockOrderService) GetDeadLetter(ctx context.Context, id int64) (domain.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", ctx, id)
+	ret0, _ := ret[0].(domain.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockOrderServiceMockRecorder) GetDeadLetter(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockOrderService)(nil).GetDeadLetter), ctx, id)
+}
+
+// GetIncident mocks base method.
+func (m *MockOrderService) GetIncident(ctx context.Context, id int64) (domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncident", ctx, id)
+	ret0, _ := ret[0].(domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncident indicates an expected call of GetIncident.
+func (mr *MockOrderServiceMockRecorder) GetIncident(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncident", reflect.TypeOf((*MockOrderService)(nil).GetIncident), ctx, id)
 }
 
 // GetOrderByID mocks base method.
-func (m *MockOrderService) GetOrderByID(ctx context.Context, orderId int64) (domain.Order, error) {
+func (m *MockOrderService) GetOrderByID(ctx context.Context, orderID int64) (domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderByID", ctx, orderId)
+	ret := m.ctrl.Call(m, "GetOrderByID", ctx, orderID)
 	ret0, _ := ret[0].(domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrderById indicates an expected call of GetOrderById.
-func (mr *MockOrderServiceMockRecorder) GetOrderById(ctx, orderId interface{}) *gomock.Call {
+// GetOrderByID indicates an expected call of GetOrderByID.
+func (mr *MockOrderServiceMockRecorder) GetOrderByID(ctx, orderID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockOrderService)(nil).GetOrderByID), ctx, orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockOrderService)(nil).GetOrderByID), ctx, orderID)
 }
 
 // GetOrders mocks base method.
-func (m *MockOrderService) GetOrders(ctx context.Context, lastId *int64, limit *int, searchFilter *service.SearchFilter) []domain.Order {
+func (m *MockOrderService) GetOrders(ctx context.Context, lastID *int64, limit *int, searchFilter *service.SearchFilter) []domain.Order {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrders", ctx, lastId, limit, searchFilter)
+	ret := m.ctrl.Call(m, "GetOrders", ctx, lastID, limit, searchFilter)
 	ret0, _ := ret[0].([]domain.Order)
 	return ret0
 }
 
 // GetOrders indicates an expected call of GetOrders.
-func (mr *MockOrderServiceMockRecorder) GetOrders(ctx, lastId, limit, searchFilter interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) GetOrders(ctx, lastID, limit, searchFilter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderService)(nil).GetOrders), ctx, lastId, limit, searchFilter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderService)(nil).GetOrders), ctx, lastID, limit, searchFilter)
 }
 
 // GetOrdersBySpecificStatus mocks base method.
@@ -108,56 +331,489 @@ func (mr *MockOrderServiceMockRecorder) GetOrdersBySpecificStatus(ctx, status in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersBySpecificStatus", reflect.TypeOf((*MockOrderService)(nil).GetOrdersBySpecificStatus), ctx, status)
 }
 
-// GetOrdersByUserId mocks base method.
-func (m *MockOrderService) GetOrdersByUserID(ctx context.Context, userID int64, limit *int, lastId *int64) ([]domain.Order, error) {
+// GetOrdersByUserID mocks base method.
+func (m *MockOrderService) GetOrdersByUserID(ctx context.Context, userID int64, limit *int, lastID *int64) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrdersByUserID", ctx, userID, limit, lastId)
+	ret := m.ctrl.Call(m, "GetOrdersByUserID", ctx, userID, limit, lastID)
 	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrdersByUserId indicates an expected call of GetOrdersByUserId.
-func (mr *MockOrderServiceMockRecorder) GetOrdersByUserId(ctx, userID, limit, lastId interface{}) *gomock.Call {
+// GetOrdersByUserID indicates an expected call of GetOrdersByUserID.
+func (mr *MockOrderServiceMockRecorder) GetOrdersByUserID(ctx, userID, limit, lastID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersByUserID", reflect.TypeOf((*MockOrderService)(nil).GetOrdersByUserID), ctx, userID, limit, lastId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersByUserID", reflect.TypeOf((*MockOrderService)(nil).GetOrdersByUserID), ctx, userID, limit, lastID)
+}
+
+// GetPickupPoint mocks base method.
+func (m *MockOrderService) GetPickupPoint(ctx context.Context, id int64) (domain.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoint", ctx, id)
+	ret0, _ := ret[0].(domain.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoint indicates an expected call of GetPickupPoint.
+func (mr *MockOrderServiceMockRecorder) GetPickupPoint(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoint", reflect.TypeOf((*MockOrderService)(nil).GetPickupPoint), ctx, id)
 }
 
 // GetRefundedOrders mocks base method.
-func (m *MockOrderService) GetRefundedOrders(ctx context.Context, lastId *int64, limit *int) ([]domain.Order, error) {
+func (m *MockOrderService) GetRefundedOrders(ctx context.Context, lastID *int64, limit *int) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRefundedOrders", ctx, lastId, limit)
+	ret := m.ctrl.Call(m, "GetRefundedOrders", ctx, lastID, limit)
 	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRefundedOrders indicates an expected call of GetRefundedOrders.
-func (mr *MockOrderServiceMockRecorder) GetRefundedOrders(ctx, lastId, limit interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) GetRefundedOrders(ctx, lastID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundedOrders", reflect.TypeOf((*MockOrderService)(nil).GetRefundedOrders), ctx, lastID, limit)
+}
+
+// GetReturnManifest mocks base method.
+func (m *MockOrderService) GetReturnManifest(ctx context.Context, id int64) (domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnManifest", ctx, id)
+	ret0, _ := ret[0].(domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnManifest indicates an expected call of GetReturnManifest.
+func (mr *MockOrderServiceMockRecorder) GetReturnManifest(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnManifest", reflect.TypeOf((*MockOrderService)(nil).GetReturnManifest), ctx, id)
+}
+
+// GetShipment mocks base method.
+func (m *MockOrderService) GetShipment(ctx context.Context, id int64) (domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipment", ctx, id)
+	ret0, _ := ret[0].(domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipment indicates an expected call of GetShipment.
+func (mr *MockOrderServiceMockRecorder) GetShipment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipment", reflect.TypeOf((*MockOrderService)(nil).GetShipment), ctx, id)
+}
+
+// GetShipmentReport mocks base method.
+func (m *MockOrderService) GetShipmentReport(ctx context.Context, id int64) (domain.ShipmentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipmentReport", ctx, id)
+	ret0, _ := ret[0].(domain.ShipmentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipmentReport indicates an expected call of GetShipmentReport.
+func (mr *MockOrderServiceMockRecorder) GetShipmentReport(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipmentReport", reflect.TypeOf((*MockOrderService)(nil).GetShipmentReport), ctx, id)
+}
+
+// GetStocktaking mocks base method.
+func (m *MockOrderService) GetStocktaking(ctx context.Context, id int64) (domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStocktaking", ctx, id)
+	ret0, _ := ret[0].(domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStocktaking indicates an expected call of GetStocktaking.
+func (mr *MockOrderServiceMockRecorder) GetStocktaking(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStocktaking", reflect.TypeOf((*MockOrderService)(nil).GetStocktaking), ctx, id)
+}
+
+// GetStorageOccupancy mocks base method.
+func (m *MockOrderService) GetStorageOccupancy(ctx context.Context) ([]domain.StorageOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageOccupancy", ctx)
+	ret0, _ := ret[0].([]domain.StorageOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageOccupancy indicates an expected call of GetStorageOccupancy.
+func (mr *MockOrderServiceMockRecorder) GetStorageOccupancy(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageOccupancy", reflect.TypeOf((*MockOrderService)(nil).GetStorageOccupancy), ctx)
+}
+
+// GetStorageTariff mocks base method.
+func (m *MockOrderService) GetStorageTariff(ctx context.Context, pickupPointID int64) (domain.StorageTariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageTariff", ctx, pickupPointID)
+	ret0, _ := ret[0].(domain.StorageTariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageTariff indicates an expected call of GetStorageTariff.
+func (mr *MockOrderServiceMockRecorder) GetStorageTariff(ctx, pickupPointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageTariff", reflect.TypeOf((*MockOrderService)(nil).GetStorageTariff), ctx, pickupPointID)
+}
+
+// IssuePickupCode mocks base method.
+func (m *MockOrderService) IssuePickupCode(ctx context.Context, orderID, userID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssuePickupCode", ctx, orderID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssuePickupCode indicates an expected call of IssuePickupCode.
+func (mr *MockOrderServiceMockRecorder) IssuePickupCode(ctx, orderID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssuePickupCode", reflect.TypeOf((*MockOrderService)(nil).IssuePickupCode), ctx, orderID, userID)
+}
+
+// ListAllowedTransitions mocks base method.
+func (m *MockOrderService) ListAllowedTransitions(ctx context.Context, orderID, userID int64, expirationDays int) ([]domain.Transition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllowedTransitions", ctx, orderID, userID, expirationDays)
+	ret0, _ := ret[0].([]domain.Transition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllowedTransitions indicates an expected call of ListAllowedTransitions.
+func (mr *MockOrderServiceMockRecorder) ListAllowedTransitions(ctx, orderID, userID, expirationDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllowedTransitions", reflect.TypeOf((*MockOrderService)(nil).ListAllowedTransitions), ctx, orderID, userID, expirationDays)
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockOrderService) ListDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx, filter)
+	ret0, _ := ret[0].([]domain.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockOrderServiceMockRecorder) ListDeadLetters(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockOrderService)(nil).ListDeadLetters), ctx, filter)
+}
+
+// ListIncidents mocks base method.
+func (m *MockOrderService) ListIncidents(ctx context.Context, filter domain.IncidentFilter) ([]domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIncidents", ctx, filter)
+	ret0, _ := ret[0].([]domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIncidents indicates an expected call of ListIncidents.
+func (mr *MockOrderServiceMockRecorder) ListIncidents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIncidents", reflect.TypeOf((*MockOrderService)(nil).ListIncidents), ctx, filter)
+}
+
+// ListPackages mocks base method.
+func (m *MockOrderService) ListPackages(ctx context.Context) []domain.PackageSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackages", ctx)
+	ret0, _ := ret[0].([]domain.PackageSpec)
+	return ret0
+}
+
+// ListPackages indicates an expected call of ListPackages.
+func (mr *MockOrderServiceMockRecorder) ListPackages(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackages", reflect.TypeOf((*MockOrderService)(nil).ListPackages), ctx)
+}
+
+// ListPickupPoints mocks base method.
+func (m *MockOrderService) ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPickupPoints", ctx)
+	ret0, _ := ret[0].([]domain.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPickupPoints indicates an expected call of ListPickupPoints.
+func (mr *MockOrderServiceMockRecorder) ListPickupPoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPickupPoints", reflect.TypeOf((*MockOrderService)(nil).ListPickupPoints), ctx)
+}
+
+// ListRefundReasons mocks base method.
+func (m *MockOrderService) ListRefundReasons(ctx context.Context) []domain.RefundReason {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRefundReasons", ctx)
+	ret0, _ := ret[0].([]domain.RefundReason)
+	return ret0
+}
+
+// ListRefundReasons indicates an expected call of ListRefundReasons.
+func (mr *MockOrderServiceMockRecorder) ListRefundReasons(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRefundReasons", reflect.TypeOf((*MockOrderService)(nil).ListRefundReasons), ctx)
+}
+
+// ListReturnManifests mocks base method.
+func (m *MockOrderService) ListReturnManifests(ctx context.Context, filter domain.ManifestFilter) ([]domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReturnManifests", ctx, filter)
+	ret0, _ := ret[0].([]domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReturnManifests indicates an expected call of ListReturnManifests.
+func (mr *MockOrderServiceMockRecorder) ListReturnManifests(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnManifests", reflect.TypeOf((*MockOrderService)(nil).ListReturnManifests), ctx, filter)
+}
+
+// ListShipments mocks base method.
+func (m *MockOrderService) ListShipments(ctx context.Context, filter domain.ShipmentFilter) ([]domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShipments", ctx, filter)
+	ret0, _ := ret[0].([]domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShipments indicates an expected call of ListShipments.
+func (mr *MockOrderServiceMockRecorder) ListShipments(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShipments", reflect.TypeOf((*MockOrderService)(nil).ListShipments), ctx, filter)
+}
+
+// ListStocktakings mocks base method.
+func (m *MockOrderService) ListStocktakings(ctx context.Context, filter domain.StocktakingFilter) ([]domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStocktakings", ctx, filter)
+	ret0, _ := ret[0].([]domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStocktakings indicates an expected call of ListStocktakings.
+func (mr *MockOrderServiceMockRecorder) ListStocktakings(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStocktakings", reflect.TypeOf((*MockOrderService)(nil).ListStocktakings), ctx, filter)
+}
+
+// ListStorageCells mocks base method.
+func (m *MockOrderService) ListStorageCells(ctx context.Context, pickupPointID int64) ([]domain.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageCells", ctx, pickupPointID)
+	ret0, _ := ret[0].([]domain.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageCells indicates an expected call of ListStorageCells.
+func (mr *MockOrderServiceMockRecorder) ListStorageCells(ctx, pickupPointID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundedOrders", reflect.TypeOf((*MockOrderService)(nil).GetRefundedOrders), ctx, lastId, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageCells", reflect.TypeOf((*MockOrderService)(nil).ListStorageCells), ctx, pickupPointID)
+}
+
+// OpenIncident mocks base method.
+func (m *MockOrderService) OpenIncident(ctx context.Context, orderID int64, incidentType domain.IncidentType, description, operator string) (domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenIncident", ctx, orderID, incidentType, description, operator)
+	ret0, _ := ret[0].(domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenIncident indicates an expected call of OpenIncident.
+func (mr *MockOrderServiceMockRecorder) OpenIncident(ctx, orderID, incidentType, description, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenIncident", reflect.TypeOf((*MockOrderService)(nil).OpenIncident), ctx, orderID, incidentType, description, operator)
+}
+
+// OverrideCompleteOrder mocks base method.
+func (m *MockOrderService) OverrideCompleteOrder(ctx context.Context, orderID, userID int64, reason string, storageFeePaid bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverrideCompleteOrder", ctx, orderID, userID, reason, storageFeePaid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OverrideCompleteOrder indicates an expected call of OverrideCompleteOrder.
+func (mr *MockOrderServiceMockRecorder) OverrideCompleteOrder(ctx, orderID, userID, reason, storageFeePaid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverrideCompleteOrder", reflect.TypeOf((*MockOrderService)(nil).OverrideCompleteOrder), ctx, orderID, userID, reason, storageFeePaid)
+}
+
+// PurgeDeadLetters mocks base method.
+func (m *MockOrderService) PurgeDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeadLetters", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeadLetters indicates an expected call of PurgeDeadLetters.
+func (mr *MockOrderServiceMockRecorder) PurgeDeadLetters(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeadLetters", reflect.TypeOf((*MockOrderService)(nil).PurgeDeadLetters), ctx, filter)
+}
+
+// QuoteOrder mocks base method.
+func (m *MockOrderService) QuoteOrder(weight int, cost domain.Money, dimensions domain.Dimensions, packaging []domain.PackageType) (domain.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteOrder", weight, cost, dimensions, packaging)
+	ret0, _ := ret[0].(domain.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteOrder indicates an expected call of QuoteOrder.
+func (mr *MockOrderServiceMockRecorder) QuoteOrder(weight, cost, dimensions, packaging interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteOrder", reflect.TypeOf((*MockOrderService)(nil).QuoteOrder), weight, cost, dimensions, packaging)
+}
+
+// RecommendPackaging mocks base method.
+func (m *MockOrderService) RecommendPackaging(weight int, cost domain.Money, dimensions domain.Dimensions) (domain.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecommendPackaging", weight, cost, dimensions)
+	ret0, _ := ret[0].(domain.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecommendPackaging indicates an expected call of RecommendPackaging.
+func (mr *MockOrderServiceMockRecorder) RecommendPackaging(weight, cost, dimensions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecommendPackaging", reflect.TypeOf((*MockOrderService)(nil).RecommendPackaging), weight, cost, dimensions)
 }
 
 // RefundOrder mocks base method.
-func (m *MockOrderService) RefundOrder(ctx context.Context, orderId int64, expirationDays int) error {
+func (m *MockOrderService) RefundOrder(ctx context.Context, orderID, userID int64, expirationDays int, details domain.RefundDetails) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefundOrder", ctx, orderId, expirationDays)
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, orderID, userID, expirationDays, details)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RefundOrder indicates an expected call of RefundOrder.
-func (mr *MockOrderServiceMockRecorder) RefundOrder(ctx, orderId, expirationDays interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) RefundOrder(ctx, orderID, userID, expirationDays, details interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderService)(nil).RefundOrder), ctx, orderId, expirationDays)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderService)(nil).RefundOrder), ctx, orderID, userID, expirationDays, details)
+}
+
+// RefundOrders mocks base method.
+func (m *MockOrderService) RefundOrders(ctx context.Context, userID int64, orderIDs []int64, expirationDays int, details domain.RefundDetails, mode domain.BatchMode) (domain.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrders", ctx, userID, orderIDs, expirationDays, details, mode)
+	ret0, _ := ret[0].(domain.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundOrders indicates an expected call of RefundOrders.
+func (mr *MockOrderServiceMockRecorder) RefundOrders(ctx, userID, orderIDs, expirationDays, details, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrders", reflect.TypeOf((*MockOrderService)(nil).RefundOrders), ctx, userID, orderIDs, expirationDays, details, mode)
+}
+
+// RegisterShipment mocks base method.
+func (m *MockOrderService) RegisterShipment(ctx context.Context, pickupPointID int64, reference string, items []domain.ShipmentItem) (domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterShipment", ctx, pickupPointID, reference, items)
+	ret0, _ := ret[0].(domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterShipment indicates an expected call of RegisterShipment.
+func (mr *MockOrderServiceMockRecorder) RegisterShipment(ctx, pickupPointID, reference, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterShipment", reflect.TypeOf((*MockOrderService)(nil).RegisterShipment), ctx, pickupPointID, reference, items)
+}
+
+// RegisterShipmentFromFile mocks base method.
+func (m *MockOrderService) RegisterShipmentFromFile(ctx context.Context, pickupPointID int64, reference string, data []byte) (domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterShipmentFromFile", ctx, pickupPointID, reference, data)
+	ret0, _ := ret[0].(domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterShipmentFromFile indicates an expected call of RegisterShipmentFromFile.
+func (mr *MockOrderServiceMockRecorder) RegisterShipmentFromFile(ctx, pickupPointID, reference, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterShipmentFromFile", reflect.TypeOf((*MockOrderService)(nil).RegisterShipmentFromFile), ctx, pickupPointID, reference, data)
+}
+
+// RequeueDeadLetter mocks base method.
+func (m *MockOrderService) RequeueDeadLetter(ctx context.Context, id int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLetter", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLetter indicates an expected call of RequeueDeadLetter.
+func (mr *MockOrderServiceMockRecorder) RequeueDeadLetter(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLetter", reflect.TypeOf((*MockOrderService)(nil).RequeueDeadLetter), ctx, id)
+}
+
+// ResolveIncident mocks base method.
+func (m *MockOrderService) ResolveIncident(ctx context.Context, id int64, outcome domain.IncidentOutcome, resolution, operator string) (domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveIncident", ctx, id, outcome, resolution, operator)
+	ret0, _ := ret[0].(domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveIncident indicates an expected call of ResolveIncident.
+func (mr *MockOrderServiceMockRecorder) ResolveIncident(ctx, id, outcome, resolution, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveIncident", reflect.TypeOf((*MockOrderService)(nil).ResolveIncident), ctx, id, outcome, resolution, operator)
+}
+
+// ResolvePackage mocks base method.
+func (m *MockOrderService) ResolvePackage(packageType domain.PackageType) (domain.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePackage", packageType)
+	ret0, _ := ret[0].(domain.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePackage indicates an expected call of ResolvePackage.
+func (mr *MockOrderServiceMockRecorder) ResolvePackage(packageType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePackage", reflect.TypeOf((*MockOrderService)(nil).ResolvePackage), packageType)
 }
 
 // RetrieveOrdersFromFile mocks base method.
-func (m *MockOrderService) RetrieveOrdersFromFile(ctx context.Context, data []byte) error {
+func (m *MockOrderService) RetrieveOrdersFromFile(ctx context.Context, data []byte) ([]domain.IssuedPickupCode, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RetrieveOrdersFromFile", ctx, data)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]domain.IssuedPickupCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RetrieveOrdersFromFile indicates an expected call of RetrieveOrdersFromFile.
@@ -167,15 +823,134 @@ func (mr *MockOrderServiceMockRecorder) RetrieveOrdersFromFile(ctx, data interfa
 }
 
 // ReturnOrder mocks base method.
-func (m *MockOrderService) ReturnOrder(ctx context.Context, orderId int64) error {
+func (m *MockOrderService) ReturnOrder(ctx context.Context, orderID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrder", ctx, orderId)
+	ret := m.ctrl.Call(m, "ReturnOrder", ctx, orderID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReturnOrder indicates an expected call of ReturnOrder.
-func (mr *MockOrderServiceMockRecorder) ReturnOrder(ctx, orderId interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) ReturnOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockOrderService)(nil).ReturnOrder), ctx, orderID)
+}
+
+// SavePackage mocks base method.
+func (m *MockOrderService) SavePackage(ctx context.Context, spec domain.PackageSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePackage", ctx, spec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePackage indicates an expected call of SavePackage.
+func (mr *MockOrderServiceMockRecorder) SavePackage(ctx, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePackage", reflect.TypeOf((*MockOrderService)(nil).SavePackage), ctx, spec)
+}
+
+// ScanReturnManifestOrder mocks base method.
+func (m *MockOrderService) ScanReturnManifestOrder(ctx context.Context, manifestID, orderID int64) (domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanReturnManifestOrder", ctx, manifestID, orderID)
+	ret0, _ := ret[0].(domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanReturnManifestOrder indicates an expected call of ScanReturnManifestOrder.
+func (mr *MockOrderServiceMockRecorder) ScanReturnManifestOrder(ctx, manifestID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanReturnManifestOrder", reflect.TypeOf((*MockOrderService)(nil).ScanReturnManifestOrder), ctx, manifestID, orderID)
+}
+
+// ScanShipmentOrder mocks base method.
+func (m *MockOrderService) ScanShipmentOrder(ctx context.Context, shipmentID, orderID int64) (domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanShipmentOrder", ctx, shipmentID, orderID)
+	ret0, _ := ret[0].(domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScanShipmentOrder indicates an expected call of ScanShipmentOrder.
+func (mr *MockOrderServiceMockRecorder) ScanShipmentOrder(ctx, shipmentID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanShipmentOrder", reflect.TypeOf((*MockOrderService)(nil).ScanShipmentOrder), ctx, shipmentID, orderID)
+}
+
+// SearchArchivedOrders mocks base method.
+func (m *MockOrderService) SearchArchivedOrders(ctx context.Context, filter domain.ArchiveFilter, lastID *int64, limit *int) ([]domain.ArchivedOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchArchivedOrders", ctx, filter, lastID, limit)
+	ret0, _ := ret[0].([]domain.ArchivedOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchArchivedOrders indicates an expected call of SearchArchivedOrders.
+func (mr *MockOrderServiceMockRecorder) SearchArchivedOrders(ctx, filter, lastID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArchivedOrders", reflect.TypeOf((*MockOrderService)(nil).SearchArchivedOrders), ctx, filter, lastID, limit)
+}
+
+// SetStorageTariff mocks base method.
+func (m *MockOrderService) SetStorageTariff(ctx context.Context, pickupPointID int64, freeDays int, dailyRate domain.Money) (domain.StorageTariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStorageTariff", ctx, pickupPointID, freeDays, dailyRate)
+	ret0, _ := ret[0].(domain.StorageTariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStorageTariff indicates an expected call of SetStorageTariff.
+func (mr *MockOrderServiceMockRecorder) SetStorageTariff(ctx, pickupPointID, freeDays, dailyRate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageTariff", reflect.TypeOf((*MockOrderService)(nil).SetStorageTariff), ctx, pickupPointID, freeDays, dailyRate)
+}
+
+// StartStocktaking mocks base method.
+func (m *MockOrderService) StartStocktaking(ctx context.Context, pickupPointID int64, operator string) (domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartStocktaking", ctx, pickupPointID, operator)
+	ret0, _ := ret[0].(domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartStocktaking indicates an expected call of StartStocktaking.
+func (mr *MockOrderServiceMockRecorder) StartStocktaking(ctx, pickupPointID, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartStocktaking", reflect.TypeOf((*MockOrderService)(nil).StartStocktaking), ctx, pickupPointID, operator)
+}
+
+// UpdateIncident mocks base method.
+func (m *MockOrderService) UpdateIncident(ctx context.Context, id int64, update domain.IncidentUpdate) (domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIncident", ctx, id, update)
+	ret0, _ := ret[0].(domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIncident indicates an expected call of UpdateIncident.
+func (mr *MockOrderServiceMockRecorder) UpdateIncident(ctx, id, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIncident", reflect.TypeOf((*MockOrderService)(nil).UpdateIncident), ctx, id, update)
+}
+
+// UpdatePickupPoint mocks base method.
+func (m *MockOrderService) UpdatePickupPoint(ctx context.Context, point domain.PickupPoint) (domain.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePickupPoint", ctx, point)
+	ret0, _ := ret[0].(domain.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePickupPoint indicates an expected call of UpdatePickupPoint.
+func (mr *MockOrderServiceMockRecorder) UpdatePickupPoint(ctx, point interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockOrderService)(nil).ReturnOrder), ctx, orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePickupPoint", reflect.TypeOf((*MockOrderService)(nil).UpdatePickupPoint), ctx, point)
 }
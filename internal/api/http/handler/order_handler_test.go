@@ -4,11 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/require"
+	mock_handler "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/http/handler/mocks"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
-	mock_repository "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service/mocks"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -19,48 +18,35 @@ func TestOrderHandler(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
-	mockRepo := mock_repository.NewMockOrderRepository(ctrl)
-	handler := NewOrderHandler(&service.OrderServiceImpl{repo: mockRepo})
+	mockService := mock_handler.NewMockOrderService(ctrl)
+	handler := &OrderHandler{service: mockService}
 
-	t.Run("validateRequestBody", func(t *testing.T) {
+	t.Run("getRequestBody", func(t *testing.T) {
 		t.Parallel()
-
 		t.Run("empty body", func(t *testing.T) {
 			req := httptest.NewRequest(http.MethodPost, "/", nil)
 			w := httptest.NewRecorder()
-
-			body, _, failed := handler.validateRequestBody(w, req, true)
-
-			require.True(t, failed)
-			require.Nil(t, body)
-			require.Equal(t, http.StatusBadRequest, w.Code)
-			require.Equal(t, "body is empty\n", w.Body.String())
+			body, err := handler.getRequestBody(w, req, true)
+			require.NoError(t, err)
+			require.Empty(t, body)
 		})
-
 		t.Run("valid body", func(t *testing.T) {
 			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer([]byte(`{"key":"value"}`)))
 			w := httptest.NewRecorder()
-
-			body, _, failed := handler.validateRequestBody(w, req, false)
-
-			require.False(t, failed)
+			body, err := handler.getRequestBody(w, req, false)
+			require.NoError(t, err)
 			require.Equal(t, `{"key":"value"}`, string(body))
 			require.Equal(t, http.StatusOK, w.Code)
 		})
-
 		t.Run("read error", func(t *testing.T) {
 			req := httptest.NewRequest(http.MethodPost, "/", &errorReader{})
 			w := httptest.NewRecorder()
-
-			body, _, failed := handler.validateRequestBody(w, req, false)
-
-			require.True(t, failed)
+			body, _ := handler.getRequestBody(w, req, false)
 			require.Nil(t, body)
 			require.Equal(t, http.StatusPreconditionRequired, w.Code)
 			require.Contains(t, w.Body.String(), "mock read error")
 		})
 	})
-
 	t.Run("isOrderValid", func(t *testing.T) {
 		t.Parallel()
 
@@ -69,12 +55,12 @@ func TestOrderHandler(t *testing.T) {
 			req  CreateOrderRequest
 			want bool
 		}{
-			{name: "valid", req: CreateOrderRequest{Status: "confirmed", UserID: 1, Cost: 100, Weight: 10}, want: true},
-			{name: "empty status", req: CreateOrderRequest{Status: "", UserID: 1, Cost: 100, Weight: 10}, want: false},
-			{name: "invalid userID", req: CreateOrderRequest{Status: "confirmed", UserID: 0, Cost: 100, Weight: 10}, want: false},
-			{name: "invalid userID", req: CreateOrderRequest{Status: "confirmed", UserID: -1, Cost: 100, Weight: 10}, want: false},
-			{name: "invalid cost", req: CreateOrderRequest{Status: "confirmed", UserID: 1, Cost: 0, Weight: 10}, want: false},
-			{name: "invalid weight", req: CreateOrderRequest{Status: "confirmed", UserID: 1, Cost: 100, Weight: 0}, want: false},
+			{name: "valid", req: CreateOrderRequest{Status: "confirmed", UserID: 1, Cost: domain.Money{Amount: 100, Currency: domain.RUB}, Weight: 10}, want: true},
+			{name: "empty status", req: CreateOrderRequest{Status: "", UserID: 1, Cost: domain.Money{Amount: 100, Currency: domain.RUB}, Weight: 10}, want: false},
+			{name: "invalid userID", req: CreateOrderRequest{Status: "confirmed", UserID: 0, Cost: domain.Money{Amount: 100, Currency: domain.RUB}, Weight: 10}, want: false},
+			{name: "invalid userID", req: CreateOrderRequest{Status: "confirmed", UserID: -1, Cost: domain.Money{Amount: 100, Currency: domain.RUB}, Weight: 10}, want: false},
+			{name: "invalid cost", req: CreateOrderRequest{Status: "confirmed", UserID: 1, Cost: domain.Money{Currency: domain.RUB}, Weight: 10}, want: false},
+			{name: "invalid weight", req: CreateOrderRequest{Status: "confirmed", UserID: 1, Cost: domain.Money{Amount: 100, Currency: domain.RUB}, Weight: 0}, want: false},
 		}
 
 		for _, tt := range tests {
@@ -142,8 +128,7 @@ func TestOrderHandler(t *testing.T) {
 			req := httptest.NewRequest(http.MethodGet, "/orders?userId=1", nil)
 			w := httptest.NewRecorder()
 
-			filter := repository.Filter{UserID: int64Ptr(1)}
-			mockRepo.EXPECT().FindAll(req.Context(), filter, nil, nil).
+			mockService.EXPECT().GetOrdersByUserID(req.Context(), int64(1), nil, nil).
 				Return(nil, domain.ErrOrderNotFound)
 			handler.listOrdersByUserID(w, req, "1", "", "")
 
@@ -151,6 +136,31 @@ func TestOrderHandler(t *testing.T) {
 			require.Contains(t, w.Body.String(), "order not found")
 		})
 	})
+	t.Run("AddStocktakingScans", func(t *testing.T) {
+		t.Parallel()
+		t.Run("reads ids separated by whitespace", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/admin/stocktaking/3/scans", bytes.NewBufferString("1\n2 3\n"))
+			req = mux.SetURLVars(req, map[string]string{"id": "3"})
+			w := httptest.NewRecorder()
+
+			mockService.EXPECT().AddStocktakingScans(req.Context(), int64(3), []int64{1, 2, 3}).
+				Return(domain.StocktakingSession{ID: 3, ScannedCount: 3}, nil)
+			handler.AddStocktakingScans(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Contains(t, w.Body.String(), `"scanned_count":3`)
+		})
+		t.Run("invalid id", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/admin/stocktaking/3/scans", bytes.NewBufferString("1 abc"))
+			req = mux.SetURLVars(req, map[string]string{"id": "3"})
+			w := httptest.NewRecorder()
+
+			handler.AddStocktakingScans(w, req)
+
+			require.Equal(t, http.StatusBadRequest, w.Code)
+			require.Contains(t, w.Body.String(), `order id "abc" is not valid`)
+		})
+	})
 }
 
 type errorReader struct{}
@@ -158,5 +168,3 @@ type errorReader struct{}
 func (e *errorReader) Read([]byte) (n int, err error) {
 	return 0, fmt.Errorf("mock read error")
 }
-
-func int64Ptr(i int64) *int64 { return &i }
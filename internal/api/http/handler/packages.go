@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type PackagesListResponse struct {
+	Packages []domain.PackageSpec `json:"packages"`
+}
+
+type UpsertPackageRequest struct {
+	MaxWeight int          `json:"max_weight"`
+	MaxVolume int          `json:"max_volume"`
+	MaxLength int          `json:"max_length"`
+	Price     domain.Money `json:"price"`
+	CanWrap   bool         `json:"can_wrap"`
+	IsActive  bool         `json:"is_active"`
+}
+
+func (h *OrderHandler) ListPackages(w http.ResponseWriter, r *http.Request) {
+	response := PackagesListResponse{
+		Packages: h.service.ListPackages(r.Context()),
+	}
+
+	_ = h.writeResponseToHeader(response, w)
+}
+
+func (h *OrderHandler) UpsertPackage(w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var up UpsertPackageRequest
+	if err := json.Unmarshal(body, &up); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	spec, err := domain.NewPackageSpec(
+		mux.Vars(r)["name"],
+		up.MaxWeight,
+		up.MaxVolume,
+		up.MaxLength,
+		up.Price,
+		up.CanWrap,
+		up.IsActive,
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := h.service.SavePackage(r.Context(), spec); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPackageFieldsAreIncorrect):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(spec, w)
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type PickupPointsListResponse struct {
+	PickupPoints []domain.PickupPoint `json:"pickup_points"`
+}
+
+type PickupPointRequest struct {
+	Name                string `json:"name"`
+	Address             string `json:"address"`
+	Timezone            string `json:"timezone"`
+	OpensAt             string `json:"opens_at"`
+	ClosesAt            string `json:"closes_at"`
+	OrderExpirationDays int    `json:"order_expiration_days"`
+}
+
+func (h *OrderHandler) ListPickupPoints(w http.ResponseWriter, r *http.Request) {
+	points, err := h.service.ListPickupPoints(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(PickupPointsListResponse{PickupPoints: points}, w)
+}
+
+func (h *OrderHandler) GetPickupPoint(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	point, err := h.service.GetPickupPoint(r.Context(), id)
+	if err != nil {
+		h.writePickupPointError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(point, w)
+}
+
+func (h *OrderHandler) CreatePickupPoint(w http.ResponseWriter, r *http.Request) {
+	point, ok := h.readPickupPoint(w, r)
+	if !ok {
+		return
+	}
+
+	point, err := h.service.CreatePickupPoint(r.Context(), point)
+	if err != nil {
+		h.writePickupPointError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(point, w)
+}
+
+func (h *OrderHandler) UpdatePickupPoint(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	point, ok := h.readPickupPoint(w, r)
+	if !ok {
+		return
+	}
+	point.ID = id
+
+	point, err = h.service.UpdatePickupPoint(r.Context(), point)
+	if err != nil {
+		h.writePickupPointError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(point, w)
+}
+
+func (h *OrderHandler) DeletePickupPoint(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := h.service.DeletePickupPoint(r.Context(), id); err != nil {
+		h.writePickupPointError(w, err)
+
+		return
+	}
+
+	h.writeOkResponseToHeader(w)
+}
+
+func (h *OrderHandler) readPickupPoint(w http.ResponseWriter, r *http.Request) (domain.PickupPoint, bool) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return domain.PickupPoint{}, false
+	}
+
+	var pr PickupPointRequest
+	if err := json.Unmarshal(body, &pr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return domain.PickupPoint{}, false
+	}
+
+	point, err := domain.NewPickupPoint(
+		pr.Name,
+		pr.Address,
+		pr.Timezone,
+		pr.OpensAt,
+		pr.ClosesAt,
+		pr.OrderExpirationDays,
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return domain.PickupPoint{}, false
+	}
+
+	return point, true
+}
+
+func (h *OrderHandler) writePickupPointError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrPickupPointNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrPickupPointHasOrders),
+		errors.Is(err, domain.ErrDefaultPickupPoint):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
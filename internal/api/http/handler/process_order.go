@@ -1,12 +1,33 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
 	"github.com/gorilla/mux"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
 	"net/http"
 	"strconv"
 )
 
+// ProcessOrderRequest is the optional body of ProcessOrder. Action complete
+// needs PickupCode, action override needs OverrideReason and a supervisor
+// authenticated with the X-Supervisor-Token header, action refund needs
+// RefundReason and ItemCondition. Complete and override need StorageFeePaid
+// when the order accrued a storage fee.
+type ProcessOrderRequest struct {
+	PickupCode     string `json:"pickup_code"`
+	OverrideReason string `json:"override_reason"`
+	RefundReason   string `json:"refund_reason"`
+	RefundComment  string `json:"refund_comment"`
+	ItemCondition  string `json:"item_condition"`
+	StorageFeePaid bool   `json:"storage_fee_paid"`
+}
+
+type IssuePickupCodeResponse struct {
+	PickupCode string `json:"pickup_code"`
+}
+
 func (h *OrderHandler) ProcessOrder(config config.Config, w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	orderID := vars["id"]
@@ -26,18 +47,45 @@ func (h *OrderHandler) ProcessOrder(config config.Config, w http.ResponseWriter,
 		return
 	}
 
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+	var pr ProcessOrderRequest
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &pr); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+	}
+
 	switch action {
 	case "complete":
-		err = h.service.CompleteOrder(r.Context(), orderIDInt, userIDInt)
+		err = h.service.CompleteOrder(r.Context(), orderIDInt, userIDInt, pr.PickupCode, pr.StorageFeePaid)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			h.writeCompleteOrderError(w, err)
+
+			return
+		}
+	case "override":
+		err := h.service.OverrideCompleteOrder(r.Context(), orderIDInt, userIDInt, pr.OverrideReason, pr.StorageFeePaid)
+		if err != nil {
+			h.writeCompleteOrderError(w, err)
 
 			return
 		}
 	case "refund":
-		err = h.service.RefundOrder(r.Context(), orderIDInt, config.OrderExpirationDays)
+		details, err := domain.NewRefundDetails(pr.RefundReason, pr.RefundComment, pr.ItemCondition)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		if err := h.service.RefundOrder(r.Context(), orderIDInt, userIDInt, config.OrderExpirationDays, details); err != nil {
+			h.writeRefundOrderError(w, err)
 
 			return
 		}
@@ -51,3 +99,59 @@ func (h *OrderHandler) ProcessOrder(config config.Config, w http.ResponseWriter,
 
 	h.writeOkResponseToHeader(w)
 }
+
+// IssuePickupCode lets a supervisor replace the pickup code of the owner of
+// the order, the user_id query parameter.
+func (h *OrderHandler) IssuePickupCode(w http.ResponseWriter, r *http.Request) {
+	orderID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid order ID format", http.StatusBadRequest)
+
+		return
+	}
+	userID, err := strconv.ParseInt(r.URL.Query().Get("user_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid user ID format", http.StatusBadRequest)
+
+		return
+	}
+
+	code, err := h.service.IssuePickupCode(r.Context(), orderID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOrderNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, domain.ErrOrderNotBelongToUser), errors.Is(err, domain.ErrSupervisorRequired):
+			http.Error(w, err.Error(), http.StatusForbidden)
+		case errors.Is(err, domain.ErrTransitionNotAllowed):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(IssuePickupCodeResponse{PickupCode: code}, w)
+}
+
+func (h *OrderHandler) writeCompleteOrderError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrPickupCodeRequired),
+		errors.Is(err, domain.ErrPickupOverrideFieldsAreIncorrect):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrSupervisorRequired),
+		errors.Is(err, domain.ErrPickupCodeInvalid),
+		errors.Is(err, domain.ErrPickupCodeUsed),
+		errors.Is(err, domain.ErrPickupCodeNotIssued):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrPickupCodeLocked):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	case errors.Is(err, domain.ErrOrderIsBlocked):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrStorageFeeNotPaid):
+		http.Error(w, err.Error(), http.StatusPaymentRequired)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
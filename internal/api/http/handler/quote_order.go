@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type QuoteOrderRequest struct {
+	Weight           int          `json:"weight"`
+	Cost             domain.Money `json:"cost"`
+	PackageType      string       `json:"package_type"`
+	IsAdditionalFilm bool         `json:"is_additional_film"`
+	PackagingLayers  []string     `json:"packaging_layers"`
+	Length           int          `json:"length"`
+	Width            int          `json:"width"`
+	Height           int          `json:"height"`
+}
+
+func (h *OrderHandler) QuoteOrder(w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var qr QuoteOrderRequest
+	if err := json.Unmarshal(body, &qr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	packaging := packagingFromRequest(qr.PackageType, qr.IsAdditionalFilm, qr.PackagingLayers)
+	dimensions := domain.Dimensions{Length: qr.Length, Width: qr.Width, Height: qr.Height}
+	quote, err := h.service.QuoteOrder(qr.Weight, qr.Cost, dimensions, packaging)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOrderFieldsAreIncorrect),
+			errors.Is(err, domain.ErrIncorrectWeightForApplyPackage),
+			errors.Is(err, domain.ErrIncorrectSizeForApplyPackage),
+			errors.Is(err, domain.ErrNoSuitablePackage),
+			errors.Is(err, domain.ErrCurrencyMismatch),
+			errors.Is(err, domain.ErrPackageNotExists),
+			errors.Is(err, domain.ErrPackageNotActive),
+			errors.Is(err, domain.ErrPackageCannotWrap),
+			errors.Is(err, domain.ErrPackagingCompositionNotAllowed):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(quote, w)
+}
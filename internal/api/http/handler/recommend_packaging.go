@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type RecommendPackagingRequest struct {
+	Weight int          `json:"weight"`
+	Cost   domain.Money `json:"cost"`
+	Length int          `json:"length"`
+	Width  int          `json:"width"`
+	Height int          `json:"height"`
+}
+
+func (h *OrderHandler) RecommendPackaging(w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var rr RecommendPackagingRequest
+	if err := json.Unmarshal(body, &rr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	dimensions := domain.Dimensions{Length: rr.Length, Width: rr.Width, Height: rr.Height}
+	quote, err := h.service.RecommendPackaging(rr.Weight, rr.Cost, dimensions)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOrderFieldsAreIncorrect):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrNoSuitablePackage):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(quote, w)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// RefundOrdersRequest refunds all orders for the same reason.
+type RefundOrdersRequest struct {
+	UserID        int64   `json:"user_id"`
+	Mode          string  `json:"mode"`
+	OrderIDs      []int64 `json:"order_ids"`
+	RefundReason  string  `json:"refund_reason"`
+	RefundComment string  `json:"refund_comment"`
+	ItemCondition string  `json:"item_condition"`
+}
+
+type RefundReasonsResponse struct {
+	Reasons []domain.RefundReason `json:"reasons"`
+}
+
+func (h *OrderHandler) RefundOrders(config config.Config, w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var rr RefundOrdersRequest
+	if err := json.Unmarshal(body, &rr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	mode, err := domain.ParseBatchMode(rr.Mode)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	details, err := domain.NewRefundDetails(rr.RefundReason, rr.RefundComment, rr.ItemCondition)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	result, err := h.service.RefundOrders(r.Context(), rr.UserID, rr.OrderIDs, config.OrderExpirationDays, details, mode)
+	if err != nil {
+		if errors.Is(err, domain.ErrUnknownRefundReason) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		h.writeBatchError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(newBatchOrdersResponse(result), w)
+}
+
+func (h *OrderHandler) ListRefundReasons(w http.ResponseWriter, r *http.Request) {
+	_ = h.writeResponseToHeader(RefundReasonsResponse{Reasons: h.service.ListRefundReasons(r.Context())}, w)
+}
+
+func (h *OrderHandler) writeRefundOrderError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUnknownRefundReason):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrOrderNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrOrderNotBelongToUser):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrOrderNotCompleted),
+		errors.Is(err, domain.ErrOrderCannotBeRefunded),
+		errors.Is(err, domain.ErrOrderIsBlocked),
+		errors.Is(err, domain.ErrNoFreeStorageCell):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
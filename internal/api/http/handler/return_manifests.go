@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type ReturnManifestsListResponse struct {
+	Manifests []domain.ReturnManifest `json:"manifests"`
+}
+
+type CreateReturnManifestRequest struct {
+	PickupPointID int64  `json:"pickup_point_id"`
+	CourierName   string `json:"courier_name"`
+}
+
+type ScanReturnManifestOrderRequest struct {
+	OrderID int64 `json:"order_id"`
+}
+
+func (h *OrderHandler) CreateReturnManifest(w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var cr CreateReturnManifestRequest
+	if err := json.Unmarshal(body, &cr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	manifest, err := h.service.CreateReturnManifest(r.Context(), cr.PickupPointID, cr.CourierName)
+	if err != nil {
+		h.writeReturnManifestError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(manifest, w)
+}
+
+func (h *OrderHandler) ListReturnManifests(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var filter domain.ManifestFilter
+	if raw := query.Get("pickup_point_id"); raw != "" {
+		pickupPointID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || pickupPointID <= 0 {
+			http.Error(w, "pickup_point_id is not valid", http.StatusBadRequest)
+
+			return
+		}
+		filter.PickupPointID = &pickupPointID
+	}
+	if raw := query.Get("status"); raw != "" {
+		status, err := domain.ParseManifestStatus(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		filter.Status = &status
+	}
+
+	manifests, err := h.service.ListReturnManifests(r.Context(), filter)
+	if err != nil {
+		h.writeReturnManifestError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(ReturnManifestsListResponse{Manifests: manifests}, w)
+}
+
+func (h *OrderHandler) GetReturnManifest(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	manifest, err := h.service.GetReturnManifest(r.Context(), id)
+	if err != nil {
+		h.writeReturnManifestError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(manifest, w)
+}
+
+func (h *OrderHandler) ScanReturnManifestOrder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var sr ScanReturnManifestOrderRequest
+	if err := json.Unmarshal(body, &sr); err != nil || sr.OrderID <= 0 {
+		http.Error(w, "order_id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	manifest, err := h.service.ScanReturnManifestOrder(r.Context(), id, sr.OrderID)
+	if err != nil {
+		h.writeReturnManifestError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(manifest, w)
+}
+
+func (h *OrderHandler) CloseReturnManifest(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	manifest, err := h.service.CloseReturnManifest(r.Context(), id)
+	if err != nil {
+		h.writeReturnManifestError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(manifest, w)
+}
+
+// ExportReturnManifest sends the manifest as a file, format is "json"
+// (default) or "csv".
+func (h *OrderHandler) ExportReturnManifest(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+	format, err := domain.ParseExportFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	content, err := h.service.ExportReturnManifest(r.Context(), id, format)
+	if err != nil {
+		h.writeReturnManifestError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="return-manifest-%d.%s"`, id, format))
+	_, _ = w.Write(content)
+}
+
+func (h *OrderHandler) writeReturnManifestError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrReturnManifestFieldsAreIncorrect),
+		errors.Is(err, domain.ErrUnknownExportFormat):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrReturnManifestNotFound),
+		errors.Is(err, domain.ErrPickupPointNotFound),
+		errors.Is(err, domain.ErrOrderNotInManifest):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrReturnManifestIsClosed),
+		errors.Is(err, domain.ErrNothingToReturn),
+		errors.Is(err, domain.ErrNoScannedOrders),
+		errors.Is(err, domain.ErrOrderNotFound),
+		errors.Is(err, domain.ErrTransitionNotAllowed):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
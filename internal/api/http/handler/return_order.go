@@ -31,6 +31,11 @@ func (h *OrderHandler) ReturnOrder(w http.ResponseWriter, r *http.Request) {
 	case errors.Is(err, domain.ErrOrderNotFound):
 		http.Error(w, err.Error(), http.StatusNotFound)
 
+		return
+	case errors.Is(err, domain.ErrOrderIsInReturnManifest),
+		errors.Is(err, domain.ErrOrderIsBlocked):
+		http.Error(w, err.Error(), http.StatusConflict)
+
 		return
 	default:
 		if err != nil {
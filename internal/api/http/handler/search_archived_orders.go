@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type ArchivedOrdersResponse struct {
+	Orders []domain.ArchivedOrder `json:"orders"`
+}
+
+// SearchArchivedOrders looks up orders returned to the courier. All query
+// parameters are optional, archived_from and archived_to are RFC 3339 times.
+func (h *OrderHandler) SearchArchivedOrders(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	orderID, err := optionalIDParam(query, "order_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	userID, err := optionalIDParam(query, "user_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	pickupPointID, err := optionalIDParam(query, "pickup_point_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	lastID, err := optionalIDParam(query, "last_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	archivedFrom, err := optionalTimeParam(query, "archived_from")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	archivedTo, err := optionalTimeParam(query, "archived_to")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	var limit *int
+	if raw := query.Get("limit"); raw != "" {
+		l, err := strconv.Atoi(raw)
+		if err != nil || l <= 0 {
+			http.Error(w, "limit is not valid", http.StatusBadRequest)
+
+			return
+		}
+		limit = &l
+	}
+
+	filter, err := domain.NewArchiveFilter(orderID, userID, pickupPointID, archivedFrom, archivedTo)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	orders, err := h.service.SearchArchivedOrders(r.Context(), filter, lastID, limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(ArchivedOrdersResponse{Orders: orders}, w)
+}
+
+func optionalIDParam(query url.Values, name string) (*int64, error) {
+	raw := query.Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("%s is not valid", name)
+	}
+
+	return &id, nil
+}
+
+func optionalTimeParam(query url.Values, name string) (*time.Time, error) {
+	raw := query.Get(name)
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid", name)
+	}
+
+	return &t, nil
+}
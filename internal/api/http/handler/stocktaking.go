@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
+)
+
+type StocktakingsListResponse struct {
+	Sessions []domain.StocktakingSession `json:"sessions"`
+}
+
+type StartStocktakingRequest struct {
+	PickupPointID int64  `json:"pickup_point_id"`
+	Operator      string `json:"operator"`
+}
+
+func (h *OrderHandler) StartStocktaking(w http.ResponseWriter, r *http.Request) {
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var sr StartStocktakingRequest
+	if err := json.Unmarshal(body, &sr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	session, err := h.service.StartStocktaking(r.Context(), sr.PickupPointID, sr.Operator)
+	if err != nil {
+		h.writeStocktakingError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(session, w)
+}
+
+// AddStocktakingScans reads scanned order ids separated by whitespace from
+// the body, which may be sent chunked. Every request adds to the session.
+func (h *OrderHandler) AddStocktakingScans(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+	defer r.Body.Close()
+
+	var (
+		session domain.StocktakingSession
+		batch   = make([]int64, 0, service.StocktakingScanBatchSize)
+	)
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		orderID, err := strconv.ParseInt(scanner.Text(), 10, 64)
+		if err != nil || orderID <= 0 {
+			http.Error(w, fmt.Sprintf("order id %q is not valid", scanner.Text()), http.StatusBadRequest)
+
+			return
+		}
+		batch = append(batch, orderID)
+		if len(batch) < service.StocktakingScanBatchSize {
+			continue
+		}
+		if _, err := h.service.AddStocktakingScans(r.Context(), id, batch); err != nil {
+			h.writeStocktakingError(w, err)
+
+			return
+		}
+		batch = batch[:0]
+	}
+	if err := scanner.Err(); err != nil {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	session, err = h.service.AddStocktakingScans(r.Context(), id, batch)
+	if err != nil {
+		h.writeStocktakingError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(session, w)
+}
+
+func (h *OrderHandler) FinishStocktaking(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	session, err := h.service.FinishStocktaking(r.Context(), id)
+	if err != nil {
+		h.writeStocktakingError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(session, w)
+}
+
+func (h *OrderHandler) GetStocktaking(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	session, err := h.service.GetStocktaking(r.Context(), id)
+	if err != nil {
+		h.writeStocktakingError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(session, w)
+}
+
+func (h *OrderHandler) ListStocktakings(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var filter domain.StocktakingFilter
+	if raw := query.Get("pickup_point_id"); raw != "" {
+		pickupPointID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || pickupPointID <= 0 {
+			http.Error(w, "pickup_point_id is not valid", http.StatusBadRequest)
+
+			return
+		}
+		filter.PickupPointID = &pickupPointID
+	}
+	if raw := query.Get("status"); raw != "" {
+		status, err := domain.ParseStocktakingStatus(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+		filter.Status = &status
+	}
+
+	sessions, err := h.service.ListStocktakings(r.Context(), filter)
+	if err != nil {
+		h.writeStocktakingError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(StocktakingsListResponse{Sessions: sessions}, w)
+}
+
+func (h *OrderHandler) writeStocktakingError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrStocktakingFieldsAreIncorrect):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrStocktakingNotFound),
+		errors.Is(err, domain.ErrPickupPointNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrStocktakingAlreadyOpen),
+		errors.Is(err, domain.ErrStocktakingIsFinished):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
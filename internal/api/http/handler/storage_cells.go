@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+type StorageCellsListResponse struct {
+	StorageCells []domain.StorageCell `json:"storage_cells"`
+}
+
+type StorageOccupancyResponse struct {
+	Occupancy []StorageOccupancyItem `json:"occupancy"`
+}
+
+type StorageOccupancyItem struct {
+	domain.StorageOccupancy
+	FillRatio float64 `json:"fill_ratio"`
+}
+
+type CreateStorageCellRequest struct {
+	Code      string `json:"code"`
+	MaxWeight int    `json:"max_weight"`
+	MaxVolume int    `json:"max_volume"`
+	MaxLength int    `json:"max_length"`
+}
+
+func (h *OrderHandler) ListStorageCells(w http.ResponseWriter, r *http.Request) {
+	pickupPointID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	cells, err := h.service.ListStorageCells(r.Context(), pickupPointID)
+	if err != nil {
+		h.writePickupPointError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(StorageCellsListResponse{StorageCells: cells}, w)
+}
+
+func (h *OrderHandler) CreateStorageCell(w http.ResponseWriter, r *http.Request) {
+	pickupPointID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+
+	var cr CreateStorageCellRequest
+	if err := json.Unmarshal(body, &cr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	cell, err := domain.NewStorageCell(pickupPointID, cr.Code, cr.MaxWeight, cr.MaxVolume, cr.MaxLength)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	cell, err = h.service.CreateStorageCell(r.Context(), cell)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPickupPointNotFound):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case errors.Is(err, domain.ErrStorageCellAlreadyExists):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(cell, w)
+}
+
+func (h *OrderHandler) GetStorageOccupancy(w http.ResponseWriter, r *http.Request) {
+	occupancy, err := h.service.GetStorageOccupancy(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	items := make([]StorageOccupancyItem, len(occupancy))
+	for i, oc := range occupancy {
+		items[i] = StorageOccupancyItem{StorageOccupancy: oc, FillRatio: oc.FillRatio()}
+	}
+
+	_ = h.writeResponseToHeader(StorageOccupancyResponse{Occupancy: items}, w)
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+const revenueDateLayout = "2006-01-02"
+
+type StorageTariffRequest struct {
+	FreeDays  int          `json:"free_days"`
+	DailyRate domain.Money `json:"daily_rate"`
+}
+
+type DailyRevenueResponse struct {
+	Days []domain.DailyRevenue `json:"days"`
+}
+
+func (h *OrderHandler) GetStorageTariff(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	tariff, err := h.service.GetStorageTariff(r.Context(), id)
+	if err != nil {
+		h.writeStorageTariffError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(tariff, w)
+}
+
+func (h *OrderHandler) SetStorageTariff(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	body, err := h.getRequestBody(w, r, false)
+	if err != nil || len(body) == 0 {
+		http.Error(w, "body is incorrect", http.StatusBadRequest)
+
+		return
+	}
+	var tr StorageTariffRequest
+	if err := json.Unmarshal(body, &tr); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	tariff, err := h.service.SetStorageTariff(r.Context(), id, tr.FreeDays, tr.DailyRate)
+	if err != nil {
+		h.writeStorageTariffError(w, err)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(tariff, w)
+}
+
+func (h *OrderHandler) DeleteStorageTariff(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "id is not valid", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := h.service.DeleteStorageTariff(r.Context(), id); err != nil {
+		h.writeStorageTariffError(w, err)
+
+		return
+	}
+
+	h.writeOkResponseToHeader(w)
+}
+
+// GetDailyRevenue reports the days from the from date up to the to date,
+// exclusive. Dates are UTC in the YYYY-MM-DD format.
+func (h *OrderHandler) GetDailyRevenue(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	from, err := time.Parse(revenueDateLayout, query.Get("from"))
+	if err != nil {
+		http.Error(w, "from is not valid", http.StatusBadRequest)
+
+		return
+	}
+	to, err := time.Parse(revenueDateLayout, query.Get("to"))
+	if err != nil {
+		http.Error(w, "to is not valid", http.StatusBadRequest)
+
+		return
+	}
+	pickupPointID, err := optionalIDParam(query, "pickup_point_id")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	filter, err := domain.NewRevenueFilter(from, to, pickupPointID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	days, err := h.service.GetDailyRevenue(r.Context(), filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	_ = h.writeResponseToHeader(DailyRevenueResponse{Days: days}, w)
+}
+
+func (h *OrderHandler) writeStorageTariffError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrStorageTariffFieldsAreIncorrect):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrPickupPointNotFound),
+		errors.Is(err, domain.ErrStorageTariffNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
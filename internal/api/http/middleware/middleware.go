@@ -6,6 +6,7 @@ import (
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/http/handler"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/workers"
 	"io"
 	"net/http"
@@ -32,6 +33,27 @@ func AuthMiddleware(config config.Config, handler http.Handler) http.HandlerFunc
 	}
 }
 
+// SupervisorMiddleware authenticates the supervisor token of the request, if
+// there is one, and sets StatusUnauthorized for an unknown token.
+func SupervisorMiddleware(supervisors *service.Supervisors, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		token := req.Header.Get(service.SupervisorTokenHeader)
+		if token == "" {
+			handler.ServeHTTP(w, req)
+
+			return
+		}
+		supervisorID, err := supervisors.Authenticate(token)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+
+			return
+		}
+
+		handler.ServeHTTP(w, req.WithContext(service.ContextWithSupervisor(req.Context(), supervisorID)))
+	}
+}
+
 func AuditMiddleware(wm *workers.WorkerManager, h http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		var d domain.AuditLogData
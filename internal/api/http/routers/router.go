@@ -37,6 +37,18 @@ func (r *RouterImpl) RegisterRoutes(config config.Config) {
 			r.Handler.ConfirmOrders(w, req)
 		}
 	})
+	ordersRouter.HandleFunc("/quote", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.QuoteOrder(w, req)
+	}).Methods("POST")
+	ordersRouter.HandleFunc("/recommend", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.RecommendPackaging(w, req)
+	}).Methods("POST")
+	ordersRouter.HandleFunc("/complete", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.CompleteOrders(w, req)
+	}).Methods("POST")
+	ordersRouter.HandleFunc("/refund", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.RefundOrders(config, w, req)
+	}).Methods("POST")
 	ordersRouter.HandleFunc("/{action}/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
 		r.Handler.ReturnOrder(w, req)
 	}).Methods("PUT")
@@ -49,10 +61,164 @@ func (r *RouterImpl) RegisterRoutes(config config.Config) {
 			r.Handler.GetOrderByID(w, req)
 		}
 	})
+	ordersRouter.HandleFunc("/{id:[0-9]+}/pickup-code", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.IssuePickupCode(w, req)
+	}).Methods("POST")
+	ordersRouter.HandleFunc("/{id:[0-9]+}/transitions", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ListOrderTransitions(config, w, req)
+	}).Methods("GET")
 	ordersRouter.HandleFunc("/{orders}/{search:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
 		switch req.Method {
 		case http.MethodGet:
 			r.Handler.ListOrders(w, req)
 		}
 	})
+
+	adminRouter := r.Router.PathPrefix("/admin").Subrouter()
+	adminRouter.HandleFunc("/packages", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ListPackages(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/packages/{name}", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.UpsertPackage(w, req)
+	}).Methods("PUT")
+	adminRouter.HandleFunc("/pickup-points", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListPickupPoints(w, req)
+		case http.MethodPost:
+			r.Handler.CreatePickupPoint(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/pickup-points/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.GetPickupPoint(w, req)
+		case http.MethodPut:
+			r.Handler.UpdatePickupPoint(w, req)
+		case http.MethodDelete:
+			r.Handler.DeletePickupPoint(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/pickup-points/{id:[0-9]+}/cells", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListStorageCells(w, req)
+		case http.MethodPost:
+			r.Handler.CreateStorageCell(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/pickup-points/{id:[0-9]+}/storage-tariff", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.GetStorageTariff(w, req)
+		case http.MethodPut:
+			r.Handler.SetStorageTariff(w, req)
+		case http.MethodDelete:
+			r.Handler.DeleteStorageTariff(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/revenue/daily", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetDailyRevenue(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/storage/occupancy", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetStorageOccupancy(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/refund-reasons", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ListRefundReasons(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/archive/orders", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.SearchArchivedOrders(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/return-manifests", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListReturnManifests(w, req)
+		case http.MethodPost:
+			r.Handler.CreateReturnManifest(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/return-manifests/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetReturnManifest(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/return-manifests/{id:[0-9]+}/scan", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ScanReturnManifestOrder(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/return-manifests/{id:[0-9]+}/close", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.CloseReturnManifest(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/return-manifests/{id:[0-9]+}/export", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ExportReturnManifest(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/shipments", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListShipments(w, req)
+		case http.MethodPost:
+			r.Handler.RegisterShipment(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/shipments/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetShipment(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/shipments/{id:[0-9]+}/scan", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ScanShipmentOrder(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/shipments/{id:[0-9]+}/close", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.CloseShipment(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/shipments/{id:[0-9]+}/report", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetShipmentReport(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/stocktaking", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListStocktakings(w, req)
+		case http.MethodPost:
+			r.Handler.StartStocktaking(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/stocktaking/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetStocktaking(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/stocktaking/{id:[0-9]+}/scans", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.AddStocktakingScans(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/stocktaking/{id:[0-9]+}/finish", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.FinishStocktaking(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/incidents", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListIncidents(w, req)
+		case http.MethodPost:
+			r.Handler.OpenIncident(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/incidents/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.GetIncident(w, req)
+		case http.MethodPut:
+			r.Handler.UpdateIncident(w, req)
+		case http.MethodDelete:
+			r.Handler.CancelIncident(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/incidents/{id:[0-9]+}/resolve", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.ResolveIncident(w, req)
+	}).Methods("POST")
+	adminRouter.HandleFunc("/dead-letters", func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			r.Handler.ListDeadLetters(w, req)
+		case http.MethodDelete:
+			r.Handler.PurgeDeadLetters(w, req)
+		}
+	})
+	adminRouter.HandleFunc("/dead-letters/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.GetDeadLetter(w, req)
+	}).Methods("GET")
+	adminRouter.HandleFunc("/dead-letters/{id:[0-9]+}/requeue", func(w http.ResponseWriter, req *http.Request) {
+		r.Handler.RequeueDeadLetter(w, req)
+	}).Methods("POST")
 }
@@ -6,13 +6,12 @@ import (
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/http/handler"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/http/middleware"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/api/http/routers"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/app"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/cache"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/db"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository/postgresql"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/workers"
+	"log"
 	"net/http"
 	"time"
 )
@@ -20,17 +19,20 @@ import (
 func NewHTTPServer(ctx context.Context, dbConn db.DB, config config.Config, mng *tx_manager.TxManager, workersManager *workers.WorkerManager) *http.Server {
 	baseRouter := mux.NewRouter().StrictSlash(true)
 
-	client := cache.NewCacheClient(&config, 500)
-	orderRepo := postgresql.NewOrdersRepo(mng, client)
-	orderService := service.NewOrderServiceImpl(orderRepo, *mng, workersManager)
-	orderHandler := handler.NewOrderHandler(orderService, workersManager)
+	orders, err := app.NewOrderService(ctx, config, mng)
+	if err != nil {
+		log.Fatalf("failed to build order service: %v", err)
+	}
+	workers.NewExpirySweeper(orders.Service, config.ExpirySweeper).Start(ctx)
+	orderHandler := handler.NewOrderHandler(orders.Service, workersManager)
 
-	client.StartPeriodicUpdate(ctx, time.Duration(config.Interval), orderRepo)
+	orders.Cache.StartPeriodicUpdate(ctx, time.Duration(config.Interval), orders.Orders)
 
 	routerImpl := routers.NewRouter(baseRouter, *orderHandler)
 	routerImpl.RegisterRoutes(config)
 
-	finalHandler := middleware.AuthMiddleware(config, middleware.AuditMiddleware(workersManager, routerImpl.Router))
+	finalHandler := middleware.AuthMiddleware(config, middleware.AuditMiddleware(workersManager,
+		middleware.SupervisorMiddleware(orders.Supervisors, routerImpl.Router)))
 
 	workersManager.Start(ctx)
 
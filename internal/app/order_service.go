@@ -0,0 +1,97 @@
+// Package app wires the order service with its storage and config, so that
+// the gRPC and the HTTP server run the same service.
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/cache"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/logger"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository/postgresql"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service"
+	"go.uber.org/zap"
+)
+
+// orderCacheTTL is how long orders stay in memcached, in seconds.
+const orderCacheTTL = 500
+
+// OrderService is the order service with what the servers need next to it:
+// the supervisors their transports authenticate and the cached order
+// repository the HTTP server refreshes.
+type OrderService struct {
+	Service     *service.OrderServiceImpl
+	Supervisors *service.Supervisors
+	Orders      *postgresql.OrderRepo
+	Cache       *cache.Client
+}
+
+// NewOrderService builds the order service from the config. An incorrect
+// config is an error, a packaging catalog that cannot be loaded falls back to
+// the packages of the config.
+func NewOrderService(ctx context.Context, cfg config.Config, mng *tx_manager.TxManager) (OrderService, error) {
+	supervisors, err := service.NewSupervisors(cfg.Supervisors)
+	if err != nil {
+		return OrderService{}, fmt.Errorf("invalid supervisors config: %w", err)
+	}
+	secret, err := service.PickupCodeSecretFromConfig(cfg.PickupCodes)
+	if err != nil {
+		return OrderService{}, fmt.Errorf("invalid pickup codes config: %w", err)
+	}
+	packages, err := service.PackageSpecsFromConfig(cfg.Packages)
+	if err != nil {
+		return OrderService{}, fmt.Errorf("invalid packages config: %w", err)
+	}
+	tariff, err := service.StorageTariffFromConfig(cfg.StorageTariff)
+	if err != nil {
+		return OrderService{}, fmt.Errorf("invalid storage tariff config: %w", err)
+	}
+
+	catalog := service.NewPackagingCatalog(
+		postgresql.NewPackageRepositoryImpl(mng),
+		packages,
+		service.CompositionRulesFromConfig(cfg.PackagingRules),
+	)
+	if err := catalog.Load(ctx); err != nil {
+		logger.ZapLogger.Error("failed to load packaging catalog, using config defaults", zap.Error(err))
+	}
+
+	client := cache.NewCacheClient(&cfg, orderCacheTTL)
+	orderRepo := postgresql.NewOrdersRepo(mng, client)
+	orderService := service.NewOrderServiceImpl(
+		orderRepo,
+		mng,
+		postgresql.NewOrderStatusAuditRepositoryImpl(mng),
+		catalog,
+		postgresql.NewPickupPointRepositoryImpl(mng),
+		postgresql.NewStorageCellRepositoryImpl(mng),
+		service.NewPickupCodes(
+			postgresql.NewPickupCodeRepositoryImpl(mng),
+			secret,
+			service.PickupCodePolicyFromConfig(cfg.PickupCodes),
+		),
+		service.NewRefunds(
+			postgresql.NewRefundDetailsRepositoryImpl(mng),
+			service.RefundReasonsFromConfig(cfg.RefundReasons),
+		),
+		postgresql.NewOrderArchiveRepositoryImpl(mng),
+		postgresql.NewReturnManifestRepositoryImpl(mng),
+		postgresql.NewInboundShipmentRepositoryImpl(mng),
+		postgresql.NewStocktakingRepositoryImpl(mng),
+		postgresql.NewIncidentRepositoryImpl(mng),
+		service.NewStorageFees(
+			postgresql.NewStorageFeeRepositoryImpl(mng),
+			tariff,
+		),
+		postgresql.NewDeadLetterRepositoryImpl(mng),
+	)
+
+	return OrderService{
+		Service:     orderService,
+		Supervisors: supervisors,
+		Orders:      orderRepo,
+		Cache:       client,
+	}, nil
+}
@@ -27,6 +27,22 @@ type Config struct {
 
 	MetricsPort string `yaml:"metrics_port"`
 
+	Packages       []PackageConfig       `yaml:"packages"`
+	PackagingRules []PackagingRuleConfig `yaml:"packaging_rules"`
+
+	PickupCodes PickupCodeConfig   `yaml:"pickup_codes"`
+	Supervisors []SupervisorConfig `yaml:"supervisors"`
+
+	RefundReasons []RefundReasonConfig `yaml:"refund_reasons"`
+
+	Archive ArchiveConfig `yaml:"archive"`
+
+	ExpirySweeper ExpirySweeperConfig `yaml:"expiry_sweeper"`
+
+	StorageTariff StorageTariffConfig `yaml:"storage_tariff"`
+
+	Outbox OutboxConfig `yaml:"outbox"`
+
 	Kafka struct {
 		Brokers             []string `yaml:"brokers"`
 		Topic               string   `yaml:"topic"`
@@ -38,6 +54,88 @@ type Config struct {
 	} `yaml:"kafka"`
 }
 
+type PackageConfig struct {
+	Name      string `yaml:"name"`
+	MaxWeight int    `yaml:"max_weight"`
+	MaxVolume int    `yaml:"max_volume"`
+	MaxLength int    `yaml:"max_length"`
+	Price     int64  `yaml:"price"`
+	Currency  string `yaml:"currency"`
+	CanWrap   bool   `yaml:"can_wrap"`
+	IsActive  bool   `yaml:"is_active"`
+}
+
+// PackagingRuleConfig forbids Outer package to be wrapped around Inner package.
+type PackagingRuleConfig struct {
+	Outer string `yaml:"outer"`
+	Inner string `yaml:"inner"`
+}
+
+// PickupCodeConfig sets the key pickup codes are hashed with and how many wrong
+// codes lock an order for LockoutMinutes.
+type PickupCodeConfig struct {
+	Secret         string `yaml:"secret"`
+	MaxAttempts    int    `yaml:"max_attempts"`
+	LockoutMinutes int    `yaml:"lockout_minutes"`
+}
+
+// SupervisorConfig is a supervisor allowed to issue orders without their
+// pickup code. The supervisor authenticates with Token.
+type SupervisorConfig struct {
+	ID    int64  `yaml:"id"`
+	Token string `yaml:"token"`
+}
+
+// RefundReasonConfig is an entry of the catalog of reasons a refund may be
+// accepted for.
+type RefundReasonConfig struct {
+	Code        string `yaml:"code"`
+	Description string `yaml:"description"`
+}
+
+// ArchiveConfig sets how long returned orders stay in the archive. Zero
+// RetentionDays keeps them forever.
+type ArchiveConfig struct {
+	RetentionDays        int `yaml:"retention_days"`
+	PurgeIntervalMinutes int `yaml:"purge_interval_minutes"`
+}
+
+// ExpirySweeperConfig sets how often expired orders are looked for and how
+// many of them are moved in one transaction.
+type ExpirySweeperConfig struct {
+	IntervalSeconds int `yaml:"interval_seconds"`
+	BatchSize       int `yaml:"batch_size"`
+}
+
+// StorageTariffConfig is the tariff of pickup points that have none of their
+// own. DailyRate is in minor units, zero keeps orders for free.
+type StorageTariffConfig struct {
+	FreeDays  int    `yaml:"free_days"`
+	DailyRate int64  `yaml:"daily_rate"`
+	Currency  string `yaml:"currency"`
+}
+
+// OutboxConfig sets how long a replica owns the outbox tasks it fetched, how
+// often tasks with an expired lease are reclaimed and how events are encoded,
+// "protobuf" (the default) or "json".
+type OutboxConfig struct {
+	LeaseSeconds        int                 `yaml:"lease_seconds"`
+	ReapIntervalSeconds int                 `yaml:"reap_interval_seconds"`
+	Encoding            string              `yaml:"encoding"`
+	Retry               []RetryPolicyConfig `yaml:"retry"`
+}
+
+// RetryPolicyConfig is the retry policy of one outbox task type. Task types
+// without a policy are tried 3 times with a backoff from 2 seconds to a minute.
+type RetryPolicyConfig struct {
+	TaskType    string  `yaml:"task_type"`
+	MaxAttempts int     `yaml:"max_attempts"`
+	BaseDelayMs int     `yaml:"base_delay_ms"`
+	Multiplier  float64 `yaml:"multiplier"`
+	Jitter      float64 `yaml:"jitter"`
+	MaxDelayMs  int     `yaml:"max_delay_ms"`
+}
+
 func LoadConfig(path string) (*Config, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -65,4 +163,8 @@ func ApplyEnvironmentVariables(cfg *Config) {
 		log.Printf("DB_HOST value was set to: %s", dbHost)
 		cfg.DBHost = dbHost
 	}
+	if secret := os.Getenv("PICKUP_CODE_SECRET"); secret != "" {
+		log.Printf("PICKUP_CODE_SECRET value was set")
+		cfg.PickupCodes.Secret = secret
+	}
 }
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// ArchivedOrder is an order returned to the courier. It keeps the last state
+// the order had before it was moved out of the active orders.
+type ArchivedOrder struct {
+	Order
+	ArchivedAt time.Time `db:"archived_at"`
+}
+
+// ArchiveFilter narrows an archive search, nil fields match any order.
+type ArchiveFilter struct {
+	OrderID       *int64
+	UserID        *int64
+	PickupPointID *int64
+	ArchivedFrom  *time.Time
+	ArchivedTo    *time.Time
+}
+
+func NewArchiveFilter(
+	orderID *int64,
+	userID *int64,
+	pickupPointID *int64,
+	archivedFrom *time.Time,
+	archivedTo *time.Time,
+) (ArchiveFilter, error) {
+	if archivedFrom != nil && archivedTo != nil && archivedTo.Before(*archivedFrom) {
+		return ArchiveFilter{}, ErrArchiveFilterIsIncorrect
+	}
+
+	return ArchiveFilter{
+		OrderID:       orderID,
+		UserID:        userID,
+		PickupPointID: pickupPointID,
+		ArchivedFrom:  archivedFrom,
+		ArchivedTo:    archivedTo,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewArchiveFilter(t *testing.T) {
+	t.Parallel()
+	var (
+		userID = int64(7)
+		from   = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+		to     = from.AddDate(0, 0, 7)
+	)
+
+	t.Run("empty filter", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := NewArchiveFilter(nil, nil, nil, nil, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, ArchiveFilter{}, filter)
+	})
+	t.Run("period", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := NewArchiveFilter(nil, &userID, nil, &from, &to)
+
+		require.NoError(t, err)
+		require.Equal(t, &userID, filter.UserID)
+		require.Equal(t, &from, filter.ArchivedFrom)
+		require.Equal(t, &to, filter.ArchivedTo)
+	})
+	t.Run("period ends before it starts", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewArchiveFilter(nil, nil, nil, &to, &from)
+
+		require.ErrorIs(t, err, ErrArchiveFilterIsIncorrect)
+	})
+}
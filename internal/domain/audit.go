@@ -26,12 +26,16 @@ type AuditLogData struct {
 	RequestBody  []byte
 }
 
+// AuditOrderInfo is a status change of an order. Refund is set when the order
+// was refunded, IncidentID when an incident blocked or released it.
 type AuditOrderInfo struct {
-	EntryID        int64     `json:"entry_id" db:"entry_id"`
-	OrderID        int64     `json:"order_id" db:"order_id"`
-	PreviousStatus Status    `json:"previous_status" db:"previous_status"`
-	CurrentStatus  Status    `json:"current_status" db:"current_status"`
-	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+	EntryID        int64          `json:"entry_id" db:"entry_id"`
+	OrderID        int64          `json:"order_id" db:"order_id"`
+	PreviousStatus Status         `json:"previous_status" db:"previous_status"`
+	CurrentStatus  Status         `json:"current_status" db:"current_status"`
+	Refund         *RefundDetails `json:"refund,omitempty" db:"-"`
+	IncidentID     *int64         `json:"incident_id,omitempty" db:"incident_id"`
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
 }
 
 func NewAuditOrderInfo(orderID int64, previousStatus Status, currentStatus Status) *AuditOrderInfo {
@@ -42,15 +46,18 @@ func NewAuditOrderInfo(orderID int64, previousStatus Status, currentStatus Statu
 	}
 }
 
+// redactedFields are body fields whose values never reach the audit log.
+var redactedFields = []string{"pickup_code"}
+
 func NewAuditLogRecord(d *AuditLogData) AuditLogRecord {
 	vars := mux.Vars(d.Request)
 
-	validRequestBody := d.RequestBody
+	validRequestBody := redactBody(d.RequestBody)
 	if len(validRequestBody) == 0 {
 		validRequestBody = []byte("null")
 	}
 
-	validResponseBody := d.ResponseBody
+	validResponseBody := redactBody(d.ResponseBody)
 	if len(validResponseBody) == 0 {
 		validResponseBody = []byte("null")
 	}
@@ -65,3 +72,30 @@ func NewAuditLogRecord(d *AuditLogData) AuditLogRecord {
 		ResponseBody:  validResponseBody,
 	}
 }
+
+// redactBody masks redactedFields of a JSON object body. Other bodies are
+// returned as they are.
+func redactBody(body []byte) []byte {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return body
+	}
+
+	redacted := false
+	for _, name := range redactedFields {
+		if _, ok := fields[name]; ok {
+			fields[name] = json.RawMessage(`"***"`)
+			redacted = true
+		}
+	}
+	if !redacted {
+		return body
+	}
+
+	masked, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+
+	return masked
+}
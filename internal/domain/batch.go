@@ -0,0 +1,106 @@
+package domain
+
+import "sort"
+
+// MaxBatchSize limits how many orders one batch call may process.
+const MaxBatchSize = 100
+
+type BatchMode string
+
+const (
+	// BatchAtomic processes every order of a batch or none of them.
+	BatchAtomic BatchMode = "atomic"
+	// BatchPartial processes orders one by one and reports failures per order.
+	BatchPartial BatchMode = "partial"
+)
+
+// ParseBatchMode defaults to BatchAtomic when the mode is not set.
+func ParseBatchMode(mode string) (BatchMode, error) {
+	switch BatchMode(mode) {
+	case "", BatchAtomic:
+		return BatchAtomic, nil
+	case BatchPartial:
+		return BatchPartial, nil
+	default:
+		return "", ErrUnknownBatchMode
+	}
+}
+
+// ValidateBatch checks the size of a batch and that no order is repeated in it.
+func ValidateBatch(orderIDs []int64) error {
+	if len(orderIDs) == 0 {
+		return ErrBatchIsEmpty
+	}
+	if len(orderIDs) > MaxBatchSize {
+		return ErrBatchTooLarge
+	}
+	seen := make(map[int64]struct{}, len(orderIDs))
+	for _, id := range orderIDs {
+		if _, ok := seen[id]; ok {
+			return ErrBatchHasDuplicates
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
+
+// PickupItem is an order a customer wants to receive with its pickup code.
+// StorageFeePaid acknowledges that the accrued storage fee was paid.
+type PickupItem struct {
+	OrderID        int64
+	PickupCode     string
+	StorageFeePaid bool
+}
+
+// BatchItemResult is the outcome of one order of a batch, Err is nil on success.
+type BatchItemResult struct {
+	OrderID int64
+	Err     error
+}
+
+func (r BatchItemResult) OK() bool {
+	return r.Err == nil
+}
+
+// BatchResult lists per-order outcomes in request order and the sum of costs
+// of the processed orders, one entry per currency.
+type BatchResult struct {
+	Items  []BatchItemResult
+	Totals []Money
+}
+
+// AddTotal adds the amount to the total of its currency.
+func (r *BatchResult) AddTotal(amount Money) error {
+	for i, total := range r.Totals {
+		if total.Currency != amount.Currency {
+			continue
+		}
+		sum, err := total.Add(amount)
+		if err != nil {
+			return err
+		}
+		r.Totals[i] = sum
+
+		return nil
+	}
+
+	r.Totals = append(r.Totals, amount)
+	sort.Slice(r.Totals, func(i, j int) bool {
+		return r.Totals[i].Currency < r.Totals[j].Currency
+	})
+
+	return nil
+}
+
+// Succeeded counts orders processed without an error.
+func (r BatchResult) Succeeded() int {
+	n := 0
+	for _, item := range r.Items {
+		if item.OK() {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBatchMode(t *testing.T) {
+	t.Parallel()
+
+	mode, err := ParseBatchMode("")
+	require.NoError(t, err)
+	require.Equal(t, BatchAtomic, mode)
+
+	mode, err = ParseBatchMode("partial")
+	require.NoError(t, err)
+	require.Equal(t, BatchPartial, mode)
+
+	_, err = ParseBatchMode("best-effort")
+	require.ErrorIs(t, err, ErrUnknownBatchMode)
+}
+
+func TestValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateBatch([]int64{1, 2, 3}))
+	require.ErrorIs(t, ValidateBatch(nil), ErrBatchIsEmpty)
+	require.ErrorIs(t, ValidateBatch([]int64{1, 2, 1}), ErrBatchHasDuplicates)
+	require.ErrorIs(t, ValidateBatch(make([]int64, MaxBatchSize+1)), ErrBatchTooLarge)
+}
+
+func TestBatchResult_AddTotal(t *testing.T) {
+	t.Parallel()
+	var result BatchResult
+
+	require.NoError(t, result.AddTotal(Money{Amount: 100, Currency: USD}))
+	require.NoError(t, result.AddTotal(Money{Amount: 200, Currency: RUB}))
+	require.NoError(t, result.AddTotal(Money{Amount: 50, Currency: USD}))
+
+	require.Equal(t, []Money{{Amount: 200, Currency: RUB}, {Amount: 150, Currency: USD}}, result.Totals)
+}
@@ -3,16 +3,94 @@ package domain
 import "errors"
 
 var (
-	ErrOrderNotFound                  = errors.New("order not found")
-	ErrOrderAlreadyExists             = errors.New("order already exists")
-	ErrOrderNotBelongToUser           = errors.New("order is not belong to user")
-	ErrOrderCannotBeRefunded          = errors.New("order cannot be refunded")
-	ErrOrderNotCompleted              = errors.New("order is not completed")
-	ErrExpirationDateInPast           = errors.New("expiration date is in the past")
-	ErrExpirationDateInFuture         = errors.New("expiration date is in the future")
-	ErrIncorrectWeightForApplyPackage = errors.New("incorrect weight for apply package")
-	ErrOrderFieldsAreIncorrect        = errors.New("order cannot be created. Incorrect fields")
-	ErrPackageNotExists               = errors.New("package does not exist")
-	ErrOrderAlreadyCompleted          = errors.New("order already completed")
-	ErrOrderHasToBeRefunded           = errors.New("order has to be refunded")
+	ErrOrderNotFound                    = errors.New("order not found")
+	ErrOrderAlreadyExists               = errors.New("order already exists")
+	ErrOrderNotBelongToUser             = errors.New("order is not belong to user")
+	ErrUserIDRequired                   = errors.New("user id is required and must be positive")
+	ErrOrderCannotBeRefunded            = errors.New("order cannot be refunded")
+	ErrOrderNotCompleted                = errors.New("order is not completed")
+	ErrExpirationDateInPast             = errors.New("expiration date is in the past")
+	ErrExpirationDateInFuture           = errors.New("expiration date is in the future")
+	ErrIncorrectWeightForApplyPackage   = errors.New("incorrect weight for apply package")
+	ErrIncorrectSizeForApplyPackage     = errors.New("parcel does not fit into package")
+	ErrNoSuitablePackage                = errors.New("no suitable package for parcel")
+	ErrUnknownCurrency                  = errors.New("unknown currency")
+	ErrCostCurrencyRequired             = errors.New("cost currency is required, the cost is in its minor units")
+	ErrCurrencyMismatch                 = errors.New("currencies do not match")
+	ErrMoneyOverflow                    = errors.New("money amount overflow")
+	ErrMoneyDivisionByZero              = errors.New("money division by zero")
+	ErrOrderFieldsAreIncorrect          = errors.New("order cannot be created. Incorrect fields")
+	ErrPackageNotExists                 = errors.New("package does not exist")
+	ErrPackageNotActive                 = errors.New("package is not active")
+	ErrPackageCannotWrap                = errors.New("package cannot wrap another package")
+	ErrPackageFieldsAreIncorrect        = errors.New("package cannot be saved. Incorrect fields")
+	ErrPackagingCompositionNotAllowed   = errors.New("packaging composition is not allowed")
+	ErrPickupPointNotFound              = errors.New("pickup point not found")
+	ErrPickupPointFieldsAreIncorrect    = errors.New("pickup point cannot be saved. Incorrect fields")
+	ErrPickupPointHasOrders             = errors.New("pickup point still has orders")
+	ErrDefaultPickupPoint               = errors.New("default pickup point cannot be deleted")
+	ErrStorageCellNotFound              = errors.New("storage cell not found")
+	ErrStorageCellFieldsAreIncorrect    = errors.New("storage cell cannot be saved. Incorrect fields")
+	ErrStorageCellAlreadyExists         = errors.New("storage cell already exists")
+	ErrNoFreeStorageCell                = errors.New("no free storage cell fits the parcel")
+	ErrPickupCodeRequired               = errors.New("pickup code is required")
+	ErrPickupCodeInvalid                = errors.New("pickup code is invalid")
+	ErrPickupCodeLocked                 = errors.New("too many wrong pickup codes, try again later")
+	ErrPickupCodeNotIssued              = errors.New("pickup code has not been issued for the order")
+	ErrPickupCodeUsed                   = errors.New("pickup code has already been used")
+	ErrPickupOverrideFieldsAreIncorrect = errors.New("pickup override needs a supervisor and a reason")
+	ErrPickupCodeSecretIsIncorrect      = errors.New("pickup code secret has to be set and must not be the default one")
+	ErrSupervisorRequired               = errors.New("action needs an authenticated supervisor")
+	ErrSupervisorTokenInvalid           = errors.New("supervisor token is invalid")
+	ErrSupervisorsAreIncorrect          = errors.New("supervisors need a positive id and a unique token")
+	ErrRefundReasonRequired             = errors.New("refund reason is required")
+	ErrUnknownRefundReason              = errors.New("unknown refund reason")
+	ErrUnknownItemCondition             = errors.New("item condition must be intact, opened or damaged")
+	ErrRefundDetailsAreIncorrect        = errors.New("refund details are incorrect")
+	ErrRefundDetailsNotFound            = errors.New("refund details not found")
+	ErrUnknownBatchMode                 = errors.New("unknown batch mode")
+	ErrBatchIsEmpty                     = errors.New("batch has no orders")
+	ErrBatchTooLarge                    = errors.New("batch has too many orders")
+	ErrBatchHasDuplicates               = errors.New("batch has duplicate orders")
+	ErrBatchRolledBack                  = errors.New("order was not processed because another order of the batch failed")
+	ErrArchiveFilterIsIncorrect         = errors.New("archive filter is incorrect")
+	ErrReturnManifestFieldsAreIncorrect = errors.New("return manifest fields are incorrect")
+	ErrReturnManifestNotFound           = errors.New("return manifest not found")
+	ErrReturnManifestIsClosed           = errors.New("return manifest is closed")
+	ErrNothingToReturn                  = errors.New("pickup point has no orders to return")
+	ErrNoScannedOrders                  = errors.New("return manifest has no scanned orders")
+	ErrOrderNotInManifest               = errors.New("order is not in the return manifest")
+	ErrOrderIsInReturnManifest          = errors.New("order is in an open return manifest")
+	ErrUnknownManifestStatus            = errors.New("unknown manifest status")
+	ErrUnknownExportFormat              = errors.New("unknown export format")
+	ErrShipmentFieldsAreIncorrect       = errors.New("inbound shipment fields are incorrect")
+	ErrShipmentIsEmpty                  = errors.New("inbound shipment has no orders")
+	ErrShipmentTooLarge                 = errors.New("inbound shipment has too many orders")
+	ErrShipmentHasDuplicates            = errors.New("inbound shipment has duplicate orders")
+	ErrShipmentNotFound                 = errors.New("inbound shipment not found")
+	ErrShipmentIsClosed                 = errors.New("inbound shipment is closed")
+	ErrShipmentReportNotFound           = errors.New("inbound shipment report not found")
+	ErrUnknownShipmentStatus            = errors.New("unknown shipment status")
+	ErrStocktakingFieldsAreIncorrect    = errors.New("stocktaking session fields are incorrect")
+	ErrStocktakingNotFound              = errors.New("stocktaking session not found")
+	ErrStocktakingIsFinished            = errors.New("stocktaking session is finished")
+	ErrStocktakingAlreadyOpen           = errors.New("pickup point already has an open stocktaking session")
+	ErrUnknownStocktakingStatus         = errors.New("unknown stocktaking status")
+	ErrIncidentFieldsAreIncorrect       = errors.New("incident fields are incorrect")
+	ErrIncidentNotFound                 = errors.New("incident not found")
+	ErrIncidentIsClosed                 = errors.New("incident is already resolved or cancelled")
+	ErrOrderIsBlocked                   = errors.New("order is blocked by an open incident")
+	ErrUnknownIncidentType              = errors.New("incident type must be lost or damaged")
+	ErrUnknownIncidentStatus            = errors.New("unknown incident status")
+	ErrUnknownIncidentOutcome           = errors.New("incident outcome must be found, compensated or written_off")
+	ErrStorageTariffFieldsAreIncorrect  = errors.New("storage tariff fields are incorrect")
+	ErrStorageTariffNotFound            = errors.New("storage tariff not found")
+	ErrStorageFeeNotPaid                = errors.New("storage fee has to be paid before the order is issued")
+	ErrRevenuePeriodIsIncorrect         = errors.New("revenue period is incorrect")
+	ErrRetryPolicyIsIncorrect           = errors.New("retry policy is incorrect")
+	ErrUnknownTaskType                  = errors.New("task type must be AUDIT_LOG or ORDER_STATUS_LOG")
+	ErrDeadLetterNotFound               = errors.New("dead letter not found")
+	ErrOrderAlreadyCompleted            = errors.New("order already completed")
+	ErrOrderHasToBeRefunded             = errors.New("order has to be refunded")
+	ErrTransitionNotAllowed             = errors.New("transition is not allowed for current order status")
 )
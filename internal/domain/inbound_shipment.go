@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxShipmentReferenceLength limits the waybill reference in characters.
+	MaxShipmentReferenceLength = 255
+	// MaxShipmentSize limits the number of orders expected in one shipment.
+	MaxShipmentSize = 1000
+)
+
+type ShipmentStatus string
+
+const (
+	ShipmentOpen   ShipmentStatus = "open"
+	ShipmentClosed ShipmentStatus = "closed"
+)
+
+// InboundShipment is a delivery expected at a pickup point. Its manifest is
+// registered before the delivery arrives, staff scan the orders physically
+// received and the received orders are created when the shipment is closed.
+type InboundShipment struct {
+	ID            int64          `json:"id" db:"id"`
+	PickupPointID int64          `json:"pickup_point_id" db:"pickup_point_id"`
+	Reference     string         `json:"reference" db:"reference"`
+	Status        ShipmentStatus `json:"status" db:"status"`
+	CreatedAt     time.Time      `json:"created_at" db:"created_at"`
+	ClosedAt      *time.Time     `json:"closed_at,omitempty" db:"closed_at"`
+	Items         []ShipmentItem `json:"items" db:"-"`
+	// Scanned holds every scanned order id, expected or not.
+	Scanned []int64 `json:"scanned" db:"-"`
+}
+
+// ShipmentItem is an order expected in a shipment.
+type ShipmentItem struct {
+	ShipmentID     int64     `json:"-" db:"shipment_id"`
+	OrderID        int64     `json:"order_id" db:"order_id"`
+	UserID         int64     `json:"user_id" db:"user_id"`
+	ExpirationTime time.Time `json:"expiration_time" db:"expiration_date"`
+	Weight         int       `json:"weight" db:"weight"`
+	Cost           Money     `json:"cost" db:"-"`
+	Dimensions
+}
+
+// RejectedItem is a received order that could not be created.
+type RejectedItem struct {
+	OrderID int64  `json:"order_id"`
+	Reason  string `json:"reason"`
+}
+
+// ShipmentReport is the outcome of a closed shipment. Received orders were
+// created, missing ones were expected but not scanned, unexpected ones were
+// scanned but not expected. PickupCodes of the received orders are set only
+// when the shipment is closed, they are never stored.
+type ShipmentReport struct {
+	ShipmentID  int64              `json:"shipment_id" db:"shipment_id"`
+	Received    []int64            `json:"received" db:"received"`
+	Missing     []int64            `json:"missing" db:"missing"`
+	Unexpected  []int64            `json:"unexpected" db:"unexpected"`
+	Rejected    []RejectedItem     `json:"rejected" db:"rejected"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+	PickupCodes []IssuedPickupCode `json:"pickup_codes,omitempty" db:"-"`
+}
+
+func NewInboundShipment(pickupPointID int64, reference string, items []ShipmentItem) (InboundShipment, error) {
+	reference = strings.TrimSpace(reference)
+	if pickupPointID <= 0 || reference == "" || utf8.RuneCountInString(reference) > MaxShipmentReferenceLength {
+		return InboundShipment{}, ErrShipmentFieldsAreIncorrect
+	}
+	if len(items) == 0 {
+		return InboundShipment{}, ErrShipmentIsEmpty
+	}
+	if len(items) > MaxShipmentSize {
+		return InboundShipment{}, ErrShipmentTooLarge
+	}
+
+	seen := make(map[int64]struct{}, len(items))
+	for _, item := range items {
+		if item.OrderID <= 0 || item.UserID <= 0 || item.Weight < 0 ||
+			item.Cost.IsNegative() || !item.Cost.Currency.IsValid() ||
+			item.Length < 0 || item.Width < 0 || item.Height < 0 {
+			return InboundShipment{}, ErrShipmentFieldsAreIncorrect
+		}
+		if _, ok := seen[item.OrderID]; ok {
+			return InboundShipment{}, ErrShipmentHasDuplicates
+		}
+		seen[item.OrderID] = struct{}{}
+	}
+
+	return InboundShipment{
+		PickupPointID: pickupPointID,
+		Reference:     reference,
+		Status:        ShipmentOpen,
+		Items:         items,
+	}, nil
+}
+
+func (s InboundShipment) IsOpen() bool {
+	return s.Status == ShipmentOpen
+}
+
+// Reconcile compares the scans with the manifest. It returns the expected
+// items that were scanned, the ids of expected orders that were not and the
+// ids of scanned orders nobody expected, all sorted by order id.
+func (s InboundShipment) Reconcile() (received []ShipmentItem, missing []int64, unexpected []int64) {
+	scanned := make(map[int64]struct{}, len(s.Scanned))
+	for _, id := range s.Scanned {
+		scanned[id] = struct{}{}
+	}
+	expected := make(map[int64]struct{}, len(s.Items))
+	for _, item := range s.Items {
+		expected[item.OrderID] = struct{}{}
+		if _, ok := scanned[item.OrderID]; ok {
+			received = append(received, item)
+		} else {
+			missing = append(missing, item.OrderID)
+		}
+	}
+	for id := range scanned {
+		if _, ok := expected[id]; !ok {
+			unexpected = append(unexpected, id)
+		}
+	}
+
+	sort.Slice(received, func(i, j int) bool { return received[i].OrderID < received[j].OrderID })
+	sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+	sort.Slice(unexpected, func(i, j int) bool { return unexpected[i] < unexpected[j] })
+
+	return received, missing, unexpected
+}
+
+// ToOrder builds the order created for a received item.
+func (i ShipmentItem) ToOrder(pickupPointID int64) Order {
+	return Order{
+		OrderID:        i.OrderID,
+		UserID:         i.UserID,
+		ExpirationTime: i.ExpirationTime,
+		Weight:         i.Weight,
+		Cost:           i.Cost,
+		PickupPointID:  pickupPointID,
+		Dimensions:     i.Dimensions,
+	}
+}
+
+func (r ShipmentReport) HasDiscrepancies() bool {
+	return len(r.Missing) > 0 || len(r.Unexpected) > 0 || len(r.Rejected) > 0
+}
+
+// ShipmentFilter narrows a shipment search, nil fields match any shipment.
+type ShipmentFilter struct {
+	PickupPointID *int64
+	Status        *ShipmentStatus
+}
+
+func ParseShipmentStatus(s string) (ShipmentStatus, error) {
+	switch ShipmentStatus(s) {
+	case ShipmentOpen, ShipmentClosed:
+		return ShipmentStatus(s), nil
+	default:
+		return "", ErrUnknownShipmentStatus
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInboundShipment(t *testing.T) {
+	t.Parallel()
+	item := ShipmentItem{OrderID: 1, UserID: 10, ExpirationTime: time.Now().Add(time.Hour), Cost: Money{Currency: RUB}}
+	tests := []struct {
+		name          string
+		pickupPointID int64
+		reference     string
+		items         []ShipmentItem
+		wantErr       error
+	}{
+		{name: "valid", pickupPointID: 1, reference: "WB-1", items: []ShipmentItem{item}},
+		{name: "no reference", pickupPointID: 1, reference: " ", items: []ShipmentItem{item}, wantErr: ErrShipmentFieldsAreIncorrect},
+		{name: "no items", pickupPointID: 1, reference: "WB-1", wantErr: ErrShipmentIsEmpty},
+		{
+			name:          "duplicate items",
+			pickupPointID: 1,
+			reference:     "WB-1",
+			items:         []ShipmentItem{item, item},
+			wantErr:       ErrShipmentHasDuplicates,
+		},
+		{
+			name:          "unknown currency",
+			pickupPointID: 1,
+			reference:     "WB-1",
+			items:         []ShipmentItem{{OrderID: 1, UserID: 10, Cost: Money{Currency: "XXX"}}},
+			wantErr:       ErrShipmentFieldsAreIncorrect,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			shipment, err := NewInboundShipment(tt.pickupPointID, tt.reference, tt.items)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, shipment.IsOpen())
+		})
+	}
+}
+
+func TestInboundShipment_Reconcile(t *testing.T) {
+	t.Parallel()
+	shipment := InboundShipment{
+		Items:   []ShipmentItem{{OrderID: 3}, {OrderID: 1}, {OrderID: 2}},
+		Scanned: []int64{5, 3, 1},
+	}
+
+	received, missing, unexpected := shipment.Reconcile()
+
+	require.Equal(t, []ShipmentItem{{OrderID: 1}, {OrderID: 3}}, received)
+	require.Equal(t, []int64{2}, missing)
+	require.Equal(t, []int64{5}, unexpected)
+}
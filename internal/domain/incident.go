@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxIncidentDescriptionLength limits incident descriptions and resolutions
+// in characters.
+const MaxIncidentDescriptionLength = 2000
+
+type IncidentType string
+
+const (
+	IncidentLost    IncidentType = "lost"
+	IncidentDamaged IncidentType = "damaged"
+)
+
+type IncidentStatus string
+
+const (
+	IncidentOpen      IncidentStatus = "open"
+	IncidentResolved  IncidentStatus = "resolved"
+	IncidentCancelled IncidentStatus = "cancelled"
+)
+
+type IncidentOutcome string
+
+const (
+	OutcomeFound       IncidentOutcome = "found"
+	OutcomeCompensated IncidentOutcome = "compensated"
+	OutcomeWrittenOff  IncidentOutcome = "written_off"
+)
+
+// Incident records that an order was lost or damaged at the point. The order
+// stays blocked until the incident is resolved or cancelled. OrderStatus is
+// the status the order had when it was blocked.
+type Incident struct {
+	ID          int64            `json:"id" db:"id"`
+	OrderID     int64            `json:"order_id" db:"order_id"`
+	Type        IncidentType     `json:"type" db:"type"`
+	Description string           `json:"description" db:"description"`
+	Operator    string           `json:"operator" db:"operator"`
+	Status      IncidentStatus   `json:"status" db:"status"`
+	OrderStatus Status           `json:"order_status" db:"order_status"`
+	Outcome     *IncidentOutcome `json:"outcome,omitempty" db:"outcome"`
+	Resolution  string           `json:"resolution,omitempty" db:"resolution"`
+	ResolvedBy  *string          `json:"resolved_by,omitempty" db:"resolved_by"`
+	CreatedAt   time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at" db:"updated_at"`
+	ResolvedAt  *time.Time       `json:"resolved_at,omitempty" db:"resolved_at"`
+}
+
+// IncidentUpdate changes an open incident, nil fields are kept.
+type IncidentUpdate struct {
+	Type        *IncidentType
+	Description *string
+}
+
+// IncidentFilter narrows an incident search, nil fields match any incident.
+type IncidentFilter struct {
+	OrderID *int64
+	Type    *IncidentType
+	Status  *IncidentStatus
+}
+
+func NewIncident(orderID int64, incidentType IncidentType, description string, operator string) (Incident, error) {
+	if _, err := ParseIncidentType(string(incidentType)); err != nil {
+		return Incident{}, err
+	}
+	description, operator = strings.TrimSpace(description), strings.TrimSpace(operator)
+	if orderID <= 0 || !isValidText(description, MaxIncidentDescriptionLength) ||
+		!isValidText(operator, MaxOperatorNameLength) {
+		return Incident{}, ErrIncidentFieldsAreIncorrect
+	}
+
+	return Incident{
+		OrderID:     orderID,
+		Type:        incidentType,
+		Description: description,
+		Operator:    operator,
+		Status:      IncidentOpen,
+	}, nil
+}
+
+func (i Incident) IsOpen() bool {
+	return i.Status == IncidentOpen
+}
+
+// Apply validates the update and applies it to the incident.
+func (i *Incident) Apply(update IncidentUpdate) error {
+	if !i.IsOpen() {
+		return ErrIncidentIsClosed
+	}
+	if update.Type != nil {
+		if _, err := ParseIncidentType(string(*update.Type)); err != nil {
+			return err
+		}
+		i.Type = *update.Type
+	}
+	if update.Description != nil {
+		description := strings.TrimSpace(*update.Description)
+		if !isValidText(description, MaxIncidentDescriptionLength) {
+			return ErrIncidentFieldsAreIncorrect
+		}
+		i.Description = description
+	}
+
+	return nil
+}
+
+// Resolve ends the incident with the outcome.
+func (i *Incident) Resolve(outcome IncidentOutcome, resolution string, operator string) error {
+	if _, err := ParseIncidentOutcome(string(outcome)); err != nil {
+		return err
+	}
+
+	return i.close(IncidentResolved, &outcome, resolution, operator)
+}
+
+// Cancel withdraws an incident opened by mistake, the order gets back the
+// status it was blocked in.
+func (i *Incident) Cancel(resolution string, operator string) error {
+	return i.close(IncidentCancelled, nil, resolution, operator)
+}
+
+func (i *Incident) close(status IncidentStatus, outcome *IncidentOutcome, resolution string, operator string) error {
+	if !i.IsOpen() {
+		return ErrIncidentIsClosed
+	}
+	resolution, operator = strings.TrimSpace(resolution), strings.TrimSpace(operator)
+	if !isValidText(operator, MaxOperatorNameLength) ||
+		utf8.RuneCountInString(resolution) > MaxIncidentDescriptionLength {
+		return ErrIncidentFieldsAreIncorrect
+	}
+
+	i.Status, i.Outcome, i.Resolution, i.ResolvedBy = status, outcome, resolution, &operator
+
+	return nil
+}
+
+// Action returns the transition that ends an incident with the outcome.
+// Found parcels have none, they get back the status they were blocked in.
+func (o IncidentOutcome) Action() (Action, bool) {
+	switch o {
+	case OutcomeCompensated:
+		return CompensateAction, true
+	case OutcomeWrittenOff:
+		return WriteOffAction, true
+	default:
+		return "", false
+	}
+}
+
+func ParseIncidentType(s string) (IncidentType, error) {
+	switch IncidentType(s) {
+	case IncidentLost, IncidentDamaged:
+		return IncidentType(s), nil
+	default:
+		return "", ErrUnknownIncidentType
+	}
+}
+
+func ParseIncidentStatus(s string) (IncidentStatus, error) {
+	switch IncidentStatus(s) {
+	case IncidentOpen, IncidentResolved, IncidentCancelled:
+		return IncidentStatus(s), nil
+	default:
+		return "", ErrUnknownIncidentStatus
+	}
+}
+
+func ParseIncidentOutcome(s string) (IncidentOutcome, error) {
+	switch IncidentOutcome(s) {
+	case OutcomeFound, OutcomeCompensated, OutcomeWrittenOff:
+		return IncidentOutcome(s), nil
+	default:
+		return "", ErrUnknownIncidentOutcome
+	}
+}
+
+func isValidText(s string, maxLength int) bool {
+	return s != "" && utf8.RuneCountInString(s) <= maxLength
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIncident(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		orderID      int64
+		incidentType IncidentType
+		description  string
+		operator     string
+		wantErr      error
+	}{
+		{name: "valid", orderID: 1, incidentType: IncidentLost, description: "not on the shelf", operator: "Anna"},
+		{name: "unknown type", orderID: 1, incidentType: "stolen", description: "x", operator: "Anna", wantErr: ErrUnknownIncidentType},
+		{name: "no description", orderID: 1, incidentType: IncidentDamaged, description: " ", operator: "Anna", wantErr: ErrIncidentFieldsAreIncorrect},
+		{name: "no operator", orderID: 1, incidentType: IncidentDamaged, description: "torn box", wantErr: ErrIncidentFieldsAreIncorrect},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			incident, err := NewIncident(tt.orderID, tt.incidentType, tt.description, tt.operator)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, incident.IsOpen())
+		})
+	}
+}
+
+func TestIncident_Apply(t *testing.T) {
+	t.Parallel()
+	damaged, description := IncidentDamaged, "box is wet"
+	incident := Incident{Type: IncidentLost, Description: "missing", Status: IncidentOpen}
+
+	require.NoError(t, incident.Apply(IncidentUpdate{Type: &damaged, Description: &description}))
+	require.Equal(t, IncidentDamaged, incident.Type)
+	require.Equal(t, "box is wet", incident.Description)
+
+	incident.Status = IncidentResolved
+	require.ErrorIs(t, incident.Apply(IncidentUpdate{Description: &description}), ErrIncidentIsClosed)
+}
+
+func TestIncident_Resolve(t *testing.T) {
+	t.Parallel()
+	incident := Incident{Status: IncidentOpen}
+
+	require.ErrorIs(t, incident.Resolve("lost forever", "", "Anna"), ErrUnknownIncidentOutcome)
+	require.ErrorIs(t, incident.Resolve(OutcomeFound, "", " "), ErrIncidentFieldsAreIncorrect)
+	require.NoError(t, incident.Resolve(OutcomeFound, "behind the shelf", "Anna"))
+	require.Equal(t, IncidentResolved, incident.Status)
+	require.Equal(t, OutcomeFound, *incident.Outcome)
+	require.ErrorIs(t, incident.Cancel("", "Anna"), ErrIncidentIsClosed)
+}
+
+func TestOrderStateMachine_BlockedOrder(t *testing.T) {
+	t.Parallel()
+	sm := NewOrderStateMachine()
+	order := Order{OrderID: 1, UserID: 10, Status: Blocked}
+
+	for _, action := range []Action{CompleteAction, RefundAction, ReturnAction, BlockAction} {
+		_, err := sm.Fire(order, action, TransitionContext{UserID: 10})
+		require.ErrorIs(t, err, ErrOrderIsBlocked, action)
+	}
+	status, err := sm.Fire(order, WriteOffAction, TransitionContext{})
+	require.NoError(t, err)
+	require.Equal(t, WrittenOff, status)
+}
@@ -4,14 +4,34 @@ import (
 	"time"
 )
 
+// Order.Cost is the final price the customer pays, BaseCost and PackagingCost
+// are its goods and packaging parts. Money fields are stored as amount columns
+// sharing one currency column. StorageCell is the code of the cell the parcel
+// lies in, it is filled only when a single order is looked up, and so is
+// Refund. PickupCode is set only on the order returned right after creation.
+// StorageFee is the fee accrued by an order waiting for its owner since
+// ArrivedAt, the moment it was put on the shelf, it is computed when the order
+// is read.
 type Order struct {
-	OrderID        int64     `db:"order_id"`
-	UserID         int64     `db:"user_id"`
-	ExpirationTime time.Time `db:"expiration_date"`
-	Status         Status    `db:"status"`
-	Weight         int       `db:"weight"`
-	Cost           int       `db:"cost"`
-	LastChangedAt  time.Time `db:"last_changed_at"`
+	OrderID          int64            `db:"order_id"`
+	UserID           int64            `db:"user_id"`
+	ExpirationTime   time.Time        `db:"expiration_date"`
+	Status           Status           `db:"status"`
+	Weight           int              `db:"weight"`
+	Cost             Money            `db:"-"`
+	LastChangedAt    time.Time        `db:"last_changed_at"`
+	ArrivedAt        time.Time        `db:"arrived_at"`
+	Packaging        []PackagingLayer `db:"packaging"`
+	PackageType      PackageType      `db:"package_type"`
+	IsAdditionalFilm bool             `db:"is_additional_film"`
+	BaseCost         Money            `db:"-"`
+	PackagingCost    Money            `db:"-"`
+	PickupPointID    int64            `db:"pickup_point_id"`
+	StorageCell      string           `db:"-"`
+	PickupCode       string           `db:"-" json:"-"`
+	Refund           *RefundDetails   `db:"-" json:",omitempty"`
+	StorageFee       *StorageFee      `db:"-" json:",omitempty"`
+	Dimensions
 }
 
 func NewOrder(
@@ -20,9 +40,9 @@ func NewOrder(
 	expirationTime time.Time,
 	status Status,
 	weight int,
-	cost int,
+	cost Money,
 ) (Order, error) {
-	if weight < 0 || cost < 0 {
+	if weight < 0 || cost.IsNegative() || !cost.Currency.IsValid() {
 		return Order{}, ErrOrderFieldsAreIncorrect
 	}
 
@@ -34,5 +54,44 @@ func NewOrder(
 		Weight:         weight,
 		Cost:           cost,
 		LastChangedAt:  time.Now(),
+		ArrivedAt:      time.Now(),
 	}, nil
 }
+
+// ApplyPackaging stores the packaging layers on the order and splits its cost
+// into the goods and packaging parts. The innermost layer is the package type,
+// film over it is the additional film.
+func (o *Order) ApplyPackaging(layers []PackagingLayer) error {
+	baseCost := o.Cost
+	packagingCost := Zero(baseCost.Currency)
+	var (
+		packageType      PackageType
+		isAdditionalFilm bool
+		err              error
+	)
+
+	for _, layer := range layers {
+		if layer.Position == 0 {
+			packageType = layer.PackageType
+		} else if layer.PackageType == Film {
+			isAdditionalFilm = true
+		}
+		if packagingCost, err = packagingCost.Add(layer.Cost); err != nil {
+			return err
+		}
+	}
+
+	cost, err := baseCost.Add(packagingCost)
+	if err != nil {
+		return err
+	}
+
+	o.Packaging = layers
+	o.PackageType = packageType
+	o.IsAdditionalFilm = isAdditionalFilm
+	o.BaseCost = baseCost
+	o.PackagingCost = packagingCost
+	o.Cost = cost
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Currency is an ISO 4217 alphabetic currency code.
+type Currency string
+
+const (
+	RUB Currency = "RUB"
+	USD Currency = "USD"
+	EUR Currency = "EUR"
+	KZT Currency = "KZT"
+	BYN Currency = "BYN"
+
+	DefaultCurrency = RUB
+)
+
+// currencyExponents is the number of minor units in a major one, e.g. 2 for
+// kopecks in a rouble.
+var currencyExponents = map[Currency]int{
+	RUB: 2,
+	USD: 2,
+	EUR: 2,
+	KZT: 2,
+	BYN: 2,
+}
+
+func (c Currency) IsValid() bool {
+	_, ok := currencyExponents[c]
+
+	return ok
+}
+
+// RoundingMode tells how a fractional amount of minor units is rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds halves away from zero. It is the default for prices.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds halves to the nearest even minor unit.
+	RoundHalfEven
+	// RoundDown truncates towards zero.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+)
+
+// Money is an amount in minor units of its currency. Arithmetic never mixes
+// currencies and fails instead of overflowing.
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+func NewMoney(amount int64, currency Currency) (Money, error) {
+	if !currency.IsValid() {
+		return Money{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Zero returns zero money in the currency.
+func Zero(currency Currency) Money {
+	return Money{Currency: currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	if (other.Amount < 0 && m.Amount > math.MaxInt64+other.Amount) ||
+		(other.Amount > 0 && m.Amount < math.MinInt64+other.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// Mul multiplies the amount by an integer factor, e.g. a daily rate by days.
+func (m Money) Mul(factor int64) (Money, error) {
+	return m.MulRatio(factor, 1, RoundHalfUp)
+}
+
+// MulRatio multiplies the amount by numerator/denominator and rounds the result
+// to whole minor units with the given mode.
+func (m Money) MulRatio(numerator int64, denominator int64, mode RoundingMode) (Money, error) {
+	if denominator == 0 {
+		return Money{}, ErrMoneyDivisionByZero
+	}
+
+	product := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(numerator))
+	den := big.NewInt(denominator)
+	if den.Sign() < 0 {
+		product.Neg(product)
+		den.Neg(den)
+	}
+
+	quo, rem := new(big.Int).QuoRem(product, den, new(big.Int))
+	if rem.Sign() != 0 {
+		quo.Add(quo, roundingAdjustment(quo, rem, den, product.Sign(), mode))
+	}
+	if !quo.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: quo.Int64(), Currency: m.Currency}, nil
+}
+
+// roundingAdjustment returns the step added to the truncated quotient.
+func roundingAdjustment(quo, rem, den *big.Int, sign int, mode RoundingMode) *big.Int {
+	away := big.NewInt(int64(sign))
+	twiceRem := new(big.Int).Abs(rem)
+	twiceRem.Lsh(twiceRem, 1)
+	half := twiceRem.Cmp(den)
+
+	switch mode {
+	case RoundDown:
+		return big.NewInt(0)
+	case RoundUp:
+		return away
+	case RoundHalfEven:
+		if half > 0 || (half == 0 && quo.Bit(0) == 1) {
+			return away
+		}
+	default:
+		if half >= 0 {
+			return away
+		}
+	}
+
+	return big.NewInt(0)
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.Currency != other.Currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return nil
+}
+
+// String formats the amount in major units, e.g. "12.34 RUB".
+func (m Money) String() string {
+	exp := currencyExponents[m.Currency]
+	if exp == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	unit := int64(math.Pow10(exp))
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+	}
+	major, minor := amount/unit, amount%unit
+	if major < 0 {
+		major = -major
+	}
+	if minor < 0 {
+		minor = -minor
+	}
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, major, exp, minor, m.Currency)
+}
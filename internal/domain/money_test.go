@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney_Add(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		a, b    Money
+		want    Money
+		wantErr error
+	}{
+		{"smoke test", Money{100, RUB}, Money{250, RUB}, Money{350, RUB}, nil},
+		{"negative", Money{100, RUB}, Money{-250, RUB}, Money{-150, RUB}, nil},
+		{"currency mismatch", Money{100, RUB}, Money{100, USD}, Money{}, ErrCurrencyMismatch},
+		{"overflow", Money{math.MaxInt64, RUB}, Money{1, RUB}, Money{}, ErrMoneyOverflow},
+		{"underflow", Money{math.MinInt64, RUB}, Money{-1, RUB}, Money{}, ErrMoneyOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.a.Add(tt.b)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_MulRatio(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		amount      int64
+		numerator   int64
+		denominator int64
+		mode        RoundingMode
+		want        int64
+		wantErr     error
+	}{
+		{"exact", 1000, 3, 2, RoundHalfUp, 1500, nil},
+		{"half up", 5, 1, 2, RoundHalfUp, 3, nil},
+		{"half up negative", -5, 1, 2, RoundHalfUp, -3, nil},
+		{"half even down", 5, 1, 2, RoundHalfEven, 2, nil},
+		{"half even up", 7, 1, 2, RoundHalfEven, 4, nil},
+		{"down", 199, 1, 100, RoundDown, 1, nil},
+		{"up", 101, 1, 100, RoundUp, 2, nil},
+		{"negative denominator", 5, 1, -2, RoundHalfUp, -3, nil},
+		{"overflow", math.MaxInt64, 2, 1, RoundHalfUp, 0, ErrMoneyOverflow},
+		{"division by zero", 1, 1, 0, RoundHalfUp, 0, ErrMoneyDivisionByZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Money{tt.amount, RUB}.MulRatio(tt.numerator, tt.denominator, tt.mode)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got.Amount)
+		})
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "12.34 RUB", Money{1234, RUB}.String())
+	require.Equal(t, "-0.05 USD", Money{-5, USD}.String())
+}
@@ -8,118 +8,153 @@ const (
 	Box  PackageType = "box"
 	Bag  PackageType = "bag"
 	Film PackageType = "film"
-)
 
-const (
-	BoxString  string = "box"
-	BagString  string = "bag"
-	FilmString string = "film"
+	// Auto asks the service to pick a package that fits the parcel, see
+	// PackagingCatalog.Recommend.
+	Auto PackageType = "auto"
 )
 
-func GetPackageTypeFromString(s string) (PackageType, error) {
-	switch s {
-	case BoxString:
-		return Box, nil
-	case BagString:
-		return Bag, nil
-	case FilmString:
-		return Film, nil
-	default:
-		return PackageType(rune(0)), fmt.Errorf("unknown package type: %s", s)
-	}
+type Package interface {
+	ValidatePackagedOrder(weight int, dimensions Dimensions) error
+	GetCost() Money
 }
 
-func GetStringFromPackageType(t PackageType) string {
-	switch t {
-	case Box:
-		return BoxString
-	case Bag:
-		return BagString
-	case Film:
-		return FilmString
-	default:
-		return ""
-	}
+// Dimensions of a parcel in centimetres. Zero values mean the size is unknown.
+type Dimensions struct {
+	Length int `json:"length" db:"length"`
+	Width  int `json:"width" db:"width"`
+	Height int `json:"height" db:"height"`
 }
 
-func IsPackageTypeValid(s string) bool {
-	switch s {
-	case BoxString:
-		return true
-	case BagString:
-		return true
-	case FilmString:
-		return true
-	default:
-		return false
+func NewDimensions(length int, width int, height int) (Dimensions, error) {
+	if length < 0 || width < 0 || height < 0 {
+		return Dimensions{}, ErrOrderFieldsAreIncorrect
 	}
-}
 
-type Package interface {
-	ValidatePackagedOrder(weight int) error
-	GetCost() int
+	return Dimensions{Length: length, Width: width, Height: height}, nil
 }
 
-type BoxPackage struct {
+func (d Dimensions) Volume() int {
+	return d.Length * d.Width * d.Height
 }
 
-func (b *BoxPackage) GetCost() int {
-	return 20
+func (d Dimensions) LongestSide() int {
+	return max(d.Length, d.Width, d.Height)
 }
 
-func (b *BoxPackage) ValidatePackagedOrder(weight int) error {
-	const BoxPackageMaxWeight = 30
+// PackageSpec is a packaging catalog entry. Zero limits mean the package has
+// no limit of that kind. MaxVolume is in cubic centimetres, MaxLength is the
+// longest side in centimetres.
+type PackageSpec struct {
+	Name      PackageType `json:"name" db:"name"`
+	MaxWeight int         `json:"max_weight" db:"max_weight"`
+	MaxVolume int         `json:"max_volume" db:"max_volume"`
+	MaxLength int         `json:"max_length" db:"max_length"`
+	Price     Money       `json:"price" db:"-"`
+	CanWrap   bool        `json:"can_wrap" db:"can_wrap"`
+	IsActive  bool        `json:"is_active" db:"is_active"`
+}
 
-	if weight > BoxPackageMaxWeight {
-		return ErrIncorrectWeightForApplyPackage
+func NewPackageSpec(
+	name string,
+	maxWeight int,
+	maxVolume int,
+	maxLength int,
+	price Money,
+	canWrap bool,
+	isActive bool,
+) (PackageSpec, error) {
+	if name == "" || PackageType(name) == Auto || maxWeight < 0 || maxVolume < 0 || maxLength < 0 || price.IsNegative() {
+		return PackageSpec{}, ErrPackageFieldsAreIncorrect
+	}
+	if !price.Currency.IsValid() {
+		return PackageSpec{}, fmt.Errorf("%w: %w", ErrPackageFieldsAreIncorrect, ErrUnknownCurrency)
 	}
 
-	return nil
+	return PackageSpec{
+		Name:      PackageType(name),
+		MaxWeight: maxWeight,
+		MaxVolume: maxVolume,
+		MaxLength: maxLength,
+		Price:     price,
+		CanWrap:   canWrap,
+		IsActive:  isActive,
+	}, nil
 }
 
-type FilmPackage struct {
+// IsContainer reports whether the package has a limit. A package without
+// limits, like film, only wraps a parcel and fits anything.
+func (p PackageSpec) IsContainer() bool {
+	return p.MaxWeight > 0 || p.MaxVolume > 0 || p.MaxLength > 0
 }
 
-func (f FilmPackage) ValidatePackagedOrder(_ int) error {
-	return nil
+func (p PackageSpec) GetCost() Money {
+	return p.Price
 }
 
-func (f FilmPackage) GetCost() int {
-	return 1
+func (p PackageSpec) ValidatePackagedOrder(weight int, dimensions Dimensions) error {
+	if p.MaxWeight > 0 && weight > p.MaxWeight {
+		return ErrIncorrectWeightForApplyPackage
+	}
+	if p.MaxVolume > 0 && dimensions.Volume() > p.MaxVolume {
+		return ErrIncorrectSizeForApplyPackage
+	}
+	if p.MaxLength > 0 && dimensions.LongestSide() > p.MaxLength {
+		return ErrIncorrectSizeForApplyPackage
+	}
+
+	return nil
 }
 
-type BagPackage struct {
+// PackagingLayer is one layer of an order packaging with the price charged for it.
+// Position 0 is the innermost layer.
+type PackagingLayer struct {
+	Position    int         `json:"position"`
+	PackageType PackageType `json:"package_type"`
+	Cost        Money       `json:"cost"`
 }
 
-func (b *BagPackage) GetCost() int {
-	return 5
+// Quote is an itemized price of an order that has not been created yet.
+type Quote struct {
+	BaseCost      Money            `json:"base_cost"`
+	Packaging     []PackagingLayer `json:"packaging"`
+	PackagingCost Money            `json:"packaging_cost"`
+	TotalCost     Money            `json:"total_cost"`
 }
 
-func (b *BagPackage) ValidatePackagedOrder(weight int) error {
-	const MaxWeightForBox = 10
+// CompositionRule forbids Outer package to be wrapped directly around Inner package.
+type CompositionRule struct {
+	Outer PackageType
+	Inner PackageType
+}
 
-	if weight > MaxWeightForBox {
-		return ErrIncorrectWeightForApplyPackage
+// NewPackagingLayers builds layers from the single package plus optional film
+// that clients used to send before layers were supported.
+func NewPackagingLayers(packageType PackageType, isAdditionalFilm bool) []PackageType {
+	var layers []PackageType
+	if packageType != "" {
+		layers = append(layers, packageType)
+	}
+	if isAdditionalFilm {
+		layers = append(layers, Film)
 	}
 
-	return nil
+	return layers
 }
 
-func GetPackagingStrategy(packageType PackageType) (Package, error) {
-	var packageStrategy Package
-
-	switch packageType {
-	case Box:
-		packageStrategy = &BoxPackage{}
-	case Bag:
-		packageStrategy = &BagPackage{}
-	case Film:
-		packageStrategy = &FilmPackage{}
-	case "":
-		break
-	default:
-		return nil, ErrPackageNotExists
+// ValidatePackagingComposition checks layers ordered from the innermost to the outermost.
+func ValidatePackagingComposition(layers []PackageSpec, rules []CompositionRule) error {
+	for i := 1; i < len(layers); i++ {
+		outer, inner := layers[i], layers[i-1]
+		if !outer.CanWrap {
+			return fmt.Errorf("%w: %s", ErrPackageCannotWrap, outer.Name)
+		}
+		for _, rule := range rules {
+			if rule.Outer == outer.Name && rule.Inner == inner.Name {
+				return fmt.Errorf("%w: %s cannot wrap %s", ErrPackagingCompositionNotAllowed, outer.Name, inner.Name)
+			}
+		}
 	}
 
-	return packageStrategy, nil
+	return nil
 }
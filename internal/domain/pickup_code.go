@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// PickupCodeLength is the number of digits in a pickup code.
+const PickupCodeLength = 6
+
+// PickupCodePolicy limits how many wrong codes may be entered in a row before
+// the order is locked for Lockout.
+type PickupCodePolicy struct {
+	MaxAttempts int
+	Lockout     time.Duration
+}
+
+// PickupCode is the one-time code a customer shows to receive an order. Only
+// the hash of the code is stored.
+type PickupCode struct {
+	OrderID        int64      `db:"order_id"`
+	CodeHash       string     `db:"code_hash"`
+	FailedAttempts int        `db:"failed_attempts"`
+	LockedUntil    *time.Time `db:"locked_until"`
+	UsedAt         *time.Time `db:"used_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+// NewPickupCodeValue generates a random numeric code.
+func NewPickupCodeValue() (string, error) {
+	var sb strings.Builder
+	for range PickupCodeLength {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(byte('0' + digit.Int64()))
+	}
+
+	return sb.String(), nil
+}
+
+func (c PickupCode) IsLocked(now time.Time) bool {
+	return c.LockedUntil != nil && now.Before(*c.LockedUntil)
+}
+
+// Verify compares the hash of the entered code with the stored one. A wrong
+// code is counted and locks the code once the policy limit is reached; a
+// right one resets the counter and uses the code up.
+func (c *PickupCode) Verify(hash string, now time.Time, policy PickupCodePolicy) error {
+	if c.UsedAt != nil {
+		return ErrPickupCodeUsed
+	}
+	if c.IsLocked(now) {
+		return ErrPickupCodeLocked
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(c.CodeHash)) != 1 {
+		c.FailedAttempts++
+		if policy.MaxAttempts > 0 && c.FailedAttempts >= policy.MaxAttempts {
+			lockedUntil := now.Add(policy.Lockout)
+			c.LockedUntil = &lockedUntil
+			c.FailedAttempts = 0
+		}
+
+		return ErrPickupCodeInvalid
+	}
+
+	c.Use(now)
+
+	return nil
+}
+
+// Use marks the code as spent, e.g. when a supervisor issues the order without it.
+func (c *PickupCode) Use(now time.Time) {
+	c.FailedAttempts = 0
+	c.LockedUntil = nil
+	c.UsedAt = &now
+}
+
+// PickupOverride records a supervisor issuing an order without its pickup code.
+type PickupOverride struct {
+	EntryID      int64     `json:"entry_id" db:"entry_id"`
+	OrderID      int64     `json:"order_id" db:"order_id"`
+	SupervisorID int64     `json:"supervisor_id" db:"supervisor_id"`
+	Reason       string    `json:"reason" db:"reason"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+func NewPickupOverride(orderID int64, supervisorID int64, reason string) (PickupOverride, error) {
+	if orderID <= 0 || supervisorID <= 0 || strings.TrimSpace(reason) == "" {
+		return PickupOverride{}, ErrPickupOverrideFieldsAreIncorrect
+	}
+
+	return PickupOverride{
+		OrderID:      orderID,
+		SupervisorID: supervisorID,
+		Reason:       reason,
+	}, nil
+}
+
+// PickupCodeReissue records a pickup code replaced for UserID, the owner of
+// the order, by the supervisor SupervisorID.
+type PickupCodeReissue struct {
+	EntryID      int64     `json:"entry_id" db:"entry_id"`
+	OrderID      int64     `json:"order_id" db:"order_id"`
+	UserID       int64     `json:"user_id" db:"user_id"`
+	SupervisorID *int64    `json:"supervisor_id,omitempty" db:"supervisor_id"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// IssuedPickupCode is the plain code of a newly accepted order. It is shown
+// once to whoever accepted the order, only its hash is stored.
+type IssuedPickupCode struct {
+	OrderID    int64  `json:"order_id"`
+	PickupCode string `json:"pickup_code"`
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPickupCodeValue(t *testing.T) {
+	t.Parallel()
+
+	code, err := NewPickupCodeValue()
+
+	require.NoError(t, err)
+	require.Len(t, code, PickupCodeLength)
+	for _, r := range code {
+		require.True(t, r >= '0' && r <= '9')
+	}
+}
+
+func TestPickupCode_Verify(t *testing.T) {
+	t.Parallel()
+	policy := PickupCodePolicy{MaxAttempts: 2, Lockout: time.Hour}
+	now := time.Now()
+
+	t.Run("right code uses it up", func(t *testing.T) {
+		t.Parallel()
+		code := PickupCode{CodeHash: "hash", FailedAttempts: 1}
+
+		require.NoError(t, code.Verify("hash", now, policy))
+		require.NotNil(t, code.UsedAt)
+		require.Zero(t, code.FailedAttempts)
+		require.ErrorIs(t, code.Verify("hash", now, policy), ErrPickupCodeUsed)
+	})
+	t.Run("wrong codes lock it", func(t *testing.T) {
+		t.Parallel()
+		code := PickupCode{CodeHash: "hash"}
+
+		require.ErrorIs(t, code.Verify("wrong", now, policy), ErrPickupCodeInvalid)
+		require.False(t, code.IsLocked(now))
+		require.ErrorIs(t, code.Verify("wrong", now, policy), ErrPickupCodeInvalid)
+		require.True(t, code.IsLocked(now))
+		require.ErrorIs(t, code.Verify("hash", now, policy), ErrPickupCodeLocked)
+		require.NoError(t, code.Verify("hash", now.Add(policy.Lockout), policy))
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	require.JSONEq(t, `{"OrderID":1,"pickup_code":"***"}`, string(redactBody([]byte(`{"OrderID":1,"pickup_code":"123456"}`))))
+	require.Equal(t, `{"OrderID":1}`, string(redactBody([]byte(`{"OrderID":1}`))))
+	require.Equal(t, `not json`, string(redactBody([]byte(`not json`))))
+}
@@ -0,0 +1,96 @@
+package domain
+
+import "time"
+
+// DefaultPickupPointID is the point orders created before multi-point support belong to.
+const DefaultPickupPointID int64 = 1
+
+const workingHoursLayout = "15:04"
+
+// WorkingHours are opening and closing times in the local time of a pickup point.
+type WorkingHours struct {
+	OpensAt  string `json:"opens_at" db:"opens_at"`
+	ClosesAt string `json:"closes_at" db:"closes_at"`
+}
+
+// PickupPoint is a place where customers receive and return orders. Zero
+// OrderExpirationDays means the global setting applies.
+type PickupPoint struct {
+	ID                  int64  `json:"id" db:"id"`
+	Name                string `json:"name" db:"name"`
+	Address             string `json:"address" db:"address"`
+	Timezone            string `json:"timezone" db:"timezone"`
+	OrderExpirationDays int    `json:"order_expiration_days" db:"order_expiration_days"`
+	WorkingHours
+}
+
+func NewPickupPoint(
+	name string,
+	address string,
+	timezone string,
+	opensAt string,
+	closesAt string,
+	orderExpirationDays int,
+) (PickupPoint, error) {
+	if name == "" || address == "" || orderExpirationDays < 0 {
+		return PickupPoint{}, ErrPickupPointFieldsAreIncorrect
+	}
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return PickupPoint{}, ErrPickupPointFieldsAreIncorrect
+	}
+	opens, err := time.Parse(workingHoursLayout, opensAt)
+	if err != nil {
+		return PickupPoint{}, ErrPickupPointFieldsAreIncorrect
+	}
+	closes, err := time.Parse(workingHoursLayout, closesAt)
+	if err != nil || !closes.After(opens) {
+		return PickupPoint{}, ErrPickupPointFieldsAreIncorrect
+	}
+
+	return PickupPoint{
+		Name:                name,
+		Address:             address,
+		Timezone:            timezone,
+		OrderExpirationDays: orderExpirationDays,
+		WorkingHours: WorkingHours{
+			OpensAt:  opensAt,
+			ClosesAt: closesAt,
+		},
+	}, nil
+}
+
+// Location returns the timezone of the point, UTC when it cannot be loaded.
+func (p PickupPoint) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// IsOpen tells whether the point works at the moment t.
+func (p PickupPoint) IsOpen(t time.Time) bool {
+	opens, err := time.Parse(workingHoursLayout, p.OpensAt)
+	if err != nil {
+		return false
+	}
+	closes, err := time.Parse(workingHoursLayout, p.ClosesAt)
+	if err != nil {
+		return false
+	}
+
+	local := t.In(p.Location())
+	minutes := local.Hour()*60 + local.Minute()
+
+	return minutes >= opens.Hour()*60+opens.Minute() && minutes < closes.Hour()*60+closes.Minute()
+}
+
+// ExpirationDays returns the point setting or defaultDays when the point has none.
+func (p PickupPoint) ExpirationDays(defaultDays int) int {
+	if p.OrderExpirationDays > 0 {
+		return p.OrderExpirationDays
+	}
+
+	return defaultDays
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPickupPoint(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		timezone string
+		opensAt  string
+		closesAt string
+		wantErr  error
+	}{
+		{"smoke test", "Europe/Moscow", "09:00", "21:00", nil},
+		{"unknown timezone", "Mars/Olympus", "09:00", "21:00", ErrPickupPointFieldsAreIncorrect},
+		{"empty timezone", "", "09:00", "21:00", ErrPickupPointFieldsAreIncorrect},
+		{"bad hours", "UTC", "9am", "21:00", ErrPickupPointFieldsAreIncorrect},
+		{"closes before opening", "UTC", "21:00", "09:00", ErrPickupPointFieldsAreIncorrect},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewPickupPoint("north", "Lenina 1", tt.timezone, tt.opensAt, tt.closesAt, 0)
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPickupPoint_IsOpen(t *testing.T) {
+	t.Parallel()
+	point, err := NewPickupPoint("north", "Lenina 1", "Europe/Moscow", "09:00", "21:00", 0)
+	require.NoError(t, err)
+
+	// 06:30 UTC is 09:30 in Moscow.
+	require.True(t, point.IsOpen(time.Date(2025, 4, 17, 6, 30, 0, 0, time.UTC)))
+	require.False(t, point.IsOpen(time.Date(2025, 4, 17, 18, 30, 0, 0, time.UTC)))
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// MaxRefundCommentLength limits the free-text comment in characters.
+const MaxRefundCommentLength = 1000
+
+// ItemCondition is the state a refunded item came back in.
+type ItemCondition string
+
+const (
+	Intact  ItemCondition = "intact"
+	Opened  ItemCondition = "opened"
+	Damaged ItemCondition = "damaged"
+)
+
+func (c ItemCondition) IsValid() bool {
+	switch c {
+	case Intact, Opened, Damaged:
+		return true
+	default:
+		return false
+	}
+}
+
+// RefundReason is an entry of the refund reasons catalog.
+type RefundReason struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// RefundDetails tell why the customer returned the order and what state it
+// came back in. They are kept for dispute handling.
+type RefundDetails struct {
+	OrderID    int64         `json:"order_id,omitempty" db:"order_id"`
+	ReasonCode string        `json:"reason_code" db:"reason_code"`
+	Comment    string        `json:"comment,omitempty" db:"comment"`
+	Condition  ItemCondition `json:"condition" db:"item_condition"`
+	CreatedAt  time.Time     `json:"created_at,omitempty" db:"created_at"`
+}
+
+// NewRefundDetails checks the fields that do not depend on the reasons
+// catalog, the reason code itself is checked by the service.
+func NewRefundDetails(reasonCode string, comment string, condition string) (RefundDetails, error) {
+	if reasonCode == "" {
+		return RefundDetails{}, ErrRefundReasonRequired
+	}
+	if !ItemCondition(condition).IsValid() {
+		return RefundDetails{}, ErrUnknownItemCondition
+	}
+	if utf8.RuneCountInString(comment) > MaxRefundCommentLength {
+		return RefundDetails{}, ErrRefundDetailsAreIncorrect
+	}
+
+	return RefundDetails{
+		ReasonCode: reasonCode,
+		Comment:    comment,
+		Condition:  ItemCondition(condition),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRefundDetails(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		reason    string
+		comment   string
+		condition string
+		wantErr   error
+	}{
+		{name: "valid", reason: "defective", comment: "screen is cracked", condition: "damaged"},
+		{name: "comment is optional", reason: "changed_mind", condition: "intact"},
+		{name: "reason is required", condition: "opened", wantErr: ErrRefundReasonRequired},
+		{name: "unknown condition", reason: "defective", condition: "broken", wantErr: ErrUnknownItemCondition},
+		{
+			name:      "comment too long",
+			reason:    "defective",
+			comment:   strings.Repeat("a", MaxRefundCommentLength+1),
+			condition: "damaged",
+			wantErr:   ErrRefundDetailsAreIncorrect,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			details, err := NewRefundDetails(tt.reason, tt.comment, tt.condition)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ItemCondition(tt.condition), details.Condition)
+			require.Equal(t, tt.reason, details.ReasonCode)
+		})
+	}
+}
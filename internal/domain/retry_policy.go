@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// DefaultRetryPolicy is used for task types without a policy of their own.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   2 * time.Second,
+	Multiplier:  2,
+	Jitter:      0.1,
+	MaxDelay:    time.Minute,
+}
+
+// RetryPolicy sets how often and when a failed outbox task is tried again.
+// The delay after the n-th failed attempt is BaseDelay * Multiplier^(n-1),
+// capped by MaxDelay and spread by up to Jitter of itself either way.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	Multiplier  float64
+	Jitter      float64
+	MaxDelay    time.Duration
+}
+
+// RetryPolicies maps task types to their policies.
+type RetryPolicies map[TaskType]RetryPolicy
+
+func NewRetryPolicy(
+	maxAttempts int,
+	baseDelay time.Duration,
+	multiplier float64,
+	jitter float64,
+	maxDelay time.Duration,
+) (RetryPolicy, error) {
+	if maxAttempts <= 0 || baseDelay <= 0 || multiplier < 1 || jitter < 0 || jitter > 1 || maxDelay < baseDelay {
+		return RetryPolicy{}, ErrRetryPolicyIsIncorrect
+	}
+
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   baseDelay,
+		Multiplier:  multiplier,
+		Jitter:      jitter,
+		MaxDelay:    maxDelay,
+	}, nil
+}
+
+// Exhausted reports whether a task that failed attempts times is dead.
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return attempts >= p.MaxAttempts
+}
+
+// Delay returns how long to wait after the attempts-th failed attempt. random
+// is in [0, 1) and picks the jitter, 0.5 gives the delay without jitter.
+func (p RetryPolicy) Delay(attempts int, random float64) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := math.Min(float64(p.BaseDelay)*math.Pow(p.Multiplier, float64(attempts-1)), float64(p.MaxDelay))
+	delay += delay * p.Jitter * (2*random - 1)
+
+	return time.Duration(math.Min(delay, float64(p.MaxDelay)))
+}
+
+// For returns the policy of the task type or the default one.
+func (p RetryPolicies) For(taskType TaskType) RetryPolicy {
+	if policy, ok := p[taskType]; ok {
+		return policy
+	}
+
+	return DefaultRetryPolicy
+}
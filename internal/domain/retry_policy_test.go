@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+	policy, err := NewRetryPolicy(5, time.Second, 2, 0.5, 10*time.Second)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		attempts int
+		random   float64
+		delay    time.Duration
+	}{
+		{"first retry without jitter", 1, 0.5, time.Second},
+		{"grows by multiplier", 3, 0.5, 4 * time.Second},
+		{"capped by max delay", 6, 0.5, 10 * time.Second},
+		{"jitter shortens", 2, 0, time.Second},
+		{"jitter lengthens", 2, 1, 3 * time.Second},
+		{"jitter never passes max delay", 5, 1, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.delay, policy.Delay(tt.attempts, tt.random))
+		})
+	}
+}
+
+func TestRetryPolicy_Exhausted(t *testing.T) {
+	t.Parallel()
+	policies := RetryPolicies{AuditLog: {MaxAttempts: 1}}
+
+	require.True(t, policies.For(AuditLog).Exhausted(1))
+	require.False(t, policies.For(OrderStatusLog).Exhausted(1))
+	require.True(t, policies.For(OrderStatusLog).Exhausted(DefaultRetryPolicy.MaxAttempts))
+}
+
+func TestNewRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRetryPolicy(0, time.Second, 2, 0, time.Minute)
+	require.ErrorIs(t, err, ErrRetryPolicyIsIncorrect)
+	_, err = NewRetryPolicy(3, time.Second, 0.5, 0, time.Minute)
+	require.ErrorIs(t, err, ErrRetryPolicyIsIncorrect)
+	_, err = NewRetryPolicy(3, time.Second, 2, 1.5, time.Minute)
+	require.ErrorIs(t, err, ErrRetryPolicyIsIncorrect)
+	_, err = NewRetryPolicy(3, time.Minute, 2, 0, time.Second)
+	require.ErrorIs(t, err, ErrRetryPolicyIsIncorrect)
+	_, err = NewRetryPolicy(3, time.Second, 1, 0, time.Second)
+	require.NoError(t, err)
+}
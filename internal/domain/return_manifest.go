@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxCourierNameLength limits the courier name in characters.
+const MaxCourierNameLength = 255
+
+type ManifestStatus string
+
+const (
+	ManifestOpen   ManifestStatus = "open"
+	ManifestClosed ManifestStatus = "closed"
+)
+
+// ReturnableStatuses are the statuses of orders a courier takes back to the
+// warehouse.
+var ReturnableStatuses = []Status{Refunded, AwaitingReturn}
+
+// ReturnManifest lists orders handed back to a courier together. Items are
+// scanned by staff one by one, only scanned items leave with the courier when
+// the manifest is closed.
+type ReturnManifest struct {
+	ID            int64                `json:"id" db:"id"`
+	PickupPointID int64                `json:"pickup_point_id" db:"pickup_point_id"`
+	CourierName   string               `json:"courier_name" db:"courier_name"`
+	Status        ManifestStatus       `json:"status" db:"status"`
+	CreatedAt     time.Time            `json:"created_at" db:"created_at"`
+	ClosedAt      *time.Time           `json:"closed_at,omitempty" db:"closed_at"`
+	Items         []ReturnManifestItem `json:"items" db:"-"`
+}
+
+// ReturnManifestItem is an order of a manifest. UserID and OrderStatus are
+// taken when the manifest is created, the order itself is archived on close.
+type ReturnManifestItem struct {
+	ManifestID  int64      `json:"-" db:"manifest_id"`
+	OrderID     int64      `json:"order_id" db:"order_id"`
+	UserID      int64      `json:"user_id" db:"user_id"`
+	OrderStatus Status     `json:"order_status" db:"order_status"`
+	ScannedAt   *time.Time `json:"scanned_at,omitempty" db:"scanned_at"`
+}
+
+func NewReturnManifest(pickupPointID int64, courierName string) (ReturnManifest, error) {
+	courierName = strings.TrimSpace(courierName)
+	if pickupPointID <= 0 || courierName == "" || utf8.RuneCountInString(courierName) > MaxCourierNameLength {
+		return ReturnManifest{}, ErrReturnManifestFieldsAreIncorrect
+	}
+
+	return ReturnManifest{
+		PickupPointID: pickupPointID,
+		CourierName:   courierName,
+		Status:        ManifestOpen,
+	}, nil
+}
+
+func (m ReturnManifest) IsOpen() bool {
+	return m.Status == ManifestOpen
+}
+
+func (m ReturnManifest) HasOrder(orderID int64) bool {
+	for _, item := range m.Items {
+		if item.OrderID == orderID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Scanned returns the ids of the orders confirmed by staff.
+func (m ReturnManifest) Scanned() []int64 {
+	var ids []int64
+	for _, item := range m.Items {
+		if item.ScannedAt != nil {
+			ids = append(ids, item.OrderID)
+		}
+	}
+
+	return ids
+}
+
+// ManifestFilter narrows a manifest search, nil fields match any manifest.
+type ManifestFilter struct {
+	PickupPointID *int64
+	Status        *ManifestStatus
+}
+
+// ExportFormat is the format manifests are exported in.
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportJSON ExportFormat = "json"
+)
+
+// ParseExportFormat treats an empty format as JSON.
+func ParseExportFormat(s string) (ExportFormat, error) {
+	switch ExportFormat(strings.ToLower(s)) {
+	case "", ExportJSON:
+		return ExportJSON, nil
+	case ExportCSV:
+		return ExportCSV, nil
+	default:
+		return "", ErrUnknownExportFormat
+	}
+}
+
+func (f ExportFormat) ContentType() string {
+	if f == ExportCSV {
+		return "text/csv"
+	}
+
+	return "application/json"
+}
+
+func ParseManifestStatus(s string) (ManifestStatus, error) {
+	switch ManifestStatus(s) {
+	case ManifestOpen, ManifestClosed:
+		return ManifestStatus(s), nil
+	default:
+		return "", ErrUnknownManifestStatus
+	}
+}
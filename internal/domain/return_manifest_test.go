@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReturnManifest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		pickupPointID int64
+		courierName   string
+		wantErr       error
+	}{
+		{name: "valid", pickupPointID: 1, courierName: "Ivan Petrov"},
+		{name: "no pickup point", courierName: "Ivan Petrov", wantErr: ErrReturnManifestFieldsAreIncorrect},
+		{name: "blank courier", pickupPointID: 1, courierName: "  ", wantErr: ErrReturnManifestFieldsAreIncorrect},
+		{
+			name:          "courier name too long",
+			pickupPointID: 1,
+			courierName:   strings.Repeat("a", MaxCourierNameLength+1),
+			wantErr:       ErrReturnManifestFieldsAreIncorrect,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifest, err := NewReturnManifest(tt.pickupPointID, tt.courierName)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, manifest.IsOpen())
+			require.Equal(t, tt.courierName, manifest.CourierName)
+		})
+	}
+}
+
+func TestReturnManifest_Scanned(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	manifest := ReturnManifest{Items: []ReturnManifestItem{
+		{OrderID: 1, ScannedAt: &now},
+		{OrderID: 2},
+		{OrderID: 3, ScannedAt: &now},
+	}}
+
+	require.Equal(t, []int64{1, 3}, manifest.Scanned())
+	require.True(t, manifest.HasOrder(2))
+	require.False(t, manifest.HasOrder(4))
+}
+
+func TestParseExportFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := ParseExportFormat("")
+	require.NoError(t, err)
+	require.Equal(t, ExportJSON, format)
+
+	format, err = ParseExportFormat("CSV")
+	require.NoError(t, err)
+	require.Equal(t, ExportCSV, format)
+
+	_, err = ParseExportFormat("xml")
+	require.ErrorIs(t, err, ErrUnknownExportFormat)
+}
@@ -0,0 +1,174 @@
+package domain
+
+import "time"
+
+type Action string
+
+const (
+	PlaceAction    Action = "place"
+	CompleteAction Action = "complete"
+	RefundAction   Action = "refund"
+	ReturnAction   Action = "return"
+	ExpireAction   Action = "expire"
+	// BlockAction opens an incident, CompensateAction and WriteOffAction
+	// resolve it. A found parcel goes back to the status it was blocked in,
+	// which depends on the incident, so that move is not part of the table.
+	BlockAction      Action = "block"
+	CompensateAction Action = "compensate"
+	WriteOffAction   Action = "write_off"
+)
+
+// TransitionContext carries everything a guard may need besides the order itself.
+type TransitionContext struct {
+	UserID       int64
+	Now          time.Time
+	RefundPeriod time.Duration
+}
+
+// Guard decides whether a transition may happen and explains why not.
+type Guard func(order Order, tc TransitionContext) error
+
+type Transition struct {
+	From   Status
+	Action Action
+	To     Status
+	Guards []Guard
+}
+
+// rejection describes the error returned when an action is not declared for a
+// state. Checks run first so that the most specific reason wins.
+type rejection struct {
+	From   Status
+	Action Action
+	Checks []Guard
+	Err    error
+}
+
+var orderTransitions = []Transition{
+	{From: Accepted, Action: PlaceAction, To: Confirmed},
+	{From: Confirmed, Action: CompleteAction, To: Completed, Guards: []Guard{OwnedByUser, NotExpired}},
+	{From: Confirmed, Action: ExpireAction, To: AwaitingReturn, Guards: []Guard{Expired}},
+	{From: Completed, Action: RefundAction, To: Refunded, Guards: []Guard{WithinRefundPeriod}},
+	{From: Refunded, Action: ReturnAction, To: ReturnedToCourier},
+	{From: AwaitingReturn, Action: ReturnAction, To: ReturnedToCourier},
+	{From: Confirmed, Action: BlockAction, To: Blocked},
+	{From: Refunded, Action: BlockAction, To: Blocked},
+	{From: AwaitingReturn, Action: BlockAction, To: Blocked},
+	{From: Blocked, Action: CompensateAction, To: Compensated},
+	{From: Blocked, Action: WriteOffAction, To: WrittenOff},
+}
+
+var orderRejections = []rejection{
+	{From: Completed, Action: CompleteAction, Err: ErrOrderAlreadyCompleted},
+	{From: Completed, Action: ReturnAction, Err: ErrOrderAlreadyCompleted},
+	{From: AwaitingReturn, Action: CompleteAction, Err: ErrExpirationDateInPast},
+	{From: Confirmed, Action: ReturnAction, Checks: []Guard{Expired}, Err: ErrOrderHasToBeRefunded},
+	{From: Accepted, Action: ReturnAction, Err: ErrOrderHasToBeRefunded},
+	{From: Blocked, Action: CompleteAction, Err: ErrOrderIsBlocked},
+	{From: Blocked, Action: RefundAction, Err: ErrOrderIsBlocked},
+	{From: Blocked, Action: ReturnAction, Err: ErrOrderIsBlocked},
+	{From: Blocked, Action: BlockAction, Err: ErrOrderIsBlocked},
+	{Action: RefundAction, Err: ErrOrderNotCompleted},
+}
+
+func OwnedByUser(order Order, tc TransitionContext) error {
+	if order.UserID != tc.UserID {
+		return ErrOrderNotBelongToUser
+	}
+
+	return nil
+}
+
+func NotExpired(order Order, tc TransitionContext) error {
+	if order.ExpirationTime.Before(tc.Now) {
+		return ErrExpirationDateInPast
+	}
+
+	return nil
+}
+
+func Expired(order Order, tc TransitionContext) error {
+	if order.ExpirationTime.After(tc.Now) {
+		return ErrExpirationDateInFuture
+	}
+
+	return nil
+}
+
+func WithinRefundPeriod(order Order, tc TransitionContext) error {
+	if tc.Now.After(order.LastChangedAt.Add(tc.RefundPeriod)) {
+		return ErrOrderCannotBeRefunded
+	}
+
+	return nil
+}
+
+type OrderStateMachine struct {
+	transitions map[Status][]Transition
+	rejections  []rejection
+}
+
+func NewOrderStateMachine() *OrderStateMachine {
+	sm := &OrderStateMachine{
+		transitions: make(map[Status][]Transition),
+		rejections:  orderRejections,
+	}
+	for _, t := range orderTransitions {
+		sm.transitions[t.From] = append(sm.transitions[t.From], t)
+	}
+
+	return sm
+}
+
+// Fire checks that action is allowed for the order and returns the status the order moves to.
+func (sm *OrderStateMachine) Fire(order Order, action Action, tc TransitionContext) (Status, error) {
+	for _, t := range sm.transitions[order.Status] {
+		if t.Action != action {
+			continue
+		}
+		if err := runGuards(t.Guards, order, tc); err != nil {
+			return order.Status, err
+		}
+
+		return t.To, nil
+	}
+
+	return order.Status, sm.reject(order, action, tc)
+}
+
+// AllowedTransitions lists transitions whose guards currently pass for the order.
+func (sm *OrderStateMachine) AllowedTransitions(order Order, tc TransitionContext) []Transition {
+	var allowed []Transition
+	for _, t := range sm.transitions[order.Status] {
+		if runGuards(t.Guards, order, tc) == nil {
+			allowed = append(allowed, t)
+		}
+	}
+
+	return allowed
+}
+
+func (sm *OrderStateMachine) reject(order Order, action Action, tc TransitionContext) error {
+	for _, r := range sm.rejections {
+		if r.Action != action || (r.From != "" && r.From != order.Status) {
+			continue
+		}
+		if err := runGuards(r.Checks, order, tc); err != nil {
+			return err
+		}
+
+		return r.Err
+	}
+
+	return ErrTransitionNotAllowed
+}
+
+func runGuards(guards []Guard, order Order, tc TransitionContext) error {
+	for _, g := range guards {
+		if err := g(order, tc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
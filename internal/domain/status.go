@@ -7,32 +7,39 @@ import (
 
 type Status string
 
+// Confirmed is the "ready for pickup" state and Completed is the "issued to
+// customer" state; both keep their historical names because they are stored
+// in the orders table and exposed through the API. AwaitingReturn is a
+// confirmed order nobody picked up before it expired. Blocked is an order
+// with an open incident, WrittenOff and Compensated end lost or damaged ones.
 const (
-	Confirmed Status = "confirmed"
-	Completed Status = "completed"
-	Refunded  Status = "refunded"
+	Accepted          Status = "accepted"
+	Confirmed         Status = "confirmed"
+	AwaitingReturn    Status = "awaiting_return"
+	Completed         Status = "completed"
+	Refunded          Status = "refunded"
+	ReturnedToCourier Status = "returned_to_courier"
+	Archived          Status = "archived"
+	Blocked           Status = "blocked"
+	WrittenOff        Status = "written_off"
+	Compensated       Status = "compensated"
 )
 
 const (
-	ConfirmedString string = "confirmed"
-	CompletedString string = "completed"
-	RefundedString  string = "refunded"
+	AcceptedString          string = "accepted"
+	ConfirmedString         string = "confirmed"
+	AwaitingReturnString    string = "awaiting_return"
+	CompletedString         string = "completed"
+	RefundedString          string = "refunded"
+	ReturnedToCourierString string = "returned_to_courier"
+	ArchivedString          string = "archived"
+	BlockedString           string = "blocked"
+	WrittenOffString        string = "written_off"
+	CompensatedString       string = "compensated"
 )
 
 func NewStatusFromString(s string) (Status, error) {
-	var state Status
-	switch s {
-	case ConfirmedString:
-		state = Confirmed
-	case CompletedString:
-		state = Completed
-	case RefundedString:
-		state = Refunded
-	default:
-		return "", fmt.Errorf("unknown status string: %s", s)
-	}
-
-	return state, nil
+	return GetStatusTypeFromString(s)
 }
 
 func (s *Status) String() string {
@@ -40,27 +47,34 @@ func (s *Status) String() string {
 }
 
 func IsStatusValid(statusString string) bool {
-	switch statusString {
-	case ConfirmedString:
-		return true
-	case CompletedString:
-		return true
-	case RefundedString:
-		return true
-	default:
-		return false
-	}
+	_, err := GetStatusTypeFromString(statusString)
+
+	return err == nil
 }
 
 func GetStatusTypeFromString(s string) (Status, error) {
 	var state Status
 	switch s {
+	case AcceptedString:
+		state = Accepted
 	case ConfirmedString:
 		state = Confirmed
+	case AwaitingReturnString:
+		state = AwaitingReturn
 	case CompletedString:
 		state = Completed
 	case RefundedString:
 		state = Refunded
+	case ReturnedToCourierString:
+		state = ReturnedToCourier
+	case ArchivedString:
+		state = Archived
+	case BlockedString:
+		state = Blocked
+	case WrittenOffString:
+		state = WrittenOff
+	case CompensatedString:
+		state = Compensated
 	default:
 		return "", fmt.Errorf("unknown status string: %s", s)
 	}
@@ -70,12 +84,26 @@ func GetStatusTypeFromString(s string) (Status, error) {
 
 func GetStringFromStatus(s Status) string {
 	switch s {
+	case Accepted:
+		return AcceptedString
 	case Confirmed:
 		return ConfirmedString
+	case AwaitingReturn:
+		return AwaitingReturnString
 	case Completed:
 		return CompletedString
 	case Refunded:
 		return RefundedString
+	case ReturnedToCourier:
+		return ReturnedToCourierString
+	case Archived:
+		return ArchivedString
+	case Blocked:
+		return BlockedString
+	case WrittenOff:
+		return WrittenOffString
+	case Compensated:
+		return CompensatedString
 	default:
 		return ""
 	}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxOperatorNameLength limits the operator name in characters.
+const MaxOperatorNameLength = 255
+
+// StoredStatuses are the statuses of orders that are kept on the shelves of
+// the point. Blocked orders are not among them, a blocked parcel may be lost
+// as well as damaged and kept.
+var StoredStatuses = []Status{Confirmed, Refunded, AwaitingReturn}
+
+type StocktakingStatus string
+
+const (
+	StocktakingOpen     StocktakingStatus = "open"
+	StocktakingFinished StocktakingStatus = "finished"
+)
+
+// StocktakingSession is a count of the parcels on the shelves of a point.
+// Staff scan every parcel they find, the result is computed on finish.
+type StocktakingSession struct {
+	ID            int64              `json:"id" db:"id"`
+	PickupPointID int64              `json:"pickup_point_id" db:"pickup_point_id"`
+	Operator      string             `json:"operator" db:"operator"`
+	Status        StocktakingStatus  `json:"status" db:"status"`
+	StartedAt     time.Time          `json:"started_at" db:"started_at"`
+	FinishedAt    *time.Time         `json:"finished_at,omitempty" db:"finished_at"`
+	ScannedCount  int                `json:"scanned_count" db:"scanned_count"`
+	Result        *StocktakingResult `json:"result,omitempty" db:"-"`
+}
+
+// StocktakingResult compares the scanned parcels with the stored orders of
+// the point. Missing orders are stored but were not found on the shelves,
+// unknown parcels were found but are not stored at the point. Blocked orders
+// are covered by their incidents and are neither missing nor unknown.
+type StocktakingResult struct {
+	Expected int     `json:"expected"`
+	Found    int     `json:"found"`
+	Missing  []int64 `json:"missing"`
+	Unknown  []int64 `json:"unknown"`
+}
+
+func NewStocktakingSession(pickupPointID int64, operator string) (StocktakingSession, error) {
+	operator = strings.TrimSpace(operator)
+	if pickupPointID <= 0 || operator == "" || utf8.RuneCountInString(operator) > MaxOperatorNameLength {
+		return StocktakingSession{}, ErrStocktakingFieldsAreIncorrect
+	}
+
+	return StocktakingSession{
+		PickupPointID: pickupPointID,
+		Operator:      operator,
+		Status:        StocktakingOpen,
+	}, nil
+}
+
+func (s StocktakingSession) IsOpen() bool {
+	return s.Status == StocktakingOpen
+}
+
+// NewStocktakingResult diffs the stored order ids against the scanned ones,
+// scanned blocked orders are left out. Both lists of the result are sorted.
+func NewStocktakingResult(stored []int64, blocked []int64, scanned []int64) StocktakingResult {
+	found := make(map[int64]struct{}, len(scanned))
+	for _, id := range scanned {
+		found[id] = struct{}{}
+	}
+	for _, id := range blocked {
+		delete(found, id)
+	}
+	expected := make(map[int64]struct{}, len(stored))
+	result := StocktakingResult{Missing: []int64{}, Unknown: []int64{}}
+	for _, id := range stored {
+		if _, ok := expected[id]; ok {
+			continue
+		}
+		expected[id] = struct{}{}
+		if _, ok := found[id]; ok {
+			result.Found++
+		} else {
+			result.Missing = append(result.Missing, id)
+		}
+	}
+	for id := range found {
+		if _, ok := expected[id]; !ok {
+			result.Unknown = append(result.Unknown, id)
+		}
+	}
+	result.Expected = len(expected)
+
+	sort.Slice(result.Missing, func(i, j int) bool { return result.Missing[i] < result.Missing[j] })
+	sort.Slice(result.Unknown, func(i, j int) bool { return result.Unknown[i] < result.Unknown[j] })
+
+	return result
+}
+
+// StocktakingFilter narrows a session search, nil fields match any session.
+type StocktakingFilter struct {
+	PickupPointID *int64
+	Status        *StocktakingStatus
+}
+
+func ParseStocktakingStatus(s string) (StocktakingStatus, error) {
+	switch StocktakingStatus(s) {
+	case StocktakingOpen, StocktakingFinished:
+		return StocktakingStatus(s), nil
+	default:
+		return "", ErrUnknownStocktakingStatus
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStocktakingSession(t *testing.T) {
+	t.Parallel()
+
+	session, err := NewStocktakingSession(1, " Anna ")
+	require.NoError(t, err)
+	require.True(t, session.IsOpen())
+	require.Equal(t, "Anna", session.Operator)
+
+	_, err = NewStocktakingSession(1, "")
+	require.ErrorIs(t, err, ErrStocktakingFieldsAreIncorrect)
+
+	_, err = NewStocktakingSession(0, "Anna")
+	require.ErrorIs(t, err, ErrStocktakingFieldsAreIncorrect)
+}
+
+func TestNewStocktakingResult(t *testing.T) {
+	t.Parallel()
+
+	result := NewStocktakingResult([]int64{4, 1, 2, 3}, []int64{8, 9}, []int64{3, 7, 1, 5, 8})
+
+	require.Equal(t, StocktakingResult{
+		Expected: 4,
+		Found:    2,
+		Missing:  []int64{2, 4},
+		Unknown:  []int64{5, 7},
+	}, result)
+}
@@ -0,0 +1,70 @@
+package domain
+
+// StorageCell is a shelf or a cell of a pickup point that holds one parcel.
+// Zero limits mean the cell has no limit of that kind. OrderID is nil while
+// the cell is free.
+type StorageCell struct {
+	ID            int64  `json:"id" db:"id"`
+	PickupPointID int64  `json:"pickup_point_id" db:"pickup_point_id"`
+	Code          string `json:"code" db:"code"`
+	MaxWeight     int    `json:"max_weight" db:"max_weight"`
+	MaxVolume     int    `json:"max_volume" db:"max_volume"`
+	MaxLength     int    `json:"max_length" db:"max_length"`
+	OrderID       *int64 `json:"order_id,omitempty" db:"order_id"`
+}
+
+func NewStorageCell(
+	pickupPointID int64,
+	code string,
+	maxWeight int,
+	maxVolume int,
+	maxLength int,
+) (StorageCell, error) {
+	if pickupPointID <= 0 || code == "" || maxWeight < 0 || maxVolume < 0 || maxLength < 0 {
+		return StorageCell{}, ErrStorageCellFieldsAreIncorrect
+	}
+
+	return StorageCell{
+		PickupPointID: pickupPointID,
+		Code:          code,
+		MaxWeight:     maxWeight,
+		MaxVolume:     maxVolume,
+		MaxLength:     maxLength,
+	}, nil
+}
+
+func (c StorageCell) IsFree() bool {
+	return c.OrderID == nil
+}
+
+// Fits tells whether a parcel of the weight and size can be put into the cell.
+func (c StorageCell) Fits(weight int, dimensions Dimensions) bool {
+	if c.MaxWeight > 0 && weight > c.MaxWeight {
+		return false
+	}
+	if c.MaxVolume > 0 && dimensions.Volume() > c.MaxVolume {
+		return false
+	}
+	if c.MaxLength > 0 && dimensions.LongestSide() > c.MaxLength {
+		return false
+	}
+
+	return true
+}
+
+// StorageOccupancy is the number of cells of a pickup point and how many of
+// them hold a parcel.
+type StorageOccupancy struct {
+	PickupPointID int64 `json:"pickup_point_id" db:"pickup_point_id"`
+	Total         int   `json:"total" db:"total"`
+	Occupied      int   `json:"occupied" db:"occupied"`
+}
+
+// FillRatio is the share of occupied cells, zero for a point without cells.
+func (o StorageOccupancy) FillRatio() float64 {
+	if o.Total == 0 {
+		return 0
+	}
+
+	return float64(o.Occupied) / float64(o.Total)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageCell_Fits(t *testing.T) {
+	t.Parallel()
+	cell := StorageCell{MaxWeight: 10, MaxVolume: 27000, MaxLength: 40}
+	tests := []struct {
+		name       string
+		cell       StorageCell
+		weight     int
+		dimensions Dimensions
+		want       bool
+	}{
+		{"smoke test", cell, 5, Dimensions{Length: 30, Width: 30, Height: 30}, true},
+		{"unknown size", cell, 5, Dimensions{}, true},
+		{"too heavy", cell, 11, Dimensions{}, false},
+		{"too big", cell, 5, Dimensions{Length: 31, Width: 30, Height: 30}, false},
+		{"too long", cell, 5, Dimensions{Length: 41, Width: 1, Height: 1}, false},
+		{"no limits", StorageCell{}, 1000, Dimensions{Length: 1000, Width: 1000, Height: 1000}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, tt.cell.Fits(tt.weight, tt.dimensions))
+		})
+	}
+}
+
+func TestStorageOccupancy_FillRatio(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0.0, StorageOccupancy{}.FillRatio())
+	require.Equal(t, 0.25, StorageOccupancy{Total: 4, Occupied: 1}.FillRatio())
+}
@@ -0,0 +1,114 @@
+package domain
+
+import "time"
+
+// MaxRevenuePeriodDays limits how many days one revenue report covers.
+const MaxRevenuePeriodDays = 366
+
+// StorageTariff charges DailyRate for every started day an order waits for
+// its owner beyond FreeDays. Nil PickupPointID is the global tariff.
+type StorageTariff struct {
+	PickupPointID *int64 `json:"pickup_point_id,omitempty" db:"pickup_point_id"`
+	FreeDays      int    `json:"free_days" db:"free_days"`
+	DailyRate     Money  `json:"daily_rate" db:"-"`
+}
+
+func NewStorageTariff(pickupPointID *int64, freeDays int, dailyRate Money) (StorageTariff, error) {
+	if freeDays < 0 || dailyRate.IsNegative() || !dailyRate.Currency.IsValid() {
+		return StorageTariff{}, ErrStorageTariffFieldsAreIncorrect
+	}
+	if pickupPointID != nil && *pickupPointID <= 0 {
+		return StorageTariff{}, ErrStorageTariffFieldsAreIncorrect
+	}
+
+	return StorageTariff{PickupPointID: pickupPointID, FreeDays: freeDays, DailyRate: dailyRate}, nil
+}
+
+// StorageFee is the fee an order accrued on the shelf by a moment. Stored
+// days are started days since StoredSince.
+type StorageFee struct {
+	StoredSince time.Time `json:"stored_since"`
+	StoredDays  int       `json:"stored_days"`
+	FreeDays    int       `json:"free_days"`
+	ChargedDays int       `json:"charged_days"`
+	DailyRate   Money     `json:"daily_rate"`
+	Amount      Money     `json:"amount"`
+}
+
+// Fee returns the fee of an order put on the shelf at storedSince.
+func (t StorageTariff) Fee(storedSince time.Time, now time.Time) (StorageFee, error) {
+	fee := StorageFee{
+		StoredSince: storedSince,
+		FreeDays:    t.FreeDays,
+		DailyRate:   t.DailyRate,
+		Amount:      Zero(t.DailyRate.Currency),
+	}
+	if elapsed := now.Sub(storedSince); elapsed > 0 {
+		fee.StoredDays = int((elapsed + 24*time.Hour - 1) / (24 * time.Hour))
+	}
+	if fee.StoredDays <= t.FreeDays {
+		return fee, nil
+	}
+
+	fee.ChargedDays = fee.StoredDays - t.FreeDays
+	amount, err := t.DailyRate.Mul(int64(fee.ChargedDays))
+	if err != nil {
+		return StorageFee{}, err
+	}
+	fee.Amount = amount
+
+	return fee, nil
+}
+
+func (f StorageFee) IsDue() bool {
+	return f.Amount.Amount > 0
+}
+
+// AccruesStorageFee tells whether the order is charged for its time on the
+// shelf. Only orders waiting for their owner are, since the moment they were
+// put on the shelf.
+func AccruesStorageFee(order Order) bool {
+	return order.Status == Confirmed
+}
+
+// StorageFeePayment is a storage fee collected when an order was issued.
+type StorageFeePayment struct {
+	OrderID       int64     `json:"order_id" db:"order_id"`
+	PickupPointID int64     `json:"pickup_point_id" db:"pickup_point_id"`
+	ChargedDays   int       `json:"charged_days" db:"charged_days"`
+	Amount        Money     `json:"amount" db:"-"`
+	PaidAt        time.Time `json:"paid_at" db:"paid_at"`
+}
+
+// DailyRevenue is what a pickup point earned in one currency on one UTC day:
+// the cost of orders issued that day and not refunded since, and the storage
+// fees collected.
+type DailyRevenue struct {
+	Date            time.Time `json:"date"`
+	PickupPointID   int64     `json:"pickup_point_id"`
+	OrdersIssued    int       `json:"orders_issued"`
+	OrdersRevenue   Money     `json:"orders_revenue"`
+	StorageFeesPaid int       `json:"storage_fees_paid"`
+	StorageFees     Money     `json:"storage_fees"`
+	Total           Money     `json:"total"`
+}
+
+// RevenueFilter selects the days [From, To) of a revenue report, From and To
+// are truncated to UTC days. Nil PickupPointID reports every point.
+type RevenueFilter struct {
+	From          time.Time
+	To            time.Time
+	PickupPointID *int64
+}
+
+func NewRevenueFilter(from time.Time, to time.Time, pickupPointID *int64) (RevenueFilter, error) {
+	from, to = from.UTC().Truncate(24*time.Hour), to.UTC().Truncate(24*time.Hour)
+	if !to.After(from) || to.Sub(from) > MaxRevenuePeriodDays*24*time.Hour {
+		return RevenueFilter{}, ErrRevenuePeriodIsIncorrect
+	}
+	if pickupPointID != nil && *pickupPointID <= 0 {
+		return RevenueFilter{}, ErrRevenuePeriodIsIncorrect
+	}
+
+	return RevenueFilter{From: from, To: to, PickupPointID: pickupPointID}, nil
+}
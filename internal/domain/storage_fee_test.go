@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageTariff_Fee(t *testing.T) {
+	t.Parallel()
+	tariff, err := NewStorageTariff(nil, 3, Money{Amount: 5000, Currency: RUB})
+	require.NoError(t, err)
+	since := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		storedDays  int
+		chargedDays int
+		amount      int64
+	}{
+		{"just put on the shelf", since, 0, 0, 0},
+		{"within free days", since.Add(72 * time.Hour), 3, 0, 0},
+		{"started day is charged", since.Add(72*time.Hour + time.Minute), 4, 1, 5000},
+		{"several charged days", since.Add(10 * 24 * time.Hour), 10, 7, 35000},
+		{"clock behind", since.Add(-time.Hour), 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fee, err := tariff.Fee(since, tt.now)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.storedDays, fee.StoredDays)
+			require.Equal(t, tt.chargedDays, fee.ChargedDays)
+			require.Equal(t, Money{Amount: tt.amount, Currency: RUB}, fee.Amount)
+			require.Equal(t, tt.amount > 0, fee.IsDue())
+		})
+	}
+}
+
+func TestNewStorageTariff(t *testing.T) {
+	t.Parallel()
+	pointID := int64(0)
+
+	_, err := NewStorageTariff(nil, -1, Money{Currency: RUB})
+	require.ErrorIs(t, err, ErrStorageTariffFieldsAreIncorrect)
+	_, err = NewStorageTariff(nil, 1, Money{Amount: -1, Currency: RUB})
+	require.ErrorIs(t, err, ErrStorageTariffFieldsAreIncorrect)
+	_, err = NewStorageTariff(nil, 1, Money{Amount: 1, Currency: "XXX"})
+	require.ErrorIs(t, err, ErrStorageTariffFieldsAreIncorrect)
+	_, err = NewStorageTariff(&pointID, 1, Money{Amount: 1, Currency: RUB})
+	require.ErrorIs(t, err, ErrStorageTariffFieldsAreIncorrect)
+}
+
+func TestNewRevenueFilter(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC)
+
+	filter, err := NewRevenueFilter(from, from.AddDate(0, 0, 1), nil)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), filter.From)
+	require.Equal(t, time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC), filter.To)
+
+	_, err = NewRevenueFilter(from, from.Add(time.Hour), nil)
+	require.ErrorIs(t, err, ErrRevenuePeriodIsIncorrect)
+	_, err = NewRevenueFilter(from, from.AddDate(2, 0, 0), nil)
+	require.ErrorIs(t, err, ErrRevenuePeriodIsIncorrect)
+}
@@ -1,14 +1,16 @@
 package domain
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type TaskStatus string
 
 var (
-	Created        TaskStatus = "CREATED"
-	Processing     TaskStatus = "PROCESSING"
-	Failed         TaskStatus = "FAILED"
-	NoAttemptsLeft TaskStatus = "NO_ATTEMPTS_LEFT"
+	Created    TaskStatus = "CREATED"
+	Processing TaskStatus = "PROCESSING"
+	Failed     TaskStatus = "FAILED"
 )
 
 type TaskType string
@@ -18,14 +20,57 @@ var (
 	OrderStatusLog TaskType = "ORDER_STATUS_LOG"
 )
 
+// Task is an outbox entry published to Kafka. The entry it publishes is
+// fetched with it: StatusChange for ORDER_STATUS_LOG tasks, Request for
+// AUDIT_LOG tasks, nil when the entry is gone. TraceParent and TraceState are
+// the trace context of the operation that created the task. A PROCESSING task
+// is leased to LeaseOwner until LeaseExpiresAt.
 type Task struct {
-	TaskID        int64      `json:"task_id" db:"task_id"`
-	TaskStatus    TaskStatus `json:"task_status" db:"task_status"`
-	TaskType      TaskType   `json:"task_type" db:"task_type"`
-	EntryID       int64      `json:"entry_id" db:"entry_id"`
-	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
-	AttemptsCount int        `json:"attempts_count" db:"attempts_count"`
-	NextAttemptAt time.Time  `json:"next_attempt_at" db:"next_attempt_at"`
-	FinishedAt    time.Time  `json:"finished_at" db:"finished_at"`
+	TaskID         int64           `json:"task_id" db:"task_id"`
+	TaskStatus     TaskStatus      `json:"task_status" db:"task_status"`
+	TaskType       TaskType        `json:"task_type" db:"task_type"`
+	EntryID        int64           `json:"entry_id" db:"entry_id"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+	AttemptsCount  int             `json:"attempts_count" db:"attempts_count"`
+	LastError      string          `json:"last_error,omitempty" db:"last_error"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at" db:"next_attempt_at"`
+	FinishedAt     time.Time       `json:"finished_at" db:"finished_at"`
+	LeaseOwner     *string         `json:"-" db:"lease_owner"`
+	LeaseExpiresAt *time.Time      `json:"-" db:"lease_expires_at"`
+	TraceParent    string          `json:"-" db:"trace_parent"`
+	TraceState     string          `json:"-" db:"trace_state"`
+	StatusChange   *AuditOrderInfo `json:"-" db:"-"`
+	Request        *AuditLogRecord `json:"-" db:"-"`
+}
+
+// DeadLetter is an outbox task that failed every attempt its retry policy
+// allows. LastError is the error of the last attempt, Entry is the audit entry
+// the task publishes, it is only filled when a single dead letter is read.
+type DeadLetter struct {
+	ID            int64           `json:"id" db:"id"`
+	TaskID        int64           `json:"task_id" db:"task_id"`
+	TaskType      TaskType        `json:"task_type" db:"task_type"`
+	EntryID       int64           `json:"entry_id" db:"entry_id"`
+	AttemptsCount int             `json:"attempts_count" db:"attempts_count"`
+	LastError     string          `json:"last_error" db:"last_error"`
+	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
+	DeadAt        time.Time       `json:"dead_at" db:"dead_at"`
+	Entry         json.RawMessage `json:"entry,omitempty" db:"entry"`
+}
+
+// DeadLetterFilter narrows dead letters, nil fields match any dead letter.
+// DeadBefore keeps dead letters that died before the time.
+type DeadLetterFilter struct {
+	TaskType   *TaskType
+	DeadBefore *time.Time
+}
+
+func ParseTaskType(s string) (TaskType, error) {
+	switch TaskType(s) {
+	case AuditLog, OrderStatusLog:
+		return TaskType(s), nil
+	default:
+		return "", ErrUnknownTaskType
+	}
 }
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	pgconn "github.com/jackc/pgconn"
 	pgx "github.com/jackc/pgx/v4"
+	pgxpool "github.com/jackc/pgx/v4/pgxpool"
 )
 
 // MockDB is a mock of DB interface.
@@ -106,6 +107,40 @@ func (mr *MockDBMockRecorder) Get(ctx, dest, query interface{}, args ...interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDB)(nil).Get), varargs...)
 }
 
+// GetPool mocks base method.
+func (m *MockDB) GetPool() *pgxpool.Pool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPool")
+	ret0, _ := ret[0].(*pgxpool.Pool)
+	return ret0
+}
+
+// GetPool indicates an expected call of GetPool.
+func (mr *MockDBMockRecorder) GetPool() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockDB)(nil).GetPool))
+}
+
+// Query mocks base method.
+func (m *MockDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, sql}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Query", varargs...)
+	ret0, _ := ret[0].(pgx.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockDBMockRecorder) Query(ctx, sql interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, sql}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockDB)(nil).Query), varargs...)
+}
+
 // Select mocks base method.
 func (m *MockDB) Select(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
 	m.ctrl.T.Helper()
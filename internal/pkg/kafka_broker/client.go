@@ -2,7 +2,6 @@ package kafka_broker
 
 import (
 	"context"
-	"encoding/json"
 	"sync"
 	"time"
 
@@ -68,19 +67,20 @@ func NewClient(cfg Config) (*Client, error) {
 	return client, nil
 }
 
-// Publish just sends a message to the single topic.
-func (c *Client) Publish(_ context.Context, key string, v interface{}) error {
-	b, err := json.Marshal(v)
-	if err != nil {
-		return err
+// Publish sends an encoded message with its headers to the single topic.
+func (c *Client) Publish(_ context.Context, key string, value []byte, headers map[string]string) error {
+	recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+	for k, v := range headers {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{Key: []byte(k), Value: []byte(v)})
 	}
 	msg := &sarama.ProducerMessage{
 		Topic:     c.topic,
 		Key:       sarama.StringEncoder(key),
-		Value:     sarama.ByteEncoder(b),
+		Value:     sarama.ByteEncoder(value),
+		Headers:   recordHeaders,
 		Timestamp: time.Now(),
 	}
-	_, _, err = c.producer.SendMessage(msg)
+	_, _, err := c.producer.SendMessage(msg)
 
 	return err
 }
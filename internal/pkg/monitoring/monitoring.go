@@ -26,6 +26,47 @@ var (
 		Name: "orders_completed_total",
 		Help: "Total number of orders completed successfully",
 	})
+	OrdersExpiredTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "orders_expired_total",
+		Help: "Total number of confirmed orders moved to awaiting return after expiration",
+	})
+	OrdersExpiredPerRun = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "orders_expired_per_run",
+		Help:    "Number of orders the expiry sweeper moved to awaiting return in one run",
+		Buckets: []float64{0, 1, 5, 10, 50, 100, 500, 1000},
+	})
+	ArchivedOrdersPurgedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "archived_orders_purged_total",
+		Help: "Total number of archived orders removed after the retention period",
+	})
+	ShipmentDiscrepanciesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "shipment_discrepancies_total",
+		Help: "Total number of missing, unexpected and rejected orders of closed inbound shipments",
+	}, []string{"kind"})
+	IncidentsOpenedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "incidents_opened_total",
+		Help: "Total number of opened lost and damaged order incidents",
+	}, []string{"type"})
+	IncidentsClosedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "incidents_closed_total",
+		Help: "Total number of resolved and cancelled incidents by outcome",
+	}, []string{"outcome"})
+	StorageFeesCollectedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "storage_fees_collected_total",
+		Help: "Total amount of storage fees collected in minor units of the currency",
+	}, []string{"currency"})
+	OutboxTasksReclaimedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_tasks_reclaimed_total",
+		Help: "Total number of outbox tasks made claimable again after their lease expired",
+	})
+	OutboxDeadLettersTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "outbox_dead_letters_total",
+		Help: "Total number of outbox tasks moved to dead letters after their last attempt failed",
+	}, []string{"task_type"})
+	StorageCellsFillRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "storage_cells_fill_ratio",
+		Help: "Share of occupied storage cells of a pickup point",
+	}, []string{"pickup_point_id"})
 
 	registerOnce sync.Once
 )
@@ -38,6 +79,16 @@ func RegisterBusinessMetrics() {
 			OrdersRefundedTotal,
 			OrdersReturnedTotal,
 			OrdersCompletedTotal,
+			OrdersExpiredTotal,
+			OrdersExpiredPerRun,
+			ArchivedOrdersPurgedTotal,
+			ShipmentDiscrepanciesTotal,
+			IncidentsOpenedTotal,
+			IncidentsClosedTotal,
+			StorageFeesCollectedTotal,
+			OutboxTasksReclaimedTotal,
+			OutboxDeadLettersTotal,
+			StorageCellsFillRatio,
 		)
 	})
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"fmt"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"net/url"
 	"time"
 )
 
@@ -12,13 +13,18 @@ type Filter struct {
 	ExpirationTime *time.Time
 	Status         *domain.Status
 	Weight         *int
-	Cost           *int
+	Cost           *domain.Money
 	SearchTerm     *string
+	PickupPointID  *int64
+	RefundReason   *string
+	ItemCondition  *domain.ItemCondition
 }
 
 func BuildSQLQuery(filter Filter) (string, []interface{}) {
 	baseQuery := `
-        SELECT order_id, user_id, expiration_date, status, last_changed_at, weight, cost
+        SELECT order_id, user_id, expiration_date, status, last_changed_at, arrived_at, weight, cost, packaging,
+               package_type, is_additional_film, base_cost, packaging_cost, length, width, height, currency,
+               pickup_point_id
         FROM orders
         WHERE 1=1
     `
@@ -41,15 +47,30 @@ func BuildSQLQuery(filter Filter) (string, []interface{}) {
 		argPos++
 	}
 	if filter.Cost != nil {
-		baseQuery += fmt.Sprintf(" AND cost = $%d", argPos)
-		values = append(values, *filter.Cost)
-		argPos++
+		baseQuery += fmt.Sprintf(" AND cost = $%d AND currency = $%d", argPos, argPos+1)
+		values = append(values, filter.Cost.Amount, filter.Cost.Currency)
+		argPos += 2
 	}
 	if filter.Weight != nil {
 		baseQuery += fmt.Sprintf(" AND weight = $%d", argPos)
 		values = append(values, *filter.Weight)
 		argPos++
 	}
+	if filter.PickupPointID != nil {
+		baseQuery += fmt.Sprintf(" AND pickup_point_id = $%d", argPos)
+		values = append(values, *filter.PickupPointID)
+		argPos++
+	}
+	if filter.RefundReason != nil {
+		baseQuery += fmt.Sprintf(" AND order_id IN (SELECT order_id FROM refund_details WHERE reason_code = $%d)", argPos)
+		values = append(values, *filter.RefundReason)
+		argPos++
+	}
+	if filter.ItemCondition != nil {
+		baseQuery += fmt.Sprintf(" AND order_id IN (SELECT order_id FROM refund_details WHERE item_condition = $%d)", argPos)
+		values = append(values, *filter.ItemCondition)
+		argPos++
+	}
 	if filter.SearchTerm != nil {
 		baseQuery += fmt.Sprintf(" AND (CAST(order_id AS TEXT) LIKE $%d OR status LIKE $%d)", argPos, argPos)
 		values = append(values, *filter.SearchTerm)
@@ -58,28 +79,39 @@ func BuildSQLQuery(filter Filter) (string, []interface{}) {
 	return baseQuery, values
 }
 
+// GetFilterStringView renders the filter with its values so that it can be
+// used as a part of a cache key. Memcached keys cannot contain spaces.
 func (filter *Filter) GetFilterStringView() string {
 	filterString := ""
 	if filter.Status != nil {
-		filterString += "status,"
+		filterString += fmt.Sprintf("status=%s,", *filter.Status)
 	}
 	if filter.UserID != nil {
-		filterString += "user_id,"
+		filterString += fmt.Sprintf("user_id=%d,", *filter.UserID)
 	}
 	if filter.OrderID != nil {
-		filterString += "order_id,"
+		filterString += fmt.Sprintf("order_id=%d,", *filter.OrderID)
 	}
 	if filter.Cost != nil {
-		filterString += "cost,"
+		filterString += fmt.Sprintf("cost=%d%s,", filter.Cost.Amount, filter.Cost.Currency)
 	}
 	if filter.Weight != nil {
-		filterString += "weight,"
+		filterString += fmt.Sprintf("weight=%d,", *filter.Weight)
 	}
 	if filter.ExpirationTime != nil {
-		filterString += "expiration_time,"
+		filterString += fmt.Sprintf("expiration_time=%s,", filter.ExpirationTime.Format(time.RFC3339))
 	}
 	if filter.SearchTerm != nil {
-		filterString += "search_term,"
+		filterString += fmt.Sprintf("search_term=%s,", url.QueryEscape(*filter.SearchTerm))
+	}
+	if filter.PickupPointID != nil {
+		filterString += fmt.Sprintf("pickup_point_id=%d,", *filter.PickupPointID)
+	}
+	if filter.RefundReason != nil {
+		filterString += fmt.Sprintf("refund_reason=%s,", url.QueryEscape(*filter.RefundReason))
+	}
+	if filter.ItemCondition != nil {
+		filterString += fmt.Sprintf("item_condition=%s,", url.QueryEscape(string(*filter.ItemCondition)))
 	}
 
 	return filterString
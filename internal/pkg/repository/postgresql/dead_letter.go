@@ -0,0 +1,117 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+const selectDeadLetterQuery = `
+		SELECT id, task_id, task_type, entry_id, attempts_count, last_error, created_at, dead_at
+		FROM outbox_dead_letters`
+
+type DeadLetterRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewDeadLetterRepositoryImpl(tx *tx_manager.TxManager) *DeadLetterRepositoryImpl {
+	return &DeadLetterRepositoryImpl{
+		tx: tx,
+	}
+}
+
+// Find returns the dead letter with the audit entry its task publishes.
+func (r *DeadLetterRepositoryImpl) Find(ctx context.Context, id int64) (domain.DeadLetter, error) {
+	var deadLetter domain.DeadLetter
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &deadLetter, `
+		SELECT d.id, d.task_id, d.task_type, d.entry_id, d.attempts_count, d.last_error, d.created_at, d.dead_at,
+		       COALESCE(
+		           (SELECT row_to_json(a) FROM order_status_audit a
+		             WHERE d.task_type = 'ORDER_STATUS_LOG' AND a.entry_id = d.entry_id),
+		           (SELECT row_to_json(l) FROM audit_logs l
+		             WHERE d.task_type = 'AUDIT_LOG' AND l.entry_id = d.entry_id)
+		       ) AS entry
+		FROM outbox_dead_letters d
+		WHERE d.id = $1;`, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.DeadLetter{}, domain.ErrDeadLetterNotFound
+		}
+
+		return domain.DeadLetter{}, fmt.Errorf("select dead letter: %w", err)
+	}
+
+	return deadLetter, nil
+}
+
+// FindAll returns dead letters matching the filter, newest first.
+func (r *DeadLetterRepositoryImpl) FindAll(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	where, values := deadLetterConditions(filter)
+
+	var deadLetters []domain.DeadLetter
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &deadLetters, selectDeadLetterQuery+where+`
+		ORDER BY id DESC;`, values...); err != nil {
+		return nil, fmt.Errorf("select dead letters: %w", err)
+	}
+
+	return deadLetters, nil
+}
+
+// Requeue puts the task of the dead letter back to the outbox with no attempts
+// made and returns the id of the new task. The task keeps its trace context,
+// so the event still continues the trace of the operation.
+func (r *DeadLetterRepositoryImpl) Requeue(ctx context.Context, id int64) (int64, error) {
+	var taskID int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		WITH dead AS (
+		    DELETE FROM outbox_dead_letters
+		     WHERE id = $1
+		    RETURNING task_type, entry_id, trace_parent, trace_state
+		)
+		INSERT INTO outbox (task_type, entry_id, task_status, trace_parent, trace_state, created_at)
+		SELECT task_type, entry_id, $2, trace_parent, trace_state, NOW()
+		  FROM dead
+		RETURNING task_id;`, id, domain.Created).Scan(&taskID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return 0, domain.ErrDeadLetterNotFound
+		}
+
+		return 0, fmt.Errorf("requeue dead letter: %w", err)
+	}
+
+	return taskID, nil
+}
+
+// Purge removes dead letters matching the filter and reports how many were
+// removed.
+func (r *DeadLetterRepositoryImpl) Purge(ctx context.Context, filter domain.DeadLetterFilter) (int64, error) {
+	where, values := deadLetterConditions(filter)
+
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		DELETE FROM outbox_dead_letters`+where+`;`, values...)
+	if err != nil {
+		return 0, fmt.Errorf("purge dead letters: %w", err)
+	}
+
+	return execResult.RowsAffected(), nil
+}
+
+func deadLetterConditions(filter domain.DeadLetterFilter) (string, []interface{}) {
+	where := `
+		WHERE 1=1`
+	var values []interface{}
+	if filter.TaskType != nil {
+		values = append(values, *filter.TaskType)
+		where += fmt.Sprintf(" AND task_type = $%d", len(values))
+	}
+	if filter.DeadBefore != nil {
+		values = append(values, *filter.DeadBefore)
+		where += fmt.Sprintf(" AND dead_at < $%d", len(values))
+	}
+
+	return where, values
+}
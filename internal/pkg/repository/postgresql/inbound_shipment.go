@@ -0,0 +1,252 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+// shipmentItemRow is an inbound_shipment_items table row.
+type shipmentItemRow struct {
+	domain.ShipmentItem
+	Cost     int64           `db:"cost"`
+	Currency domain.Currency `db:"currency"`
+}
+
+type InboundShipmentRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewInboundShipmentRepositoryImpl(tx *tx_manager.TxManager) *InboundShipmentRepositoryImpl {
+	return &InboundShipmentRepositoryImpl{
+		tx: tx,
+	}
+}
+
+// Create stores the shipment together with its expected items.
+func (r *InboundShipmentRepositoryImpl) Create(ctx context.Context, shipment domain.InboundShipment) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO inbound_shipments (pickup_point_id, reference, status)
+		VALUES ($1, $2, $3)
+		RETURNING id;`,
+		shipment.PickupPointID,
+		shipment.Reference,
+		shipment.Status,
+	).Scan(&id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return 0, domain.ErrPickupPointNotFound
+		}
+
+		return 0, fmt.Errorf("insert inbound shipment: %w", err)
+	}
+
+	n := len(shipment.Items)
+	orderIDs, userIDs := make([]int64, n), make([]int64, n)
+	expirations := make([]time.Time, n)
+	weights, lengths, widths, heights := make([]int32, n), make([]int32, n), make([]int32, n), make([]int32, n)
+	costs, currencies := make([]int64, n), make([]string, n)
+	for i, item := range shipment.Items {
+		orderIDs[i], userIDs[i] = item.OrderID, item.UserID
+		expirations[i] = item.ExpirationTime
+		weights[i] = int32(item.Weight)
+		costs[i], currencies[i] = item.Cost.Amount, string(item.Cost.Currency)
+		lengths[i], widths[i], heights[i] = int32(item.Length), int32(item.Width), int32(item.Height)
+	}
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO inbound_shipment_items (shipment_id, order_id, user_id, expiration_date, weight,
+		                                    cost, currency, length, width, height)
+		SELECT $1, order_id, user_id, expiration_date, weight, cost, currency, length, width, height
+		FROM unnest($2::bigint[], $3::bigint[], $4::timestamp[], $5::integer[],
+		            $6::bigint[], $7::varchar[], $8::integer[], $9::integer[], $10::integer[])
+		     AS i (order_id, user_id, expiration_date, weight, cost, currency, length, width, height);`,
+		id, orderIDs, userIDs, expirations, weights, costs, currencies, lengths, widths, heights,
+	); err != nil {
+		return 0, fmt.Errorf("insert inbound shipment items: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *InboundShipmentRepositoryImpl) Find(ctx context.Context, id int64) (domain.InboundShipment, error) {
+	var shipment domain.InboundShipment
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &shipment, `
+		SELECT id, pickup_point_id, reference, status, created_at, closed_at
+		FROM inbound_shipments
+		WHERE id = $1;`, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.InboundShipment{}, domain.ErrShipmentNotFound
+		}
+
+		return domain.InboundShipment{}, fmt.Errorf("select inbound shipment: %w", err)
+	}
+
+	shipments := []domain.InboundShipment{shipment}
+	if err := r.loadItems(ctx, shipments); err != nil {
+		return domain.InboundShipment{}, err
+	}
+
+	return shipments[0], nil
+}
+
+// FindAll returns shipments matching the filter, newest first.
+func (r *InboundShipmentRepositoryImpl) FindAll(
+	ctx context.Context,
+	filter domain.ShipmentFilter,
+) ([]domain.InboundShipment, error) {
+	query := `
+		SELECT id, pickup_point_id, reference, status, created_at, closed_at
+		FROM inbound_shipments
+		WHERE 1=1`
+	var values []interface{}
+	if filter.PickupPointID != nil {
+		values = append(values, *filter.PickupPointID)
+		query += fmt.Sprintf(" AND pickup_point_id = $%d", len(values))
+	}
+	if filter.Status != nil {
+		values = append(values, *filter.Status)
+		query += fmt.Sprintf(" AND status = $%d", len(values))
+	}
+	query += " ORDER BY id DESC;"
+
+	var shipments []domain.InboundShipment
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &shipments, query, values...); err != nil {
+		return nil, fmt.Errorf("select inbound shipments: %w", err)
+	}
+	if err := r.loadItems(ctx, shipments); err != nil {
+		return nil, err
+	}
+
+	return shipments, nil
+}
+
+func (r *InboundShipmentRepositoryImpl) loadItems(ctx context.Context, shipments []domain.InboundShipment) error {
+	if len(shipments) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(shipments))
+	byID := make(map[int64]int, len(shipments))
+	for i, s := range shipments {
+		ids[i] = s.ID
+		byID[s.ID] = i
+		shipments[i].Items = []domain.ShipmentItem{}
+		shipments[i].Scanned = []int64{}
+	}
+
+	var items []shipmentItemRow
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &items, `
+		SELECT shipment_id, order_id, user_id, expiration_date, weight, cost, currency, length, width, height
+		FROM inbound_shipment_items
+		WHERE shipment_id = ANY($1)
+		ORDER BY shipment_id, order_id;`, ids); err != nil {
+		return fmt.Errorf("select inbound shipment items: %w", err)
+	}
+	for _, row := range items {
+		item := row.ShipmentItem
+		item.Cost = domain.Money{Amount: row.Cost, Currency: row.Currency}
+		i := byID[item.ShipmentID]
+		shipments[i].Items = append(shipments[i].Items, item)
+	}
+
+	var scans []struct {
+		ShipmentID int64 `db:"shipment_id"`
+		OrderID    int64 `db:"order_id"`
+	}
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &scans, `
+		SELECT shipment_id, order_id
+		FROM inbound_shipment_scans
+		WHERE shipment_id = ANY($1)
+		ORDER BY shipment_id, order_id;`, ids); err != nil {
+		return fmt.Errorf("select inbound shipment scans: %w", err)
+	}
+	for _, scan := range scans {
+		i := byID[scan.ShipmentID]
+		shipments[i].Scanned = append(shipments[i].Scanned, scan.OrderID)
+	}
+
+	return nil
+}
+
+// Scan records a received order. Orders missing from the manifest are
+// recorded too, scanning an order twice keeps the first scan time.
+func (r *InboundShipmentRepositoryImpl) Scan(ctx context.Context, shipmentID int64, orderID int64) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO inbound_shipment_scans (shipment_id, order_id)
+		VALUES ($1, $2)
+		ON CONFLICT (shipment_id, order_id) DO NOTHING;`, shipmentID, orderID); err != nil {
+		return fmt.Errorf("insert inbound shipment scan: %w", err)
+	}
+
+	return nil
+}
+
+// Close stores the discrepancy report and closes the shipment.
+func (r *InboundShipmentRepositoryImpl) Close(ctx context.Context, report domain.ShipmentReport) error {
+	rejected := report.Rejected
+	if rejected == nil {
+		rejected = []domain.RejectedItem{}
+	}
+	rejectedJSON, err := json.Marshal(rejected)
+	if err != nil {
+		return err
+	}
+
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE inbound_shipments
+		SET status = $2, closed_at = NOW()
+		WHERE id = $1 AND status = $3;`, report.ShipmentID, domain.ShipmentClosed, domain.ShipmentOpen)
+	if err != nil {
+		return fmt.Errorf("close inbound shipment: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrShipmentIsClosed
+	}
+
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO inbound_shipment_reports (shipment_id, received, missing, unexpected, rejected)
+		VALUES ($1, $2, $3, $4, $5);`,
+		report.ShipmentID,
+		nonNilIDs(report.Received),
+		nonNilIDs(report.Missing),
+		nonNilIDs(report.Unexpected),
+		rejectedJSON,
+	); err != nil {
+		return fmt.Errorf("insert inbound shipment report: %w", err)
+	}
+
+	return nil
+}
+
+func (r *InboundShipmentRepositoryImpl) FindReport(ctx context.Context, shipmentID int64) (domain.ShipmentReport, error) {
+	var report domain.ShipmentReport
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &report, `
+		SELECT shipment_id, received, missing, unexpected, rejected, created_at
+		FROM inbound_shipment_reports
+		WHERE shipment_id = $1;`, shipmentID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.ShipmentReport{}, domain.ErrShipmentReportNotFound
+		}
+
+		return domain.ShipmentReport{}, fmt.Errorf("select inbound shipment report: %w", err)
+	}
+
+	return report, nil
+}
+
+// nonNilIDs keeps empty id lists from being stored as NULL.
+func nonNilIDs(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+
+	return ids
+}
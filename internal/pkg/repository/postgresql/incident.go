@@ -0,0 +1,134 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+const selectIncidentQuery = `
+		SELECT id, order_id, type, description, operator, status, order_status, outcome, resolution,
+		       resolved_by, created_at, updated_at, resolved_at
+		FROM incidents`
+
+type IncidentRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewIncidentRepositoryImpl(tx *tx_manager.TxManager) *IncidentRepositoryImpl {
+	return &IncidentRepositoryImpl{
+		tx: tx,
+	}
+}
+
+func (r *IncidentRepositoryImpl) Create(ctx context.Context, incident domain.Incident) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO incidents (order_id, type, description, operator, status, order_status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id;`,
+		incident.OrderID,
+		incident.Type,
+		incident.Description,
+		incident.Operator,
+		incident.Status,
+		incident.OrderStatus,
+	).Scan(&id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return 0, domain.ErrOrderIsBlocked
+		}
+
+		return 0, fmt.Errorf("insert incident: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *IncidentRepositoryImpl) Find(ctx context.Context, id int64) (domain.Incident, error) {
+	var incident domain.Incident
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &incident, selectIncidentQuery+`
+		WHERE id = $1;`, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.Incident{}, domain.ErrIncidentNotFound
+		}
+
+		return domain.Incident{}, fmt.Errorf("select incident: %w", err)
+	}
+
+	return incident, nil
+}
+
+// FindAll returns incidents matching the filter, newest first.
+func (r *IncidentRepositoryImpl) FindAll(ctx context.Context, filter domain.IncidentFilter) ([]domain.Incident, error) {
+	query := selectIncidentQuery + `
+		WHERE 1=1`
+	var values []interface{}
+	if filter.OrderID != nil {
+		values = append(values, *filter.OrderID)
+		query += fmt.Sprintf(" AND order_id = $%d", len(values))
+	}
+	if filter.Type != nil {
+		values = append(values, *filter.Type)
+		query += fmt.Sprintf(" AND type = $%d", len(values))
+	}
+	if filter.Status != nil {
+		values = append(values, *filter.Status)
+		query += fmt.Sprintf(" AND status = $%d", len(values))
+	}
+	query += " ORDER BY id DESC;"
+
+	var incidents []domain.Incident
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &incidents, query, values...); err != nil {
+		return nil, fmt.Errorf("select incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// Update stores the editable fields of an open incident.
+func (r *IncidentRepositoryImpl) Update(ctx context.Context, incident domain.Incident) error {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE incidents
+		SET type = $2, description = $3, updated_at = NOW()
+		WHERE id = $1 AND status = $4;`,
+		incident.ID, incident.Type, incident.Description, domain.IncidentOpen)
+	if err != nil {
+		return fmt.Errorf("update incident: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrIncidentIsClosed
+	}
+
+	return nil
+}
+
+// Close resolves or cancels an open incident with its status, outcome,
+// resolution and resolver.
+func (r *IncidentRepositoryImpl) Close(ctx context.Context, incident domain.Incident) error {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE incidents
+		SET status = $2, outcome = $3, resolution = $4, resolved_by = $5, resolved_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = $6;`,
+		incident.ID,
+		incident.Status,
+		incident.Outcome,
+		incident.Resolution,
+		incident.ResolvedBy,
+		domain.IncidentOpen,
+	)
+	if err != nil {
+		return fmt.Errorf("close incident: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrIncidentIsClosed
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
@@ -15,6 +16,33 @@ import (
 	"time"
 )
 
+// orderRow is an orders table row. Money amounts of an order share its currency.
+type orderRow struct {
+	domain.Order
+	Cost          int64           `db:"cost"`
+	BaseCost      int64           `db:"base_cost"`
+	PackagingCost int64           `db:"packaging_cost"`
+	Currency      domain.Currency `db:"currency"`
+}
+
+func (r orderRow) toDomain() domain.Order {
+	order := r.Order
+	order.Cost = domain.Money{Amount: r.Cost, Currency: r.Currency}
+	order.BaseCost = domain.Money{Amount: r.BaseCost, Currency: r.Currency}
+	order.PackagingCost = domain.Money{Amount: r.PackagingCost, Currency: r.Currency}
+
+	return order
+}
+
+func toDomainOrders(rows []orderRow) []domain.Order {
+	orders := make([]domain.Order, len(rows))
+	for i, row := range rows {
+		orders[i] = row.toDomain()
+	}
+
+	return orders
+}
+
 type OrderRepo struct {
 	tx     *tx_manager.TxManager
 	client cache.Client
@@ -27,38 +55,54 @@ func NewOrdersRepo(tx *tx_manager.TxManager, client *cache.Client) *OrderRepo {
 	}
 }
 
-func (o *OrderRepo) Create(
-	ctx context.Context,
-	orderID int64,
-	userID int64,
-	expirationDate time.Time,
-	weight int,
-	cost int,
-) (int64, error) {
+func (o *OrderRepo) Create(ctx context.Context, order domain.Order) (int64, error) {
 	var (
 		id int64
 	)
-	cacheKey := fmt.Sprintf("order_%d", orderID)
+	cacheKey := fmt.Sprintf("order_%d", order.OrderID)
 
 	value, err := o.client.GetOrdersFromCache(cacheKey)
 	if err == nil && len(value) == 1 {
-		return value[0].UserID, nil
+		return 0, domain.ErrOrderAlreadyExists
+	}
+	o.tx.AfterRollback(ctx, func() { o.client.InvalidateOrderCache(cacheKey) })
+
+	packaging := order.Packaging
+	if packaging == nil {
+		packaging = []domain.PackagingLayer{}
+	}
+	packagingJSON, err := json.Marshal(packaging)
+	if err != nil {
+		return 0, err
 	}
 
 	err = o.tx.GetQueryEngine(ctx).ExecQueryRow(ctx,
 		`
-		INSERT INTO orders(order_id, user_id, expiration_date, weight, cost) 
-		VALUES ($1,$2,$3, $4,$5) returning order_id;`,
-		orderID,
-		userID,
-		expirationDate,
-		weight,
-		cost).Scan(&id)
+		INSERT INTO orders(order_id, user_id, expiration_date, weight, cost, packaging,
+		                   package_type, is_additional_film, base_cost, packaging_cost,
+		                   length, width, height, currency, pickup_point_id, status) 
+		VALUES ($1,$2,$3, $4,$5,$6, $7,$8,$9,$10, $11,$12,$13,$14,$15,$16) returning order_id;`,
+		order.OrderID,
+		order.UserID,
+		order.ExpirationTime,
+		order.Weight,
+		order.Cost.Amount,
+		packagingJSON,
+		order.PackageType,
+		order.IsAdditionalFilm,
+		order.BaseCost.Amount,
+		order.PackagingCost.Amount,
+		order.Length,
+		order.Width,
+		order.Height,
+		order.Cost.Currency,
+		order.PickupPointID,
+		order.Status).Scan(&id)
 	if err != nil {
 		return 0, err
 	}
 
-	_, err = o.Find(ctx, orderID) // call Find to store value in cache
+	_, err = o.Find(ctx, order.OrderID) // call Find to store value in cache
 	if err != nil {
 		return 0, err
 	}
@@ -75,15 +119,26 @@ func (o *OrderRepo) Find(ctx context.Context, orderID int64) (domain.Order, erro
 		return value[0], nil
 	}
 
-	order := domain.Order{}
-	err = o.tx.GetQueryEngine(ctx).Get(ctx, &order, `SELECT 
+	row := orderRow{}
+	err = o.tx.GetQueryEngine(ctx).Get(ctx, &row, `SELECT 
 		order_id,
 		user_id,
 		expiration_date,
 		status,
 		weight,
 		cost,
-		last_changed_at
+		last_changed_at,
+		arrived_at,
+		packaging,
+		package_type,
+		is_additional_film,
+		base_cost,
+		packaging_cost,
+		length,
+		width,
+		height,
+		currency,
+		pickup_point_id
 	FROM orders
 	WHERE order_id = $1;
 	`, orderID)
@@ -96,10 +151,13 @@ func (o *OrderRepo) Find(ctx context.Context, orderID int64) (domain.Order, erro
 		return domain.Order{}, err
 	}
 
-	orders := []domain.Order{order}
-	if err := o.client.SetOrdersToCache(cacheKey, orders); err == nil {
-		log.Print("cache successfully updated")
-	}
+	order := row.toDomain()
+	// an order read inside a transaction may be rolled back with it
+	o.tx.AfterCommit(ctx, func() {
+		if err := o.client.SetOrdersToCache(cacheKey, []domain.Order{order}); err == nil {
+			log.Print("cache successfully updated")
+		}
+	})
 
 	return order, nil
 }
@@ -139,12 +197,13 @@ func (o *OrderRepo) Update(
 	expirationDate time.Time,
 	status domain.Status,
 	weight int,
-	cost int,
+	cost domain.Money,
 ) (int64, error) {
 	var returnedOrderID int64
 	cacheKey := fmt.Sprintf("order_%d", orderID)
 
 	o.client.InvalidateOrderCache(cacheKey)
+	o.tx.AfterRollback(ctx, func() { o.client.InvalidateOrderCache(cacheKey) })
 	err := o.tx.GetQueryEngine(ctx).ExecQueryRow(ctx,
 		`
 	UPDATE orders SET 
@@ -153,7 +212,8 @@ func (o *OrderRepo) Update(
 				  status = $4, 
 				  weight = $5, 
 				  cost = $6, 
-				  last_changed_at = $7 
+				  last_changed_at = $7, 
+				  currency = $8 
 	            WHERE order_id = $1 
 	RETURNING order_id;`,
 		orderID,
@@ -161,8 +221,9 @@ func (o *OrderRepo) Update(
 		expirationDate,
 		status,
 		weight,
-		cost,
-		time.Now()).Scan(&returnedOrderID)
+		cost.Amount,
+		time.Now(),
+		cost.Currency).Scan(&returnedOrderID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -181,13 +242,21 @@ func (o *OrderRepo) Update(
 	return value.OrderID, nil
 }
 
-func (o *OrderRepo) Delete(ctx context.Context, orderID int64) error {
+// Archive moves the order to orders_archive with the given status instead of
+// deleting it, so that returned orders stay available for support lookups.
+// An order id returned again, e.g. after a re-import, replaces its earlier
+// archived row, the archive keeps the latest return like refund_details do.
+func (o *OrderRepo) Archive(ctx context.Context, orderID int64, status domain.Status) error {
 	cacheKey := fmt.Sprintf("order_%d", orderID)
+	invalidate := func() { o.client.InvalidateOrderCache(cacheKey) }
 
-	o.client.InvalidateOrderCache(cacheKey)
-	execResult, err := o.tx.GetQueryEngine(ctx).Exec(ctx, `DELETE FROM orders WHERE order_id = $1;`, orderID)
+	// the order may be cached again by a reader before the archive commits
+	invalidate()
+	o.tx.AfterCommit(ctx, invalidate)
+	o.tx.AfterRollback(ctx, invalidate)
+	execResult, err := o.tx.GetQueryEngine(ctx).Exec(ctx, archiveOrderQuery, orderID, status)
 	if err != nil {
-		return err
+		return fmt.Errorf("archive order: %w", err)
 	}
 
 	if execResult.RowsAffected() == 0 {
@@ -197,6 +266,59 @@ func (o *OrderRepo) Delete(ctx context.Context, orderID int64) error {
 	return nil
 }
 
+// FindExpired locks confirmed orders that expired before the given moment.
+// Orders locked by another transaction are skipped, so several sweepers can
+// run at once.
+func (o *OrderRepo) FindExpired(ctx context.Context, before time.Time, limit int) ([]domain.Order, error) {
+	var rows []orderRow
+	if err := o.tx.GetQueryEngine(ctx).Select(ctx, &rows, `
+		SELECT order_id, user_id, expiration_date, status, last_changed_at, arrived_at, weight, cost, packaging,
+		       package_type, is_additional_film, base_cost, packaging_cost, length, width, height, currency,
+		       pickup_point_id
+		FROM orders
+		WHERE status = $1 AND expiration_date < $2
+		ORDER BY expiration_date, order_id
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED;`, domain.Confirmed, before, limit); err != nil {
+		return nil, fmt.Errorf("select expired orders: %w", err)
+	}
+
+	return toDomainOrders(rows), nil
+}
+
+const archiveOrderQuery = `
+	WITH moved AS (
+		DELETE FROM orders WHERE order_id = $1
+		RETURNING order_id, user_id, expiration_date, last_changed_at, weight, cost, packaging,
+		          package_type, is_additional_film, base_cost, packaging_cost, length, width, height,
+		          currency, pickup_point_id
+	)
+	INSERT INTO orders_archive (order_id, user_id, expiration_date, status, last_changed_at, weight, cost,
+	                            packaging, package_type, is_additional_film, base_cost, packaging_cost,
+	                            length, width, height, currency, pickup_point_id, archived_at)
+	SELECT order_id, user_id, expiration_date, $2, NOW(), weight, cost,
+	       packaging, package_type, is_additional_film, base_cost, packaging_cost,
+	       length, width, height, currency, pickup_point_id, NOW()
+	FROM moved
+	ON CONFLICT (order_id) DO UPDATE
+	SET user_id            = EXCLUDED.user_id,
+	    expiration_date    = EXCLUDED.expiration_date,
+	    status             = EXCLUDED.status,
+	    last_changed_at    = EXCLUDED.last_changed_at,
+	    weight             = EXCLUDED.weight,
+	    cost               = EXCLUDED.cost,
+	    packaging          = EXCLUDED.packaging,
+	    package_type       = EXCLUDED.package_type,
+	    is_additional_film = EXCLUDED.is_additional_film,
+	    base_cost          = EXCLUDED.base_cost,
+	    packaging_cost     = EXCLUDED.packaging_cost,
+	    length             = EXCLUDED.length,
+	    width              = EXCLUDED.width,
+	    height             = EXCLUDED.height,
+	    currency           = EXCLUDED.currency,
+	    pickup_point_id    = EXCLUDED.pickup_point_id,
+	    archived_at        = EXCLUDED.archived_at;`
+
 func (o *OrderRepo) findAllWithPagination(
 	ctx context.Context,
 	filter repository.Filter,
@@ -217,7 +339,9 @@ func (o *OrderRepo) findAllWithPagination(
 	values = append(values, lastID, limit)
 
 	baseQuery += fmt.Sprintf(" AND order_id > $%d LIMIT $%d", len(values)-1, len(values))
-	err = o.tx.GetQueryEngine(ctx).Select(ctx, orders, baseQuery, values...)
+	var rows []orderRow
+	err = o.tx.GetQueryEngine(ctx).Select(ctx, &rows, baseQuery, values...)
+	*orders = toDomainOrders(rows)
 
 	if err := o.client.SetOrdersToCache(cacheKey, *orders); err != nil {
 		logger.ZapLogger.Error("failed to cache orders", zap.String("orderrepo", err.Error()))
@@ -240,8 +364,7 @@ func (o *OrderRepo) findAllWithoutPagination(
 		return nil
 	}
 
-	baseQuery, values := repository.BuildSQLQuery(filter)
-	err = o.tx.GetQueryEngine(ctx).Select(ctx, orders, baseQuery, values...)
+	*orders, err = o.FindAllUncached(ctx, filter)
 
 	if err := o.client.SetOrdersToCache(cacheKey, *orders); err != nil {
 		logger.ZapLogger.Error("failed to cache orders", zap.String("orderrepo", err.Error()))
@@ -250,12 +373,22 @@ func (o *OrderRepo) findAllWithoutPagination(
 	return err
 }
 
+// FindAllUncached reads every order matching the filter from the database,
+// the list cache is neither read nor filled.
+func (o *OrderRepo) FindAllUncached(ctx context.Context, filter repository.Filter) ([]domain.Order, error) {
+	baseQuery, values := repository.BuildSQLQuery(filter)
+	var rows []orderRow
+	err := o.tx.GetQueryEngine(ctx).Select(ctx, &rows, baseQuery, values...)
+
+	return toDomainOrders(rows), err
+}
+
 func (o *OrderRepo) buildFindAllCacheKey(filter repository.Filter, lastID *int64, limit *int) string {
 	filterString := filter.GetFilterStringView()
 	base := fmt.Sprintf("findAll:%v", filterString)
 
 	if lastID != nil && limit != nil {
-		base = fmt.Sprintf("%v,lastId,limit", base)
+		base = fmt.Sprintf("%v,lastId=%d,limit=%d", base, *lastID, *limit)
 	}
 
 	return base
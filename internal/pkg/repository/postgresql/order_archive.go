@@ -0,0 +1,119 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+type OrderArchiveRepository interface {
+	Search(ctx context.Context, filter domain.ArchiveFilter, lastID int64, limit int) ([]domain.ArchivedOrder, error)
+	Purge(ctx context.Context, archivedBefore time.Time) (int64, error)
+}
+
+// archivedOrderRow is an orders_archive table row with the refund details of
+// the order, they are NULL when the order was not refunded.
+type archivedOrderRow struct {
+	orderRow
+	ArchivedAt      time.Time  `db:"archived_at"`
+	RefundReason    *string    `db:"refund_reason"`
+	RefundComment   *string    `db:"refund_comment"`
+	ItemCondition   *string    `db:"item_condition"`
+	RefundCreatedAt *time.Time `db:"refund_created_at"`
+}
+
+func (r archivedOrderRow) refund() *domain.RefundDetails {
+	if r.RefundReason == nil {
+		return nil
+	}
+
+	return &domain.RefundDetails{
+		OrderID:    r.OrderID,
+		ReasonCode: *r.RefundReason,
+		Comment:    deref(r.RefundComment),
+		Condition:  domain.ItemCondition(deref(r.ItemCondition)),
+		CreatedAt:  *r.RefundCreatedAt,
+	}
+}
+
+type OrderArchiveRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewOrderArchiveRepositoryImpl(tx *tx_manager.TxManager) *OrderArchiveRepositoryImpl {
+	return &OrderArchiveRepositoryImpl{
+		tx: tx,
+	}
+}
+
+// Search returns archived orders matching the filter ordered by order id,
+// starting after lastID. Refunded orders come with their refund details.
+func (r *OrderArchiveRepositoryImpl) Search(
+	ctx context.Context,
+	filter domain.ArchiveFilter,
+	lastID int64,
+	limit int,
+) ([]domain.ArchivedOrder, error) {
+	query := `
+		SELECT a.order_id, a.user_id, a.expiration_date, a.status, a.last_changed_at, a.weight, a.cost,
+		       a.packaging, a.package_type, a.is_additional_film, a.base_cost, a.packaging_cost, a.length,
+		       a.width, a.height, a.currency, a.pickup_point_id, a.archived_at,
+		       r.reason_code AS refund_reason, r.comment AS refund_comment, r.item_condition,
+		       r.created_at AS refund_created_at
+		FROM orders_archive a
+		LEFT JOIN refund_details r ON r.order_id = a.order_id
+		WHERE a.order_id > $1`
+	values := []interface{}{lastID}
+	if filter.OrderID != nil {
+		values = append(values, *filter.OrderID)
+		query += fmt.Sprintf(" AND a.order_id = $%d", len(values))
+	}
+	if filter.UserID != nil {
+		values = append(values, *filter.UserID)
+		query += fmt.Sprintf(" AND a.user_id = $%d", len(values))
+	}
+	if filter.PickupPointID != nil {
+		values = append(values, *filter.PickupPointID)
+		query += fmt.Sprintf(" AND a.pickup_point_id = $%d", len(values))
+	}
+	if filter.ArchivedFrom != nil {
+		values = append(values, *filter.ArchivedFrom)
+		query += fmt.Sprintf(" AND a.archived_at >= $%d", len(values))
+	}
+	if filter.ArchivedTo != nil {
+		values = append(values, *filter.ArchivedTo)
+		query += fmt.Sprintf(" AND a.archived_at < $%d", len(values))
+	}
+	values = append(values, limit)
+	query += fmt.Sprintf(" ORDER BY a.order_id LIMIT $%d;", len(values))
+
+	var rows []archivedOrderRow
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &rows, query, values...); err != nil {
+		return nil, fmt.Errorf("select archived orders: %w", err)
+	}
+
+	orders := make([]domain.ArchivedOrder, len(rows))
+	for i, row := range rows {
+		order := row.toDomain()
+		order.Refund = row.refund()
+		orders[i] = domain.ArchivedOrder{Order: order, ArchivedAt: row.ArchivedAt}
+	}
+
+	return orders, nil
+}
+
+// Purge removes orders archived before the given moment and reports how many
+// rows were removed.
+func (r *OrderArchiveRepositoryImpl) Purge(ctx context.Context, archivedBefore time.Time) (int64, error) {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		DELETE FROM orders_archive
+		WHERE archived_at < $1;`, archivedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("purge archived orders: %w", err)
+	}
+
+	return execResult.RowsAffected(), nil
+}
@@ -2,41 +2,60 @@ package postgresql
 
 import (
 	"context"
+	"fmt"
+
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/db"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
 )
 
-type OrderStatusAuditRepository interface {
-	Create(ctx context.Context, job domain.AuditOrderInfo) (int64, error)
-}
-
 type OrderStatusAuditRepositoryImpl struct {
-	db db.DB
+	tx     *tx_manager.TxManager
+	outbox OutboxRepository
 }
 
-func NewOrderStatusAuditRepositoryImpl(database db.DB) *OrderStatusAuditRepositoryImpl {
-	return &OrderStatusAuditRepositoryImpl{db: database}
+func NewOrderStatusAuditRepositoryImpl(tx *tx_manager.TxManager) *OrderStatusAuditRepositoryImpl {
+	return &OrderStatusAuditRepositoryImpl{
+		tx:     tx,
+		outbox: NewOutboxRepositoryImpl(tx),
+	}
 }
 
+// Create writes the audit entry and the outbox task that publishes it. Both
+// use the transaction in ctx, so they are committed or rolled back together
+// with the status change they record.
 func (a *OrderStatusAuditRepositoryImpl) Create(ctx context.Context, job domain.AuditOrderInfo) (int64, error) {
 	var entryID int64
 
+	var refundReason, refundComment, itemCondition *string
+	if job.Refund != nil {
+		condition := string(job.Refund.Condition)
+		refundReason, refundComment, itemCondition = &job.Refund.ReasonCode, &job.Refund.Comment, &condition
+	}
+
 	query := `
 		INSERT INTO order_status_audit (
-			order_id, previous_status, current_status
+			order_id, previous_status, current_status, refund_reason, refund_comment, item_condition, incident_id
 		) VALUES (
-			$1, $2, $3
+			$1, $2, $3, $4, $5, $6, $7
 		) returning entry_id;
 	`
 
-	err := a.db.ExecQueryRow(ctx,
+	err := a.tx.GetQueryEngine(ctx).ExecQueryRow(ctx,
 		query,
 		job.OrderID,
 		job.PreviousStatus,
 		job.CurrentStatus,
+		refundReason,
+		refundComment,
+		itemCondition,
+		job.IncidentID,
 	).Scan(&entryID)
 
 	if err != nil {
+		return 0, fmt.Errorf("insert order status audit: %w", err)
+	}
+
+	if _, err := a.outbox.Create(ctx, entryID, domain.OrderStatusLog); err != nil {
 		return 0, err
 	}
 
@@ -6,12 +6,17 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/jackc/pgconn"
 	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/cache"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/db"
 	mock_database "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/db/mocks"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
 	"testing"
 	"time"
 )
 
-func TestOrderRepo_Delete(t *testing.T) {
+func TestOrderRepo_Archive(t *testing.T) {
 	t.Parallel()
 
 	var (
@@ -35,10 +40,10 @@ func TestOrderRepo_Delete(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockDb := mock_database.NewMockDB(ctrl)
-			mockDb.EXPECT().Exec(ctx, `DELETE FROM orders WHERE order_id = $1;`, correctValues.OrderId).Return(pgconn.CommandTag("DELETE 0"), nil)
-			repo := NewOrdersRepo(mockDb)
+			mockDb.EXPECT().Exec(ctx, archiveOrderQuery, correctValues.OrderId, domain.ReturnedToCourier).Return(pgconn.CommandTag("INSERT 0 0"), nil)
+			repo := newTestOrdersRepo(mockDb)
 
-			err := repo.Delete(ctx, correctValues.OrderId)
+			err := repo.Archive(ctx, correctValues.OrderId, domain.ReturnedToCourier)
 
 			require.EqualError(t, err, "order not found")
 		})
@@ -75,11 +80,22 @@ func TestOrderRepo_Find(t *testing.T) {
 		status,
 		weight,
 		cost,
-		last_changed_at
+		last_changed_at,
+		arrived_at,
+		packaging,
+		package_type,
+		is_additional_film,
+		base_cost,
+		packaging_cost,
+		length,
+		width,
+		height,
+		currency,
+		pickup_point_id
 	FROM orders
 	WHERE order_id = $1;
 	`, gomock.Any()).Return(sql.ErrNoRows)
-			repo := NewOrdersRepo(mockDb)
+			repo := newTestOrdersRepo(mockDb)
 
 			_, err := repo.Find(ctx, correctValues.UserID)
 
@@ -87,3 +103,7 @@ func TestOrderRepo_Find(t *testing.T) {
 		})
 	})
 }
+
+func newTestOrdersRepo(database db.DB) *OrderRepo {
+	return NewOrdersRepo(tx_manager.NewTxManager(database), cache.NewCacheClient(&config.Config{}, 0))
+}
@@ -2,15 +2,38 @@ package postgresql
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events"
+	"time"
 )
 
+// reclaimExpiredLeasesQuery gives PROCESSING tasks with an expired lease back
+// to the fetch. The lost lease counts as a failed attempt with the given last
+// error, so a task that crashes its worker every time runs out of attempts
+// like any other failure.
+const reclaimExpiredLeasesQuery = `
+UPDATE outbox
+   SET attempts_count   = attempts_count + 1,
+       task_status      = 'FAILED',
+       next_attempt_at  = NOW(),
+       last_error       = $1,
+       lease_owner      = NULL,
+       lease_expires_at = NULL,
+       updated_at       = NOW()
+ WHERE task_status = 'PROCESSING'
+   AND lease_expires_at <= NOW()
+`
+
 type OutboxRepository interface {
 	Create(ctx context.Context, entryID int64, taskType domain.TaskType) (int64, error)
-	FetchAndMarkProcessing(ctx context.Context, limit int) ([]domain.Task, error)
-	DeleteSuccessful(ctx context.Context, tasks []domain.Task, failedIDs []int64) error
+	FetchAndMarkProcessing(ctx context.Context, owner string, lease time.Duration, limit int) ([]domain.Task, error)
+	DeleteSuccessful(ctx context.Context, owner string, taskIDs []int64) error
+	Retry(ctx context.Context, owner string, taskID int64, nextAttemptAt time.Time, lastError string) error
+	MoveToDeadLetters(ctx context.Context, owner string, taskID int64, attempts int, lastError string) error
+	ReclaimExpired(ctx context.Context, lastError string) (int64, error)
 }
 
 type OutboxRepositoryImpl struct {
@@ -22,14 +45,19 @@ func NewOutboxRepositoryImpl(tx *tx_manager.TxManager) *OutboxRepositoryImpl {
 		tx: tx,
 	}
 }
+
+// Create adds a task publishing the entry. The trace context of ctx is kept
+// with the task, so the event continues the trace of the operation.
 func (r *OutboxRepositoryImpl) Create(ctx context.Context, entryID int64, taskType domain.TaskType) (int64, error) {
 	var id int64
+	trace := events.TraceFromContext(ctx)
 	query := `
-		INSERT INTO outbox (entry_id, task_type, task_status, created_at)
-		VALUES ($1, $2, $3, NOW())
+		INSERT INTO outbox (entry_id, task_type, task_status, trace_parent, trace_state, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
 		RETURNING task_id;
 	`
-	err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, query, entryID, taskType, domain.Created).Scan(&id)
+	err := r.tx.GetQueryEngine(ctx).
+		ExecQueryRow(ctx, query, entryID, taskType, domain.Created, trace.TraceParent, trace.TraceState).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("create outbox task: %w", err)
 	}
@@ -37,79 +65,231 @@ func (r *OutboxRepositoryImpl) Create(ctx context.Context, entryID int64, taskTy
 	return id, nil
 }
 
-func (r *OutboxRepositoryImpl) FetchAndMarkProcessing(ctx context.Context, limit int) ([]domain.Task, error) {
+// outboxTaskRow is a fetched task with the entry it publishes. Only the
+// columns of the task type's entry table are set, the others are NULL.
+type outboxTaskRow struct {
+	domain.Task
+	OrderID          *int64     `db:"order_id"`
+	PreviousStatus   *string    `db:"previous_status"`
+	CurrentStatus    *string    `db:"current_status"`
+	RefundReason     *string    `db:"refund_reason"`
+	RefundComment    *string    `db:"refund_comment"`
+	ItemCondition    *string    `db:"item_condition"`
+	IncidentID       *int64     `db:"incident_id"`
+	StatusCreatedAt  *time.Time `db:"status_created_at"`
+	Method           *string    `db:"method"`
+	Path             *string    `db:"path"`
+	RequestHeader    []byte     `db:"request_header"`
+	RequestBody      []byte     `db:"request_body"`
+	QueryParams      []byte     `db:"query_params"`
+	StatusCode       *int       `db:"status_code"`
+	ResponseBody     []byte     `db:"response_body"`
+	RequestCreatedAt *time.Time `db:"request_created_at"`
+}
+
+// FetchAndMarkProcessing leases up to limit due tasks to owner for the lease
+// duration. Tasks leased by other owners are skipped.
+func (r *OutboxRepositoryImpl) FetchAndMarkProcessing(
+	ctx context.Context,
+	owner string,
+	lease time.Duration,
+	limit int,
+) ([]domain.Task, error) {
 	const q = `
 WITH cte AS (
-    SELECT task_id
-      FROM outbox
-     WHERE (task_status = 'CREATED' OR task_status = 'FAILED')
-       AND attempts_count < 3
-       AND next_attempt_at <= NOW()
-     ORDER BY created_at
+    SELECT o.task_id,
+           a.order_id, a.previous_status, a.current_status, a.refund_reason, a.refund_comment,
+           a.item_condition, a.incident_id, a.created_at AS status_created_at,
+           l.method, l.path, l.request_header, l.request_body, l.query_params, l.status_code,
+           l.response_body, l.created_at AS request_created_at
+      FROM outbox o
+      LEFT JOIN order_status_audit a
+        ON o.task_type = 'ORDER_STATUS_LOG' AND a.entry_id = o.entry_id
+      LEFT JOIN audit_logs l
+        ON o.task_type = 'AUDIT_LOG' AND l.entry_id = o.entry_id
+     WHERE (o.task_status = 'CREATED' OR o.task_status = 'FAILED')
+       AND o.next_attempt_at <= NOW()
+     ORDER BY o.created_at
      LIMIT $1
-     FOR UPDATE SKIP LOCKED
+     FOR UPDATE OF o SKIP LOCKED
 )
 UPDATE outbox
-   SET task_status = 'PROCESSING',
-       updated_at  = NOW()
- WHERE task_id IN (SELECT task_id FROM cte)
-RETURNING task_id, task_status, task_type, entry_id,
-          attempts_count, next_attempt_at
+   SET task_status      = 'PROCESSING',
+       lease_owner      = $2,
+       lease_expires_at = NOW() + make_interval(secs => $3),
+       updated_at       = NOW()
+  FROM cte
+ WHERE outbox.task_id = cte.task_id
+RETURNING outbox.task_id, outbox.task_status, outbox.task_type, outbox.entry_id,
+          outbox.attempts_count, outbox.next_attempt_at, outbox.lease_owner, outbox.lease_expires_at,
+          COALESCE(outbox.last_error, '') AS last_error,
+          outbox.trace_parent, outbox.trace_state,
+          cte.order_id, cte.previous_status, cte.current_status, cte.refund_reason, cte.refund_comment,
+          cte.item_condition, cte.incident_id, cte.status_created_at,
+          cte.method, cte.path, cte.request_header, cte.request_body, cte.query_params, cte.status_code,
+          cte.response_body, cte.request_created_at
 `
-	var tasks []domain.Task
+	var rows []outboxTaskRow
 	if err := r.tx.GetQueryEngine(ctx).
-		Select(ctx, &tasks, q, limit); err != nil {
+		Select(ctx, &rows, q, limit, owner, lease.Seconds()); err != nil {
 		return nil, fmt.Errorf("fetch tasks: %w", err)
 	}
 
+	tasks := make([]domain.Task, 0, len(rows))
+	for _, row := range rows {
+		task := row.Task
+		task.StatusChange = row.statusChange()
+		request, err := row.request()
+		if err != nil {
+			return nil, fmt.Errorf("read audit entry %d of task %d: %w", task.EntryID, task.TaskID, err)
+		}
+		task.Request = request
+		tasks = append(tasks, task)
+	}
+
 	return tasks, nil
 }
 
-func (r *OutboxRepositoryImpl) DeleteSuccessful(ctx context.Context, tasks []domain.Task, failedIDs []int64) error {
-	var successIDs []int64
-	for _, t := range tasks {
-		var failed bool
-		for _, fid := range failedIDs {
-			if t.TaskID == fid {
-				failed = true
+func (row outboxTaskRow) statusChange() *domain.AuditOrderInfo {
+	if row.OrderID == nil {
+		return nil
+	}
 
-				break
-			}
-		}
-		if !failed {
-			successIDs = append(successIDs, t.TaskID)
+	change := domain.NewAuditOrderInfo(
+		*row.OrderID, domain.Status(deref(row.PreviousStatus)), domain.Status(deref(row.CurrentStatus)))
+	change.EntryID, change.IncidentID = row.EntryID, row.IncidentID
+	if row.StatusCreatedAt != nil {
+		change.CreatedAt = *row.StatusCreatedAt
+	}
+	if row.RefundReason != nil {
+		change.Refund = &domain.RefundDetails{
+			OrderID:    *row.OrderID,
+			ReasonCode: *row.RefundReason,
+			Comment:    deref(row.RefundComment),
+			Condition:  domain.ItemCondition(deref(row.ItemCondition)),
 		}
 	}
 
-	if len(successIDs) > 0 {
-		delQuery := `DELETE FROM outbox WHERE task_id = ANY($1)`
-		if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, delQuery, successIDs); err != nil {
-			return fmt.Errorf("delete successful tasks: %w", err)
+	return change
+}
+
+func (row outboxTaskRow) request() (*domain.AuditLogRecord, error) {
+	if row.Method == nil {
+		return nil, nil
+	}
+
+	request := &domain.AuditLogRecord{
+		EntryID:      row.EntryID,
+		Method:       *row.Method,
+		Path:         deref(row.Path),
+		RequestBody:  row.RequestBody,
+		ResponseBody: row.ResponseBody,
+	}
+	if row.StatusCode != nil {
+		request.StatusCode = *row.StatusCode
+	}
+	if row.RequestCreatedAt != nil {
+		request.CreatedAt = *row.RequestCreatedAt
+	}
+	if len(row.RequestHeader) > 0 {
+		if err := json.Unmarshal(row.RequestHeader, &request.RequestHeader); err != nil {
+			return nil, err
+		}
+	}
+	if len(row.QueryParams) > 0 {
+		if err := json.Unmarshal(row.QueryParams, &request.QueryParams); err != nil {
+			return nil, err
 		}
 	}
-	if len(failedIDs) > 0 {
-		const updQuery = `
+
+	return request, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// DeleteSuccessful removes published tasks. Only tasks still leased to owner
+// are touched, a task whose lease expired may already be processed by another
+// owner.
+func (r *OutboxRepositoryImpl) DeleteSuccessful(ctx context.Context, owner string, taskIDs []int64) error {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+
+	delQuery := `DELETE FROM outbox WHERE task_id = ANY($1) AND lease_owner = $2`
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, delQuery, taskIDs, owner); err != nil {
+		return fmt.Errorf("delete successful tasks: %w", err)
+	}
+
+	return nil
+}
+
+// Retry counts the failed attempt of a task leased to owner and schedules the
+// next one.
+func (r *OutboxRepositoryImpl) Retry(
+	ctx context.Context,
+	owner string,
+	taskID int64,
+	nextAttemptAt time.Time,
+	lastError string,
+) error {
+	const updQuery = `
 UPDATE outbox
-   SET attempts_count = attempts_count + 1,
-       task_status = CASE
-         WHEN attempts_count + 1 >= 3 THEN 'NO_ATTEMPTS_LEFT'
-         ELSE 'FAILED'
-       END,
-       next_attempt_at = CASE
-         WHEN attempts_count + 1 < 3 THEN NOW() + INTERVAL '2 seconds'
-         ELSE next_attempt_at
-       END,
-       finished_at = CASE
-         WHEN attempts_count + 1 >= 3 THEN NOW()
-         ELSE finished_at
-       END,
-       updated_at = NOW()
- WHERE task_id = ANY($1)
+   SET attempts_count   = attempts_count + 1,
+       task_status      = 'FAILED',
+       next_attempt_at  = $3,
+       last_error       = $4,
+       lease_owner      = NULL,
+       lease_expires_at = NULL,
+       updated_at       = NOW()
+ WHERE task_id = $1 AND lease_owner = $2
 `
-		if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, updQuery, failedIDs); err != nil {
-			return fmt.Errorf("update failed tasks: %w", err)
-		}
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, updQuery, taskID, owner, nextAttemptAt, lastError); err != nil {
+		return fmt.Errorf("update failed task: %w", err)
 	}
 
 	return nil
 }
+
+// MoveToDeadLetters moves a task leased to owner out of the outbox with the
+// number of its failed attempts, its last error and its trace context.
+func (r *OutboxRepositoryImpl) MoveToDeadLetters(
+	ctx context.Context,
+	owner string,
+	taskID int64,
+	attempts int,
+	lastError string,
+) error {
+	const moveQuery = `
+WITH task AS (
+    DELETE FROM outbox
+     WHERE task_id = $1 AND lease_owner = $2
+    RETURNING task_id, task_type, entry_id, created_at, trace_parent, trace_state
+)
+INSERT INTO outbox_dead_letters (task_id, task_type, entry_id, attempts_count, last_error, created_at,
+                                 trace_parent, trace_state)
+SELECT task_id, task_type, entry_id, $3, $4, created_at, trace_parent, trace_state
+  FROM task
+`
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, moveQuery, taskID, owner, attempts, lastError); err != nil {
+		return fmt.Errorf("move task to dead letters: %w", err)
+	}
+
+	return nil
+}
+
+// ReclaimExpired makes tasks whose lease expired claimable again, recording
+// lastError as their last error, and reports how many tasks were reclaimed.
+func (r *OutboxRepositoryImpl) ReclaimExpired(ctx context.Context, lastError string) (int64, error) {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, reclaimExpiredLeasesQuery, lastError)
+	if err != nil {
+		return 0, fmt.Errorf("reclaim expired outbox leases: %w", err)
+	}
+
+	return execResult.RowsAffected(), nil
+}
@@ -0,0 +1,63 @@
+package postgresql
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+	mock_database "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/db/mocks"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+	"testing"
+)
+
+func TestOutboxRepo_ReclaimExpired(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+	)
+
+	ctrl := gomock.NewController(t)
+	mockDb := mock_database.NewMockDB(ctrl)
+	mockDb.EXPECT().Exec(ctx, reclaimExpiredLeasesQuery, "lease expired").Return(pgconn.CommandTag("UPDATE 3"), nil)
+	repo := NewOutboxRepositoryImpl(tx_manager.NewTxManager(mockDb))
+
+	reclaimed, err := repo.ReclaimExpired(ctx, "lease expired")
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3), reclaimed)
+}
+
+func TestOutboxRepo_DeleteSuccessful(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+	)
+	const owner = "replica-1"
+
+	t.Run("only leased tasks", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		mockDb := mock_database.NewMockDB(ctrl)
+		mockDb.EXPECT().Exec(ctx, gomock.Any(), []int64{1, 3}, owner).Return(pgconn.CommandTag("DELETE 2"), nil)
+		repo := NewOutboxRepositoryImpl(tx_manager.NewTxManager(mockDb))
+
+		err := repo.DeleteSuccessful(ctx, owner, []int64{1, 3})
+
+		require.NoError(t, err)
+	})
+	t.Run("nothing published", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		mockDb := mock_database.NewMockDB(ctrl)
+		mockDb.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		repo := NewOutboxRepositoryImpl(tx_manager.NewTxManager(mockDb))
+
+		err := repo.DeleteSuccessful(ctx, owner, nil)
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+// packageRow is a packages table row.
+type packageRow struct {
+	domain.PackageSpec
+	Price    int64           `db:"price"`
+	Currency domain.Currency `db:"currency"`
+}
+
+type PackageRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewPackageRepositoryImpl(tx *tx_manager.TxManager) *PackageRepositoryImpl {
+	return &PackageRepositoryImpl{
+		tx: tx,
+	}
+}
+
+func (r *PackageRepositoryImpl) FindAll(ctx context.Context) ([]domain.PackageSpec, error) {
+	var rows []packageRow
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &rows, `
+		SELECT name, max_weight, max_volume, max_length, price, currency, can_wrap, is_active
+		FROM packages
+		ORDER BY name;
+	`); err != nil {
+		return nil, fmt.Errorf("select packages: %w", err)
+	}
+
+	packages := make([]domain.PackageSpec, len(rows))
+	for i, row := range rows {
+		packages[i] = row.PackageSpec
+		packages[i].Price = domain.Money{Amount: row.Price, Currency: row.Currency}
+	}
+
+	return packages, nil
+}
+
+func (r *PackageRepositoryImpl) Upsert(ctx context.Context, spec domain.PackageSpec) error {
+	const query = `
+		INSERT INTO packages (name, max_weight, max_volume, max_length, price, currency, can_wrap, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (name) DO UPDATE
+		SET max_weight = EXCLUDED.max_weight,
+		    max_volume = EXCLUDED.max_volume,
+		    max_length = EXCLUDED.max_length,
+		    price      = EXCLUDED.price,
+		    currency   = EXCLUDED.currency,
+		    can_wrap   = EXCLUDED.can_wrap,
+		    is_active  = EXCLUDED.is_active;
+	`
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, query,
+		spec.Name,
+		spec.MaxWeight,
+		spec.MaxVolume,
+		spec.MaxLength,
+		spec.Price.Amount,
+		spec.Price.Currency,
+		spec.CanWrap,
+		spec.IsActive,
+	); err != nil {
+		return fmt.Errorf("upsert package: %w", err)
+	}
+
+	return nil
+}
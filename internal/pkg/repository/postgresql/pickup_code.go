@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+type PickupCodeRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewPickupCodeRepositoryImpl(tx *tx_manager.TxManager) *PickupCodeRepositoryImpl {
+	return &PickupCodeRepositoryImpl{
+		tx: tx,
+	}
+}
+
+// Save stores a new code of the order replacing the previous one. Failed
+// attempts and an active lock are kept, otherwise a new code would lift them.
+func (r *PickupCodeRepositoryImpl) Save(ctx context.Context, code domain.PickupCode) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO pickup_codes (order_id, code_hash)
+		VALUES ($1, $2)
+		ON CONFLICT (order_id) DO UPDATE
+		SET code_hash  = EXCLUDED.code_hash,
+		    used_at    = NULL,
+		    created_at = NOW();`,
+		code.OrderID,
+		code.CodeHash,
+	); err != nil {
+		return fmt.Errorf("save pickup code: %w", err)
+	}
+
+	return nil
+}
+
+// Find locks the code row so that concurrent attempts are counted one by one.
+func (r *PickupCodeRepositoryImpl) Find(ctx context.Context, orderID int64) (domain.PickupCode, error) {
+	var code domain.PickupCode
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &code, `
+		SELECT order_id, code_hash, failed_attempts, locked_until, used_at, created_at
+		FROM pickup_codes
+		WHERE order_id = $1
+		FOR UPDATE;`, orderID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.PickupCode{}, domain.ErrPickupCodeNotIssued
+		}
+
+		return domain.PickupCode{}, fmt.Errorf("select pickup code: %w", err)
+	}
+
+	return code, nil
+}
+
+func (r *PickupCodeRepositoryImpl) Update(ctx context.Context, code domain.PickupCode) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE pickup_codes
+		SET failed_attempts = $2,
+		    locked_until    = $3,
+		    used_at         = $4
+		WHERE order_id = $1;`,
+		code.OrderID,
+		code.FailedAttempts,
+		code.LockedUntil,
+		code.UsedAt,
+	); err != nil {
+		return fmt.Errorf("update pickup code: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PickupCodeRepositoryImpl) CreateOverride(ctx context.Context, override domain.PickupOverride) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO pickup_overrides (order_id, supervisor_id, reason)
+		VALUES ($1, $2, $3)
+		RETURNING entry_id;`,
+		override.OrderID,
+		override.SupervisorID,
+		override.Reason,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("insert pickup override: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PickupCodeRepositoryImpl) CreateReissue(ctx context.Context, reissue domain.PickupCodeReissue) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO pickup_code_reissues (order_id, user_id, supervisor_id)
+		VALUES ($1, $2, $3)
+		RETURNING entry_id;`,
+		reissue.OrderID,
+		reissue.UserID,
+		reissue.SupervisorID,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("insert pickup code reissue: %w", err)
+	}
+
+	return id, nil
+}
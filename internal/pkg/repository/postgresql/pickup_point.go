@@ -0,0 +1,117 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+const foreignKeyViolationCode = "23503"
+
+type PickupPointRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewPickupPointRepositoryImpl(tx *tx_manager.TxManager) *PickupPointRepositoryImpl {
+	return &PickupPointRepositoryImpl{
+		tx: tx,
+	}
+}
+
+func (r *PickupPointRepositoryImpl) Create(ctx context.Context, point domain.PickupPoint) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO pickup_points (name, address, timezone, opens_at, closes_at, order_expiration_days)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id;`,
+		point.Name,
+		point.Address,
+		point.Timezone,
+		point.OpensAt,
+		point.ClosesAt,
+		point.OrderExpirationDays,
+	).Scan(&id); err != nil {
+		return 0, fmt.Errorf("insert pickup point: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *PickupPointRepositoryImpl) Find(ctx context.Context, id int64) (domain.PickupPoint, error) {
+	var point domain.PickupPoint
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &point, `
+		SELECT id, name, address, timezone, opens_at, closes_at, order_expiration_days
+		FROM pickup_points
+		WHERE id = $1;`, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.PickupPoint{}, domain.ErrPickupPointNotFound
+		}
+
+		return domain.PickupPoint{}, fmt.Errorf("select pickup point: %w", err)
+	}
+
+	return point, nil
+}
+
+func (r *PickupPointRepositoryImpl) FindAll(ctx context.Context) ([]domain.PickupPoint, error) {
+	var points []domain.PickupPoint
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &points, `
+		SELECT id, name, address, timezone, opens_at, closes_at, order_expiration_days
+		FROM pickup_points
+		ORDER BY id;`); err != nil {
+		return nil, fmt.Errorf("select pickup points: %w", err)
+	}
+
+	return points, nil
+}
+
+func (r *PickupPointRepositoryImpl) Update(ctx context.Context, point domain.PickupPoint) error {
+	tag, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE pickup_points
+		SET name                  = $2,
+		    address               = $3,
+		    timezone              = $4,
+		    opens_at              = $5,
+		    closes_at             = $6,
+		    order_expiration_days = $7
+		WHERE id = $1;`,
+		point.ID,
+		point.Name,
+		point.Address,
+		point.Timezone,
+		point.OpensAt,
+		point.ClosesAt,
+		point.OrderExpirationDays,
+	)
+	if err != nil {
+		return fmt.Errorf("update pickup point: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPickupPointNotFound
+	}
+
+	return nil
+}
+
+func (r *PickupPointRepositoryImpl) Delete(ctx context.Context, id int64) error {
+	tag, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `DELETE FROM pickup_points WHERE id = $1;`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return domain.ErrPickupPointHasOrders
+		}
+
+		return fmt.Errorf("delete pickup point: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrPickupPointNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+type RefundDetailsRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewRefundDetailsRepositoryImpl(tx *tx_manager.TxManager) *RefundDetailsRepositoryImpl {
+	return &RefundDetailsRepositoryImpl{
+		tx: tx,
+	}
+}
+
+// Save stores the details of the latest refund of the order.
+func (r *RefundDetailsRepositoryImpl) Save(ctx context.Context, details domain.RefundDetails) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO refund_details (order_id, reason_code, comment, item_condition)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (order_id) DO UPDATE
+		SET reason_code    = EXCLUDED.reason_code,
+		    comment        = EXCLUDED.comment,
+		    item_condition = EXCLUDED.item_condition,
+		    created_at     = NOW();`,
+		details.OrderID,
+		details.ReasonCode,
+		details.Comment,
+		details.Condition,
+	); err != nil {
+		return fmt.Errorf("save refund details: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RefundDetailsRepositoryImpl) Find(ctx context.Context, orderID int64) (domain.RefundDetails, error) {
+	var details domain.RefundDetails
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &details, `
+		SELECT order_id, reason_code, comment, item_condition, created_at
+		FROM refund_details
+		WHERE order_id = $1;`, orderID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.RefundDetails{}, domain.ErrRefundDetailsNotFound
+		}
+
+		return domain.RefundDetails{}, fmt.Errorf("select refund details: %w", err)
+	}
+
+	return details, nil
+}
@@ -0,0 +1,220 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+type ReturnManifestRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewReturnManifestRepositoryImpl(tx *tx_manager.TxManager) *ReturnManifestRepositoryImpl {
+	return &ReturnManifestRepositoryImpl{
+		tx: tx,
+	}
+}
+
+// Create stores the manifest together with its items.
+func (r *ReturnManifestRepositoryImpl) Create(ctx context.Context, manifest domain.ReturnManifest) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO return_manifests (pickup_point_id, courier_name, status)
+		VALUES ($1, $2, $3)
+		RETURNING id;`,
+		manifest.PickupPointID,
+		manifest.CourierName,
+		manifest.Status,
+	).Scan(&id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return 0, domain.ErrPickupPointNotFound
+		}
+
+		return 0, fmt.Errorf("insert return manifest: %w", err)
+	}
+
+	orderIDs := make([]int64, len(manifest.Items))
+	userIDs := make([]int64, len(manifest.Items))
+	statuses := make([]string, len(manifest.Items))
+	for i, item := range manifest.Items {
+		orderIDs[i], userIDs[i], statuses[i] = item.OrderID, item.UserID, string(item.OrderStatus)
+	}
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO return_manifest_items (manifest_id, order_id, user_id, order_status)
+		SELECT $1, order_id, user_id, order_status
+		FROM unnest($2::bigint[], $3::bigint[], $4::varchar[]) AS i (order_id, user_id, order_status);`,
+		id, orderIDs, userIDs, statuses,
+	); err != nil {
+		return 0, fmt.Errorf("insert return manifest items: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *ReturnManifestRepositoryImpl) Find(ctx context.Context, id int64) (domain.ReturnManifest, error) {
+	var manifest domain.ReturnManifest
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &manifest, `
+		SELECT id, pickup_point_id, courier_name, status, created_at, closed_at
+		FROM return_manifests
+		WHERE id = $1;`, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.ReturnManifest{}, domain.ErrReturnManifestNotFound
+		}
+
+		return domain.ReturnManifest{}, fmt.Errorf("select return manifest: %w", err)
+	}
+
+	manifests := []domain.ReturnManifest{manifest}
+	if err := r.loadItems(ctx, manifests); err != nil {
+		return domain.ReturnManifest{}, err
+	}
+
+	return manifests[0], nil
+}
+
+// FindAll returns manifests matching the filter, newest first.
+func (r *ReturnManifestRepositoryImpl) FindAll(ctx context.Context, filter domain.ManifestFilter) ([]domain.ReturnManifest, error) {
+	query := `
+		SELECT id, pickup_point_id, courier_name, status, created_at, closed_at
+		FROM return_manifests
+		WHERE 1=1`
+	var values []interface{}
+	if filter.PickupPointID != nil {
+		values = append(values, *filter.PickupPointID)
+		query += fmt.Sprintf(" AND pickup_point_id = $%d", len(values))
+	}
+	if filter.Status != nil {
+		values = append(values, *filter.Status)
+		query += fmt.Sprintf(" AND status = $%d", len(values))
+	}
+	query += " ORDER BY id DESC;"
+
+	var manifests []domain.ReturnManifest
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &manifests, query, values...); err != nil {
+		return nil, fmt.Errorf("select return manifests: %w", err)
+	}
+	if err := r.loadItems(ctx, manifests); err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
+
+func (r *ReturnManifestRepositoryImpl) loadItems(ctx context.Context, manifests []domain.ReturnManifest) error {
+	if len(manifests) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(manifests))
+	byID := make(map[int64]int, len(manifests))
+	for i, m := range manifests {
+		ids[i] = m.ID
+		byID[m.ID] = i
+		manifests[i].Items = []domain.ReturnManifestItem{}
+	}
+
+	var items []domain.ReturnManifestItem
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &items, `
+		SELECT manifest_id, order_id, user_id, order_status, scanned_at
+		FROM return_manifest_items
+		WHERE manifest_id = ANY($1)
+		ORDER BY manifest_id, order_id;`, ids); err != nil {
+		return fmt.Errorf("select return manifest items: %w", err)
+	}
+	for _, item := range items {
+		i := byID[item.ManifestID]
+		manifests[i].Items = append(manifests[i].Items, item)
+	}
+
+	return nil
+}
+
+// FindReturnableOrders lists orders of the point in one of the statuses that
+// are not in an open manifest yet.
+func (r *ReturnManifestRepositoryImpl) FindReturnableOrders(
+	ctx context.Context,
+	pickupPointID int64,
+	statuses []domain.Status,
+) ([]domain.ReturnManifestItem, error) {
+	values := make([]string, len(statuses))
+	for i, s := range statuses {
+		values[i] = string(s)
+	}
+
+	var items []domain.ReturnManifestItem
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &items, `
+		SELECT o.order_id, o.user_id, o.status AS order_status
+		FROM orders o
+		WHERE o.pickup_point_id = $1
+		  AND o.status = ANY($2)
+		  AND NOT EXISTS (SELECT 1
+		                  FROM return_manifest_items i
+		                  JOIN return_manifests m ON m.id = i.manifest_id
+		                  WHERE i.order_id = o.order_id AND m.status = $3)
+		ORDER BY o.order_id;`, pickupPointID, values, domain.ManifestOpen); err != nil {
+		return nil, fmt.Errorf("select returnable orders: %w", err)
+	}
+
+	return items, nil
+}
+
+func (r *ReturnManifestRepositoryImpl) IsInOpenManifest(ctx context.Context, orderID int64) (bool, error) {
+	var found bool
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		SELECT EXISTS (SELECT 1
+		               FROM return_manifest_items i
+		               JOIN return_manifests m ON m.id = i.manifest_id
+		               WHERE i.order_id = $1 AND m.status = $2);`,
+		orderID, domain.ManifestOpen,
+	).Scan(&found); err != nil {
+		return false, fmt.Errorf("select open manifest of order: %w", err)
+	}
+
+	return found, nil
+}
+
+// Scan marks the order as confirmed by staff. Scanning an order twice keeps
+// the first scan time.
+func (r *ReturnManifestRepositoryImpl) Scan(ctx context.Context, manifestID int64, orderID int64) error {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE return_manifest_items
+		SET scanned_at = COALESCE(scanned_at, NOW())
+		WHERE manifest_id = $1 AND order_id = $2;`, manifestID, orderID)
+	if err != nil {
+		return fmt.Errorf("scan return manifest item: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrOrderNotInManifest
+	}
+
+	return nil
+}
+
+// Close drops unscanned items, they stay at the point for the next manifest.
+func (r *ReturnManifestRepositoryImpl) Close(ctx context.Context, manifestID int64) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		DELETE FROM return_manifest_items
+		WHERE manifest_id = $1 AND scanned_at IS NULL;`, manifestID); err != nil {
+		return fmt.Errorf("delete unscanned return manifest items: %w", err)
+	}
+
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE return_manifests
+		SET status = $2, closed_at = NOW()
+		WHERE id = $1 AND status = $3;`, manifestID, domain.ManifestClosed, domain.ManifestOpen)
+	if err != nil {
+		return fmt.Errorf("close return manifest: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrReturnManifestIsClosed
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+const selectStocktakingQuery = `
+		SELECT s.id, s.pickup_point_id, s.operator, s.status, s.started_at, s.finished_at,
+		       (SELECT COUNT(*) FROM stocktaking_scans sc WHERE sc.session_id = s.id) AS scanned_count,
+		       s.expected_count, s.found_count, s.missing, s.unknown
+		FROM stocktaking_sessions s`
+
+// stocktakingRow is a stocktaking_sessions table row, the result columns are
+// null until the session is finished.
+type stocktakingRow struct {
+	domain.StocktakingSession
+	ExpectedCount *int    `db:"expected_count"`
+	FoundCount    *int    `db:"found_count"`
+	Missing       []int64 `db:"missing"`
+	Unknown       []int64 `db:"unknown"`
+}
+
+func (r stocktakingRow) toDomain() domain.StocktakingSession {
+	session := r.StocktakingSession
+	if r.ExpectedCount != nil && r.FoundCount != nil {
+		session.Result = &domain.StocktakingResult{
+			Expected: *r.ExpectedCount,
+			Found:    *r.FoundCount,
+			Missing:  nonNilIDs(r.Missing),
+			Unknown:  nonNilIDs(r.Unknown),
+		}
+	}
+
+	return session
+}
+
+type StocktakingRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewStocktakingRepositoryImpl(tx *tx_manager.TxManager) *StocktakingRepositoryImpl {
+	return &StocktakingRepositoryImpl{
+		tx: tx,
+	}
+}
+
+func (r *StocktakingRepositoryImpl) Create(ctx context.Context, session domain.StocktakingSession) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO stocktaking_sessions (pickup_point_id, operator, status)
+		VALUES ($1, $2, $3)
+		RETURNING id;`,
+		session.PickupPointID,
+		session.Operator,
+		session.Status,
+	).Scan(&id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case foreignKeyViolationCode:
+				return 0, domain.ErrPickupPointNotFound
+			case uniqueViolationCode:
+				return 0, domain.ErrStocktakingAlreadyOpen
+			}
+		}
+
+		return 0, fmt.Errorf("insert stocktaking session: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *StocktakingRepositoryImpl) Find(ctx context.Context, id int64) (domain.StocktakingSession, error) {
+	var row stocktakingRow
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &row, selectStocktakingQuery+`
+		WHERE s.id = $1;`, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.StocktakingSession{}, domain.ErrStocktakingNotFound
+		}
+
+		return domain.StocktakingSession{}, fmt.Errorf("select stocktaking session: %w", err)
+	}
+
+	return row.toDomain(), nil
+}
+
+// FindAll returns sessions matching the filter, newest first.
+func (r *StocktakingRepositoryImpl) FindAll(
+	ctx context.Context,
+	filter domain.StocktakingFilter,
+) ([]domain.StocktakingSession, error) {
+	query := selectStocktakingQuery + `
+		WHERE 1=1`
+	var values []interface{}
+	if filter.PickupPointID != nil {
+		values = append(values, *filter.PickupPointID)
+		query += fmt.Sprintf(" AND s.pickup_point_id = $%d", len(values))
+	}
+	if filter.Status != nil {
+		values = append(values, *filter.Status)
+		query += fmt.Sprintf(" AND s.status = $%d", len(values))
+	}
+	query += " ORDER BY s.id DESC;"
+
+	var rows []stocktakingRow
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &rows, query, values...); err != nil {
+		return nil, fmt.Errorf("select stocktaking sessions: %w", err)
+	}
+
+	sessions := make([]domain.StocktakingSession, len(rows))
+	for i, row := range rows {
+		sessions[i] = row.toDomain()
+	}
+
+	return sessions, nil
+}
+
+// AddScans records scanned parcels. Scanning a parcel twice keeps the first
+// scan time.
+func (r *StocktakingRepositoryImpl) AddScans(ctx context.Context, sessionID int64, orderIDs []int64) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO stocktaking_scans (session_id, order_id)
+		SELECT $1, order_id
+		FROM unnest($2::bigint[]) AS s (order_id)
+		ON CONFLICT (session_id, order_id) DO NOTHING;`, sessionID, orderIDs); err != nil {
+		return fmt.Errorf("insert stocktaking scans: %w", err)
+	}
+
+	return nil
+}
+
+func (r *StocktakingRepositoryImpl) Scanned(ctx context.Context, sessionID int64) ([]int64, error) {
+	var ids []int64
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &ids, `
+		SELECT order_id
+		FROM stocktaking_scans
+		WHERE session_id = $1
+		ORDER BY order_id;`, sessionID); err != nil {
+		return nil, fmt.Errorf("select stocktaking scans: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Finish stores the result and finishes the session.
+func (r *StocktakingRepositoryImpl) Finish(
+	ctx context.Context,
+	sessionID int64,
+	result domain.StocktakingResult,
+) error {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		UPDATE stocktaking_sessions
+		SET status = $2, finished_at = NOW(), expected_count = $3, found_count = $4, missing = $5, unknown = $6
+		WHERE id = $1 AND status = $7;`,
+		sessionID,
+		domain.StocktakingFinished,
+		result.Expected,
+		result.Found,
+		nonNilIDs(result.Missing),
+		nonNilIDs(result.Unknown),
+		domain.StocktakingOpen,
+	)
+	if err != nil {
+		return fmt.Errorf("finish stocktaking session: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrStocktakingIsFinished
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+const uniqueViolationCode = "23505"
+
+type StorageCellRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewStorageCellRepositoryImpl(tx *tx_manager.TxManager) *StorageCellRepositoryImpl {
+	return &StorageCellRepositoryImpl{
+		tx: tx,
+	}
+}
+
+func (r *StorageCellRepositoryImpl) Create(ctx context.Context, cell domain.StorageCell) (int64, error) {
+	var id int64
+	if err := r.tx.GetQueryEngine(ctx).ExecQueryRow(ctx, `
+		INSERT INTO storage_cells (pickup_point_id, code, max_weight, max_volume, max_length)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id;`,
+		cell.PickupPointID,
+		cell.Code,
+		cell.MaxWeight,
+		cell.MaxVolume,
+		cell.MaxLength,
+	).Scan(&id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case uniqueViolationCode:
+				return 0, domain.ErrStorageCellAlreadyExists
+			case foreignKeyViolationCode:
+				return 0, domain.ErrPickupPointNotFound
+			}
+		}
+
+		return 0, fmt.Errorf("insert storage cell: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *StorageCellRepositoryImpl) FindAll(ctx context.Context, pickupPointID int64) ([]domain.StorageCell, error) {
+	var cells []domain.StorageCell
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &cells, `
+		SELECT id, pickup_point_id, code, max_weight, max_volume, max_length, order_id
+		FROM storage_cells
+		WHERE pickup_point_id = $1
+		ORDER BY code;`, pickupPointID); err != nil {
+		return nil, fmt.Errorf("select storage cells: %w", err)
+	}
+
+	return cells, nil
+}
+
+func (r *StorageCellRepositoryImpl) FindByOrder(ctx context.Context, orderID int64) (domain.StorageCell, error) {
+	var cell domain.StorageCell
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &cell, `
+		SELECT id, pickup_point_id, code, max_weight, max_volume, max_length, order_id
+		FROM storage_cells
+		WHERE order_id = $1;`, orderID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.StorageCell{}, domain.ErrStorageCellNotFound
+		}
+
+		return domain.StorageCell{}, fmt.Errorf("select storage cell: %w", err)
+	}
+
+	return cell, nil
+}
+
+// Assign puts the order into the smallest free cell of the point the parcel
+// fits into. Cells locked by concurrent assignments are skipped.
+func (r *StorageCellRepositoryImpl) Assign(
+	ctx context.Context,
+	pickupPointID int64,
+	orderID int64,
+	weight int,
+	dimensions domain.Dimensions,
+) (domain.StorageCell, error) {
+	var cell domain.StorageCell
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &cell, `
+		UPDATE storage_cells
+		SET order_id = $2
+		WHERE id = (
+			SELECT id
+			FROM storage_cells
+			WHERE pickup_point_id = $1
+			  AND order_id IS NULL
+			  AND (max_weight = 0 OR max_weight >= $3)
+			  AND (max_volume = 0 OR max_volume >= $4)
+			  AND (max_length = 0 OR max_length >= $5)
+			ORDER BY max_volume = 0, max_volume, max_weight = 0, max_weight, code
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, pickup_point_id, code, max_weight, max_volume, max_length, order_id;`,
+		pickupPointID,
+		orderID,
+		weight,
+		dimensions.Volume(),
+		dimensions.LongestSide(),
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.StorageCell{}, domain.ErrNoFreeStorageCell
+		}
+
+		return domain.StorageCell{}, fmt.Errorf("assign storage cell: %w", err)
+	}
+
+	return cell, nil
+}
+
+// Release frees the cell of the order and returns it as it was before.
+func (r *StorageCellRepositoryImpl) Release(ctx context.Context, orderID int64) (domain.StorageCell, error) {
+	var cell domain.StorageCell
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &cell, `
+		UPDATE storage_cells
+		SET order_id = NULL
+		WHERE order_id = $1
+		RETURNING id, pickup_point_id, code, max_weight, max_volume, max_length, $1::bigint AS order_id;`,
+		orderID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.StorageCell{}, domain.ErrStorageCellNotFound
+		}
+
+		return domain.StorageCell{}, fmt.Errorf("release storage cell: %w", err)
+	}
+
+	return cell, nil
+}
+
+func (r *StorageCellRepositoryImpl) Occupancy(ctx context.Context) ([]domain.StorageOccupancy, error) {
+	var occupancy []domain.StorageOccupancy
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &occupancy, `
+		SELECT pickup_point_id, COUNT(*) AS total, COUNT(order_id) AS occupied
+		FROM storage_cells
+		GROUP BY pickup_point_id
+		ORDER BY pickup_point_id;`); err != nil {
+		return nil, fmt.Errorf("select storage occupancy: %w", err)
+	}
+
+	return occupancy, nil
+}
+
+func (r *StorageCellRepositoryImpl) PointOccupancy(ctx context.Context, pickupPointID int64) (domain.StorageOccupancy, error) {
+	occupancy := domain.StorageOccupancy{PickupPointID: pickupPointID}
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &occupancy, `
+		SELECT $1::bigint AS pickup_point_id, COUNT(*) AS total, COUNT(order_id) AS occupied
+		FROM storage_cells
+		WHERE pickup_point_id = $1;`, pickupPointID); err != nil {
+		return domain.StorageOccupancy{}, fmt.Errorf("select storage occupancy: %w", err)
+	}
+
+	return occupancy, nil
+}
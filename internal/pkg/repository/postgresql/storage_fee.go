@@ -0,0 +1,180 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
+)
+
+// storageTariffRow is a storage_tariffs table row.
+type storageTariffRow struct {
+	domain.StorageTariff
+	DailyRate int64           `db:"daily_rate"`
+	Currency  domain.Currency `db:"currency"`
+}
+
+// dailyRevenueRow is a day of the revenue report.
+type dailyRevenueRow struct {
+	Day             time.Time       `db:"day"`
+	PickupPointID   int64           `db:"pickup_point_id"`
+	Currency        domain.Currency `db:"currency"`
+	OrdersIssued    int             `db:"orders_issued"`
+	OrdersRevenue   int64           `db:"orders_revenue"`
+	StorageFeesPaid int             `db:"storage_fees_paid"`
+	StorageFees     int64           `db:"storage_fees"`
+}
+
+type StorageFeeRepositoryImpl struct {
+	tx *tx_manager.TxManager
+}
+
+func NewStorageFeeRepositoryImpl(tx *tx_manager.TxManager) *StorageFeeRepositoryImpl {
+	return &StorageFeeRepositoryImpl{
+		tx: tx,
+	}
+}
+
+func (r *StorageFeeRepositoryImpl) FindTariff(ctx context.Context, pickupPointID int64) (domain.StorageTariff, error) {
+	var row storageTariffRow
+	if err := r.tx.GetQueryEngine(ctx).Get(ctx, &row, `
+		SELECT pickup_point_id, free_days, daily_rate, currency
+		FROM storage_tariffs
+		WHERE pickup_point_id = $1;`, pickupPointID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, sql.ErrNoRows) {
+			return domain.StorageTariff{}, domain.ErrStorageTariffNotFound
+		}
+
+		return domain.StorageTariff{}, fmt.Errorf("select storage tariff: %w", err)
+	}
+
+	tariff := row.StorageTariff
+	tariff.DailyRate = domain.Money{Amount: row.DailyRate, Currency: row.Currency}
+
+	return tariff, nil
+}
+
+func (r *StorageFeeRepositoryImpl) SaveTariff(ctx context.Context, tariff domain.StorageTariff) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO storage_tariffs (pickup_point_id, free_days, daily_rate, currency)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (pickup_point_id) DO UPDATE
+		SET free_days  = EXCLUDED.free_days,
+		    daily_rate = EXCLUDED.daily_rate,
+		    currency   = EXCLUDED.currency,
+		    updated_at = NOW();`,
+		tariff.PickupPointID,
+		tariff.FreeDays,
+		tariff.DailyRate.Amount,
+		tariff.DailyRate.Currency,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return domain.ErrPickupPointNotFound
+		}
+
+		return fmt.Errorf("upsert storage tariff: %w", err)
+	}
+
+	return nil
+}
+
+func (r *StorageFeeRepositoryImpl) DeleteTariff(ctx context.Context, pickupPointID int64) error {
+	execResult, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		DELETE FROM storage_tariffs WHERE pickup_point_id = $1;`, pickupPointID)
+	if err != nil {
+		return fmt.Errorf("delete storage tariff: %w", err)
+	}
+	if execResult.RowsAffected() == 0 {
+		return domain.ErrStorageTariffNotFound
+	}
+
+	return nil
+}
+
+func (r *StorageFeeRepositoryImpl) SavePayment(ctx context.Context, payment domain.StorageFeePayment) error {
+	if _, err := r.tx.GetQueryEngine(ctx).Exec(ctx, `
+		INSERT INTO storage_fee_payments (order_id, pickup_point_id, charged_days, amount, currency, paid_at)
+		VALUES ($1, $2, $3, $4, $5, $6);`,
+		payment.OrderID,
+		payment.PickupPointID,
+		payment.ChargedDays,
+		payment.Amount.Amount,
+		payment.Amount.Currency,
+		payment.PaidAt,
+	); err != nil {
+		return fmt.Errorf("insert storage fee payment: %w", err)
+	}
+
+	return nil
+}
+
+// DailyRevenue sums issued orders and collected storage fees by UTC day,
+// pickup point and currency. Days without either are left out.
+func (r *StorageFeeRepositoryImpl) DailyRevenue(
+	ctx context.Context,
+	filter domain.RevenueFilter,
+) ([]domain.DailyRevenue, error) {
+	values := []interface{}{filter.From, filter.To, domain.Completed}
+	pointCondition := ""
+	if filter.PickupPointID != nil {
+		values = append(values, *filter.PickupPointID)
+		pointCondition = fmt.Sprintf(" AND pickup_point_id = $%d", len(values))
+	}
+
+	query := `
+		WITH issued AS (
+			SELECT (last_changed_at AT TIME ZONE 'UTC')::date AS day, pickup_point_id, currency,
+			       COUNT(*) AS orders_issued, SUM(cost) AS orders_revenue
+			FROM orders
+			WHERE status = $3 AND last_changed_at >= $1 AND last_changed_at < $2` + pointCondition + `
+			GROUP BY 1, 2, 3
+		), fees AS (
+			SELECT (paid_at AT TIME ZONE 'UTC')::date AS day, pickup_point_id, currency,
+			       COUNT(*) AS storage_fees_paid, SUM(amount) AS storage_fees
+			FROM storage_fee_payments
+			WHERE paid_at >= $1 AND paid_at < $2` + pointCondition + `
+			GROUP BY 1, 2, 3
+		)
+		SELECT COALESCE(i.day, f.day)::timestamp AT TIME ZONE 'UTC' AS day,
+		       COALESCE(i.pickup_point_id, f.pickup_point_id) AS pickup_point_id,
+		       COALESCE(i.currency, f.currency) AS currency,
+		       COALESCE(i.orders_issued, 0) AS orders_issued,
+		       COALESCE(i.orders_revenue, 0)::bigint AS orders_revenue,
+		       COALESCE(f.storage_fees_paid, 0) AS storage_fees_paid,
+		       COALESCE(f.storage_fees, 0)::bigint AS storage_fees
+		FROM issued i
+		FULL JOIN fees f ON f.day = i.day AND f.pickup_point_id = i.pickup_point_id AND f.currency = i.currency
+		ORDER BY 1, 2, 3;`
+
+	var rows []dailyRevenueRow
+	if err := r.tx.GetQueryEngine(ctx).Select(ctx, &rows, query, values...); err != nil {
+		return nil, fmt.Errorf("select daily revenue: %w", err)
+	}
+
+	revenue := make([]domain.DailyRevenue, 0, len(rows))
+	for _, row := range rows {
+		day := domain.DailyRevenue{
+			Date:            row.Day.UTC(),
+			PickupPointID:   row.PickupPointID,
+			OrdersIssued:    row.OrdersIssued,
+			OrdersRevenue:   domain.Money{Amount: row.OrdersRevenue, Currency: row.Currency},
+			StorageFeesPaid: row.StorageFeesPaid,
+			StorageFees:     domain.Money{Amount: row.StorageFees, Currency: row.Currency},
+		}
+		total, err := day.OrdersRevenue.Add(day.StorageFees)
+		if err != nil {
+			return nil, err
+		}
+		day.Total = total
+		revenue = append(revenue, day)
+	}
+
+	return revenue, nil
+}
@@ -0,0 +1,44 @@
+package tx_manager
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// txQueryEngine runs queries inside an open transaction so that repositories
+// called with a transaction context take part in it.
+type txQueryEngine struct {
+	tx   pgx.Tx
+	pool *pgxpool.Pool
+}
+
+func (e txQueryEngine) Get(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return pgxscan.Get(ctx, e.tx, dest, query, args...)
+}
+
+func (e txQueryEngine) Select(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	return pgxscan.Select(ctx, e.tx, dest, query, args...)
+}
+
+func (e txQueryEngine) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
+	return e.tx.Exec(ctx, query, args...)
+}
+
+func (e txQueryEngine) ExecQueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	return e.tx.QueryRow(ctx, query, args...)
+}
+
+func (e txQueryEngine) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return e.tx.Query(ctx, sql, args...)
+}
+
+func (e txQueryEngine) GetPool() *pgxpool.Pool {
+	return e.pool
+}
+
+// Close does nothing, the transaction is finished by the manager.
+func (e txQueryEngine) Close() {}
@@ -9,4 +9,5 @@ type TransactionManager interface {
 	GetQueryEngine(ctx context.Context) db.DB
 	RunReadUncommitted(ctx context.Context, fn func(ctxTx context.Context) error) error
 	RunSerializable(ctx context.Context, fn func(ctxTx context.Context) error) error
+	RunRepeatableRead(ctx context.Context, fn func(ctxTx context.Context) error) error
 }
@@ -8,6 +8,14 @@ import (
 
 type txManagerKey struct{}
 
+type txHooksKey struct{}
+
+// txHooks are callbacks waiting for the transaction to finish.
+type txHooks struct {
+	afterCommit   []func()
+	afterRollback []func()
+}
+
 type TxManager struct {
 	db db.DB
 }
@@ -43,7 +51,13 @@ func (m *TxManager) RunRepeatableRead(ctx context.Context, fn func(ctxTx context
 	return m.beginFunc(ctx, opts, fn)
 }
 
+// beginFunc runs fn in a new transaction. A nested call joins the transaction
+// that is already in ctx.
 func (m *TxManager) beginFunc(ctx context.Context, opts pgx.TxOptions, fn func(ctxTx context.Context) error) error {
+	if _, ok := ctx.Value(txManagerKey{}).(db.DB); ok {
+		return fn(ctx)
+	}
+
 	tx, err := m.db.GetPool().BeginTx(ctx, opts)
 	if err != nil {
 		return err
@@ -53,12 +67,50 @@ func (m *TxManager) beginFunc(ctx context.Context, opts pgx.TxOptions, fn func(c
 		_ = tx.Rollback(ctx)
 	}()
 
-	ctx = context.WithValue(ctx, txManagerKey{}, tx)
+	hooks := &txHooks{}
+	ctx = context.WithValue(ctx, txManagerKey{}, db.DB(txQueryEngine{tx: tx, pool: m.db.GetPool()}))
+	ctx = context.WithValue(ctx, txHooksKey{}, hooks)
 	if err := fn(ctx); err != nil {
+		_ = tx.Rollback(ctx)
+		runHooks(hooks.afterRollback)
+
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		runHooks(hooks.afterRollback)
+
 		return err
 	}
+	runHooks(hooks.afterCommit)
 
-	return tx.Commit(ctx)
+	return nil
+}
+
+// AfterCommit runs fn once the transaction in ctx is committed, right away
+// when ctx has no transaction. Side effects that must not outlive a rolled
+// back transaction, like cache writes, go here.
+func (m *TxManager) AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(txHooksKey{}).(*txHooks)
+	if !ok {
+		fn()
+
+		return
+	}
+	hooks.afterCommit = append(hooks.afterCommit, fn)
+}
+
+// AfterRollback runs fn when the transaction in ctx is rolled back. Without a
+// transaction there is nothing to roll back and fn is never run.
+func (m *TxManager) AfterRollback(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(txHooksKey{}).(*txHooks); ok {
+		hooks.afterRollback = append(hooks.afterRollback, fn)
+	}
+}
+
+func runHooks(hooks []func()) {
+	for _, hook := range hooks {
+		hook()
+	}
 }
 
 func (m *TxManager) GetQueryEngine(ctx context.Context) db.DB {
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// DefaultArchivePageSize is used when an archive search does not set a limit.
+const DefaultArchivePageSize = 50
+
+// SearchArchivedOrders looks up orders that were returned to the courier.
+// Refunded orders come with the refund details given by the customer.
+func (o *OrderServiceImpl) SearchArchivedOrders(
+	ctx context.Context,
+	filter domain.ArchiveFilter,
+	lastID *int64,
+	limit *int,
+) ([]domain.ArchivedOrder, error) {
+	var (
+		after    int64
+		pageSize = DefaultArchivePageSize
+		orders   []domain.ArchivedOrder
+	)
+	if lastID != nil {
+		after = *lastID
+	}
+	if limit != nil && *limit > 0 {
+		pageSize = *limit
+	}
+
+	if err := o.txManager.RunRepeatableRead(ctx, func(ctxTx context.Context) error {
+		var err error
+		orders, err = o.archive.Search(ctxTx, filter, after, pageSize)
+
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("o.txManager.RunRepeatableRead from SearchArchivedOrders: %w", err)
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+)
+
+// errBatchFailed rolls back an atomic batch after one of its orders failed.
+var errBatchFailed = errors.New("batch failed")
+
+// batchStep moves one order of a batch within the given transaction.
+type batchStep func(ctxTx context.Context, orderID int64) (statusChange, error)
+
+// CompleteOrders issues several orders of one customer at once. In atomic mode
+// all orders are issued in one transaction or none of them is, in partial mode
+// every order is issued on its own and failures are reported per order.
+func (o *OrderServiceImpl) CompleteOrders(
+	ctx context.Context,
+	userID int64,
+	items []domain.PickupItem,
+	mode domain.BatchMode,
+) (domain.BatchResult, error) {
+	orderIDs := make([]int64, 0, len(items))
+	codes := make(map[int64]string, len(items))
+	feesPaid := make(map[int64]bool, len(items))
+	for _, item := range items {
+		orderIDs = append(orderIDs, item.OrderID)
+		codes[item.OrderID] = item.PickupCode
+		feesPaid[item.OrderID] = item.StorageFeePaid
+	}
+
+	step := func(ctxTx context.Context, orderID int64) (statusChange, error) {
+		return o.completeInTx(ctxTx, orderID, userID, feesPaid[orderID], o.verifyPickupItem(orderID, codes[orderID], mode))
+	}
+	changes, result, err := o.runBatch(ctx, "CompleteOrders", orderIDs, mode, step, isPickupCodeRejection)
+	if err != nil {
+		return domain.BatchResult{}, err
+	}
+
+	// Wrong codes of an atomic batch are only checked in it, they are counted
+	// towards the lockout once the batch is rolled back.
+	if mode != domain.BatchPartial && len(changes) == 0 {
+		for _, item := range result.Items {
+			if isPickupCodeRejection(item.Err) {
+				if err := o.recordPickupAttempt(ctx, item.OrderID, codes[item.OrderID]); err != nil {
+					return domain.BatchResult{}, err
+				}
+			}
+		}
+	}
+
+	reported := make(map[int64]struct{})
+	for _, change := range changes {
+		if err := result.AddTotal(change.order.Cost); err != nil {
+			return domain.BatchResult{}, err
+		}
+		if change.fee != nil && change.fee.IsDue() {
+			if err := result.AddTotal(change.fee.Amount); err != nil {
+				return domain.BatchResult{}, err
+			}
+		}
+		if _, ok := reported[change.order.PickupPointID]; !ok {
+			reported[change.order.PickupPointID] = struct{}{}
+			o.reportPointOccupancy(ctx, change.order.PickupPointID)
+		}
+		o.logCompletion(change)
+	}
+
+	return result, nil
+}
+
+// RefundOrders accepts refunds of several orders of one customer for the same
+// reason. Every order must belong to the customer and be within its refund
+// period. The result total is the amount to pay back.
+func (o *OrderServiceImpl) RefundOrders(
+	ctx context.Context,
+	userID int64,
+	orderIDs []int64,
+	expirationDays int,
+	details domain.RefundDetails,
+	mode domain.BatchMode,
+) (domain.BatchResult, error) {
+	if err := o.refunds.Validate(details); err != nil {
+		return domain.BatchResult{}, err
+	}
+
+	step := func(ctxTx context.Context, orderID int64) (statusChange, error) {
+		or, err := o.repo.Find(ctxTx, orderID)
+		if err != nil {
+			return statusChange{}, domain.ErrOrderNotFound
+		}
+		if err := domain.OwnedByUser(or, o.transitionContext(userID, 0)); err != nil {
+			return statusChange{}, err
+		}
+
+		return o.refundInTx(ctxTx, or, expirationDays, details)
+	}
+	changes, result, err := o.runBatch(ctx, "RefundOrders", orderIDs, mode, step, nil)
+	if err != nil {
+		return domain.BatchResult{}, err
+	}
+
+	reported := make(map[int64]struct{})
+	for _, change := range changes {
+		if err := result.AddTotal(change.order.Cost); err != nil {
+			return domain.BatchResult{}, err
+		}
+		if _, ok := reported[change.order.PickupPointID]; !ok {
+			reported[change.order.PickupPointID] = struct{}{}
+			o.reportPointOccupancy(ctx, change.order.PickupPointID)
+		}
+		monitoring.OrdersRefundedTotal.Inc()
+	}
+
+	return result, nil
+}
+
+// runBatch applies step to every order and returns the changes that were
+// committed. Step errors matched by commit are committed in partial mode, e.g.
+// a counted pickup code attempt.
+func (o *OrderServiceImpl) runBatch(
+	ctx context.Context,
+	operation string,
+	orderIDs []int64,
+	mode domain.BatchMode,
+	step batchStep,
+	commit func(error) bool,
+) ([]statusChange, domain.BatchResult, error) {
+	if err := domain.ValidateBatch(orderIDs); err != nil {
+		return nil, domain.BatchResult{}, err
+	}
+	if commit == nil {
+		commit = func(error) bool { return false }
+	}
+
+	if mode == domain.BatchPartial {
+		changes, result := o.runBatchPartially(ctx, orderIDs, step, commit)
+
+		return changes, result, nil
+	}
+
+	return o.runBatchAtomically(ctx, operation, orderIDs, step)
+}
+
+func (o *OrderServiceImpl) runBatchPartially(
+	ctx context.Context,
+	orderIDs []int64,
+	step batchStep,
+	commit func(error) bool,
+) ([]statusChange, domain.BatchResult) {
+	var (
+		changes []statusChange
+		result  domain.BatchResult
+	)
+	for _, orderID := range orderIDs {
+		var (
+			change  statusChange
+			stepErr error
+		)
+		err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+			change, stepErr = step(ctxTx, orderID)
+			if stepErr != nil && commit(stepErr) {
+				return nil
+			}
+
+			return stepErr
+		})
+		if err == nil {
+			err = stepErr
+		}
+		result.Items = append(result.Items, domain.BatchItemResult{OrderID: orderID, Err: err})
+		if err == nil {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, result
+}
+
+func (o *OrderServiceImpl) runBatchAtomically(
+	ctx context.Context,
+	operation string,
+	orderIDs []int64,
+	step batchStep,
+) ([]statusChange, domain.BatchResult, error) {
+	var (
+		changes []statusChange
+		result  domain.BatchResult
+	)
+	err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		changes, result = nil, domain.BatchResult{}
+		failed := false
+		for _, orderID := range orderIDs {
+			change, err := step(ctxTx, orderID)
+			result.Items = append(result.Items, domain.BatchItemResult{OrderID: orderID, Err: err})
+			if err != nil {
+				failed = true
+
+				continue
+			}
+			changes = append(changes, change)
+		}
+		if failed {
+			return errBatchFailed
+		}
+
+		return nil
+	})
+	if err == nil {
+		return changes, result, nil
+	}
+	if !errors.Is(err, errBatchFailed) {
+		return nil, domain.BatchResult{}, fmt.Errorf("o.txManager.RunSerializable from %s: %w", operation, err)
+	}
+
+	for i := range result.Items {
+		if result.Items[i].OK() {
+			result.Items[i].Err = domain.ErrBatchRolledBack
+		}
+	}
+
+	return nil, result, nil
+}
+
+// verifyPickupItem counts a wrong code right away in partial mode, where the
+// attempt is committed. An atomic batch is rolled back on a wrong code, so the
+// code is only checked and the attempt is recorded afterwards.
+func (o *OrderServiceImpl) verifyPickupItem(
+	orderID int64,
+	code string,
+	mode domain.BatchMode,
+) func(ctxTx context.Context) error {
+	return func(ctxTx context.Context) error {
+		if code == "" {
+			return domain.ErrPickupCodeRequired
+		}
+		if mode == domain.BatchPartial {
+			return o.codes.Verify(ctxTx, orderID, code)
+		}
+
+		return o.codes.Check(ctxTx, orderID, code)
+	}
+}
+
+func (o *OrderServiceImpl) recordPickupAttempt(ctx context.Context, orderID int64, code string) error {
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		if err := o.codes.Verify(ctxTx, orderID, code); err != nil && !isPickupCodeRejection(err) {
+			return err
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("o.txManager.RunSerializable from CompleteOrders: %w", err)
+	}
+
+	return nil
+}
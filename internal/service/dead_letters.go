@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// GetDeadLetter returns the dead letter with the audit entry it publishes.
+func (o *OrderServiceImpl) GetDeadLetter(ctx context.Context, id int64) (domain.DeadLetter, error) {
+	return o.deadLetters.Find(ctx, id)
+}
+
+func (o *OrderServiceImpl) ListDeadLetters(
+	ctx context.Context,
+	filter domain.DeadLetterFilter,
+) ([]domain.DeadLetter, error) {
+	return o.deadLetters.FindAll(ctx, filter)
+}
+
+// RequeueDeadLetter gives the task another full round of attempts and
+// returns the id of its new outbox task.
+func (o *OrderServiceImpl) RequeueDeadLetter(ctx context.Context, id int64) (int64, error) {
+	return o.deadLetters.Requeue(ctx, id)
+}
+
+// PurgeDeadLetters removes dead letters matching the filter for good and
+// reports how many were removed.
+func (o *OrderServiceImpl) PurgeDeadLetters(ctx context.Context, filter domain.DeadLetterFilter) (int64, error) {
+	return o.deadLetters.Purge(ctx, filter)
+}
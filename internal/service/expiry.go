@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+)
+
+// ExpireOrders moves up to limit confirmed orders nobody picked up in time to
+// AwaitingReturn and reports how many orders were moved.
+func (o *OrderServiceImpl) ExpireOrders(ctx context.Context, limit int) (int, error) {
+	var expired []statusChange
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		expired = expired[:0]
+		orders, err := o.repo.FindExpired(ctxTx, time.Now(), limit)
+		if err != nil {
+			return err
+		}
+		for _, or := range orders {
+			status, err := o.sm.Fire(or, domain.ExpireAction, o.transitionContext(or.UserID, 0))
+			if err != nil {
+				return err
+			}
+			if _, err := o.repo.Update(ctxTx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost); err != nil {
+				return err
+			}
+			change := statusChange{order: or, newStatus: status}
+			if err := o.auditStatusChange(ctxTx, change); err != nil {
+				return err
+			}
+			expired = append(expired, change)
+		}
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("o.txManager.RunSerializable from ExpireOrders: %w", err)
+	}
+	monitoring.OrdersExpiredTotal.Add(float64(len(expired)))
+
+	return len(expired), nil
+}
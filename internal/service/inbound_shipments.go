@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+)
+
+// RegisterShipment stores the manifest of a shipment expected at the point.
+func (o *OrderServiceImpl) RegisterShipment(
+	ctx context.Context,
+	pickupPointID int64,
+	reference string,
+	items []domain.ShipmentItem,
+) (domain.InboundShipment, error) {
+	shipment, err := domain.NewInboundShipment(pickupPointID, reference, items)
+	if err != nil {
+		return domain.InboundShipment{}, err
+	}
+
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		if _, err := o.pickupPoints.Find(ctxTx, pickupPointID); err != nil {
+			return err
+		}
+		id, err := o.shipments.Create(ctxTx, shipment)
+		if err != nil {
+			return err
+		}
+		shipment, err = o.shipments.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.InboundShipment{}, fmt.Errorf("o.txManager.RunSerializable from RegisterShipment: %w", err)
+	}
+
+	return shipment, nil
+}
+
+// RegisterShipmentFromFile reads the manifest in the format of the orders
+// file. Orders of the file bound to another point are rejected.
+func (o *OrderServiceImpl) RegisterShipmentFromFile(
+	ctx context.Context,
+	pickupPointID int64,
+	reference string,
+	data []byte,
+) (domain.InboundShipment, error) {
+	var expected []External
+	if err := json.Unmarshal(data, &expected); err != nil {
+		return domain.InboundShipment{}, fmt.Errorf("%w: failed to unmarshal shipment file: %v",
+			domain.ErrShipmentFieldsAreIncorrect, err)
+	}
+
+	items := make([]domain.ShipmentItem, 0, len(expected))
+	for _, e := range expected {
+		if e.PickupPointID != 0 && e.PickupPointID != pickupPointID {
+			return domain.InboundShipment{}, fmt.Errorf("order %d: %w", e.OrderID, domain.ErrShipmentFieldsAreIncorrect)
+		}
+		cost, err := e.GetCost()
+		if err != nil {
+			return domain.InboundShipment{}, err
+		}
+		items = append(items, domain.ShipmentItem{
+			OrderID:        e.OrderID,
+			UserID:         e.UserID,
+			ExpirationTime: e.ExpirationTime,
+			Weight:         e.Weight,
+			Cost:           cost,
+		})
+	}
+
+	return o.RegisterShipment(ctx, pickupPointID, reference, items)
+}
+
+func (o *OrderServiceImpl) GetShipment(ctx context.Context, id int64) (domain.InboundShipment, error) {
+	return o.shipments.Find(ctx, id)
+}
+
+func (o *OrderServiceImpl) ListShipments(
+	ctx context.Context,
+	filter domain.ShipmentFilter,
+) ([]domain.InboundShipment, error) {
+	return o.shipments.FindAll(ctx, filter)
+}
+
+// ScanShipmentOrder records an order physically received. Orders the
+// manifest does not list are accepted and reported as unexpected on close.
+func (o *OrderServiceImpl) ScanShipmentOrder(
+	ctx context.Context,
+	shipmentID int64,
+	orderID int64,
+) (domain.InboundShipment, error) {
+	if orderID <= 0 {
+		return domain.InboundShipment{}, domain.ErrShipmentFieldsAreIncorrect
+	}
+
+	var shipment domain.InboundShipment
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		shipment, err = o.shipments.Find(ctxTx, shipmentID)
+		if err != nil {
+			return err
+		}
+		if !shipment.IsOpen() {
+			return domain.ErrShipmentIsClosed
+		}
+		if err := o.shipments.Scan(ctxTx, shipmentID, orderID); err != nil {
+			return err
+		}
+		shipment, err = o.shipments.Find(ctxTx, shipmentID)
+
+		return err
+	}); err != nil {
+		return domain.InboundShipment{}, fmt.Errorf("o.txManager.RunSerializable from ScanShipmentOrder: %w", err)
+	}
+
+	return shipment, nil
+}
+
+// CloseShipment creates the received orders and stores the discrepancy
+// report in one transaction. Received orders that are already expired or
+// already exist are not created and are reported as rejected. The returned
+// report carries the pickup codes of the created orders.
+func (o *OrderServiceImpl) CloseShipment(ctx context.Context, id int64) (domain.ShipmentReport, error) {
+	var (
+		shipment domain.InboundShipment
+		report   domain.ShipmentReport
+		issued   []domain.IssuedPickupCode
+	)
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		shipment, err = o.shipments.Find(ctxTx, id)
+		if err != nil {
+			return err
+		}
+		if !shipment.IsOpen() {
+			return domain.ErrShipmentIsClosed
+		}
+
+		received, missing, unexpected := shipment.Reconcile()
+		report = domain.ShipmentReport{ShipmentID: id, Missing: missing, Unexpected: unexpected}
+		issued = nil
+		for _, item := range received {
+			code, err := o.receiveInTx(ctxTx, item.ToOrder(shipment.PickupPointID))
+			switch {
+			case errors.Is(err, domain.ErrExpirationDateInPast), errors.Is(err, domain.ErrOrderAlreadyExists):
+				report.Rejected = append(report.Rejected, domain.RejectedItem{OrderID: item.OrderID, Reason: err.Error()})
+			case err != nil:
+				return fmt.Errorf("order %d: %w", item.OrderID, err)
+			default:
+				report.Received = append(report.Received, item.OrderID)
+				issued = append(issued, domain.IssuedPickupCode{OrderID: item.OrderID, PickupCode: code})
+			}
+		}
+		if err := o.shipments.Close(ctxTx, report); err != nil {
+			return err
+		}
+		report, err = o.shipments.FindReport(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.ShipmentReport{}, fmt.Errorf("o.txManager.RunSerializable from CloseShipment: %w", err)
+	}
+	report.PickupCodes = issued
+
+	monitoring.OrdersCreatedTotal.Add(float64(len(report.Received)))
+	monitoring.ShipmentDiscrepanciesTotal.WithLabelValues("missing").Add(float64(len(report.Missing)))
+	monitoring.ShipmentDiscrepanciesTotal.WithLabelValues("unexpected").Add(float64(len(report.Unexpected)))
+	monitoring.ShipmentDiscrepanciesTotal.WithLabelValues("rejected").Add(float64(len(report.Rejected)))
+	o.reportPointOccupancy(ctx, shipment.PickupPointID)
+
+	return report, nil
+}
+
+// GetShipmentReport returns the discrepancy report of a closed shipment.
+func (o *OrderServiceImpl) GetShipmentReport(ctx context.Context, id int64) (domain.ShipmentReport, error) {
+	return o.shipments.FindReport(ctx, id)
+}
+
+// receiveInTx creates a received order the way the orders file does and
+// returns its pickup code. The existence check runs first because a failed
+// insert aborts the transaction.
+func (o *OrderServiceImpl) receiveInTx(ctxTx context.Context, or domain.Order) (string, error) {
+	if or.ExpirationTime.Before(time.Now()) {
+		return "", domain.ErrExpirationDateInPast
+	}
+	if _, err := o.repo.Find(ctxTx, or.OrderID); err == nil {
+		return "", domain.ErrOrderAlreadyExists
+	} else if !errors.Is(err, domain.ErrOrderNotFound) {
+		return "", err
+	}
+	if err := or.ApplyPackaging(nil); err != nil {
+		return "", err
+	}
+	if err := o.place(&or); err != nil {
+		return "", err
+	}
+	if _, err := o.repo.Create(ctxTx, or); err != nil {
+		return "", err
+	}
+	if _, err := o.assignStorageCell(ctxTx, or); err != nil {
+		return "", err
+	}
+
+	return o.codes.Issue(ctxTx, or.OrderID)
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+)
+
+// OpenIncident records that the order was lost or damaged and blocks it until
+// the incident is closed.
+func (o *OrderServiceImpl) OpenIncident(
+	ctx context.Context,
+	orderID int64,
+	incidentType domain.IncidentType,
+	description string,
+	operator string,
+) (domain.Incident, error) {
+	incident, err := domain.NewIncident(orderID, incidentType, description, operator)
+	if err != nil {
+		return domain.Incident{}, err
+	}
+
+	var blocked statusChange
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		or, err := o.repo.Find(ctxTx, orderID)
+		if err != nil {
+			return domain.ErrOrderNotFound
+		}
+		inManifest, err := o.manifests.IsInOpenManifest(ctxTx, orderID)
+		if err != nil {
+			return err
+		}
+		if inManifest {
+			return domain.ErrOrderIsInReturnManifest
+		}
+
+		status, err := o.sm.Fire(or, domain.BlockAction, o.transitionContext(or.UserID, 0))
+		if err != nil {
+			return err
+		}
+		if _, err = o.repo.Update(ctxTx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost); err != nil {
+			return err
+		}
+		incident.OrderStatus = or.Status
+		id, err := o.incidents.Create(ctxTx, incident)
+		if err != nil {
+			return err
+		}
+		blocked = statusChange{order: or, newStatus: status, incident: &id}
+		if err := o.auditStatusChange(ctxTx, blocked); err != nil {
+			return err
+		}
+		incident, err = o.incidents.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.Incident{}, fmt.Errorf("o.txManager.RunSerializable from OpenIncident: %w", err)
+	}
+	monitoring.IncidentsOpenedTotal.WithLabelValues(string(incident.Type)).Inc()
+
+	return incident, nil
+}
+
+func (o *OrderServiceImpl) GetIncident(ctx context.Context, id int64) (domain.Incident, error) {
+	return o.incidents.Find(ctx, id)
+}
+
+func (o *OrderServiceImpl) ListIncidents(ctx context.Context, filter domain.IncidentFilter) ([]domain.Incident, error) {
+	return o.incidents.FindAll(ctx, filter)
+}
+
+// UpdateIncident corrects the type or description of an open incident.
+func (o *OrderServiceImpl) UpdateIncident(
+	ctx context.Context,
+	id int64,
+	update domain.IncidentUpdate,
+) (domain.Incident, error) {
+	var incident domain.Incident
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		incident, err = o.incidents.Find(ctxTx, id)
+		if err != nil {
+			return err
+		}
+		if err := incident.Apply(update); err != nil {
+			return err
+		}
+		if err := o.incidents.Update(ctxTx, incident); err != nil {
+			return err
+		}
+		incident, err = o.incidents.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.Incident{}, fmt.Errorf("o.txManager.RunSerializable from UpdateIncident: %w", err)
+	}
+
+	return incident, nil
+}
+
+// ResolveIncident closes the incident with the outcome. A found order gets
+// back the status it was blocked in, compensated and written off orders leave
+// their storage cell for good.
+func (o *OrderServiceImpl) ResolveIncident(
+	ctx context.Context,
+	id int64,
+	outcome domain.IncidentOutcome,
+	resolution string,
+	operator string,
+) (domain.Incident, error) {
+	return o.closeIncident(ctx, id, func(incident *domain.Incident) error {
+		return incident.Resolve(outcome, resolution, operator)
+	})
+}
+
+// CancelIncident withdraws an incident opened by mistake and unblocks the
+// order.
+func (o *OrderServiceImpl) CancelIncident(
+	ctx context.Context,
+	id int64,
+	resolution string,
+	operator string,
+) (domain.Incident, error) {
+	return o.closeIncident(ctx, id, func(incident *domain.Incident) error {
+		return incident.Cancel(resolution, operator)
+	})
+}
+
+func (o *OrderServiceImpl) closeIncident(
+	ctx context.Context,
+	id int64,
+	closeFn func(incident *domain.Incident) error,
+) (domain.Incident, error) {
+	var (
+		incident domain.Incident
+		closed   statusChange
+	)
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		incident, err = o.incidents.Find(ctxTx, id)
+		if err != nil {
+			return err
+		}
+		if err := closeFn(&incident); err != nil {
+			return err
+		}
+		or, err := o.repo.Find(ctxTx, incident.OrderID)
+		if err != nil {
+			return domain.ErrOrderNotFound
+		}
+
+		status := incident.OrderStatus
+		if incident.Outcome != nil {
+			if action, ok := incident.Outcome.Action(); ok {
+				if status, err = o.sm.Fire(or, action, o.transitionContext(or.UserID, 0)); err != nil {
+					return err
+				}
+				if err := o.releaseStorageCell(ctxTx, or.OrderID); err != nil {
+					return err
+				}
+			}
+		}
+		if _, err = o.repo.Update(ctxTx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost); err != nil {
+			return err
+		}
+		if err := o.incidents.Close(ctxTx, incident); err != nil {
+			return err
+		}
+		closed = statusChange{order: or, newStatus: status, incident: &id}
+		if err := o.auditStatusChange(ctxTx, closed); err != nil {
+			return err
+		}
+		incident, err = o.incidents.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.Incident{}, fmt.Errorf("o.txManager.RunSerializable from closeIncident: %w", err)
+	}
+	o.reportPointOccupancy(ctx, closed.order.PickupPointID)
+
+	outcome := string(incident.Status)
+	if incident.Outcome != nil {
+		outcome = string(*incident.Outcome)
+	}
+	monitoring.IncidentsClosedTotal.WithLabelValues(outcome).Inc()
+
+	return incident, nil
+}
@@ -11,11 +11,8 @@ import (
 type OrderRepository interface {
 	Create(
 		ctx context.Context,
-		orderID int64,
-		userID int64,
-		expirationDate time.Time,
-		weight int,
-		cost int) (int64, error)
+		order domain.Order,
+	) (int64, error)
 	Find(
 		ctx context.Context,
 		orderID int64,
@@ -26,6 +23,12 @@ type OrderRepository interface {
 		lastID *int64,
 		limit *int,
 	) ([]domain.Order, error)
+	// FindAllUncached reads the orders from the database even when a list
+	// is cached, for reads that must not see a stale list.
+	FindAllUncached(
+		ctx context.Context,
+		filter repository.Filter,
+	) ([]domain.Order, error)
 	Update(
 		ctx context.Context,
 		orderID int64,
@@ -33,12 +36,125 @@ type OrderRepository interface {
 		expirationDate time.Time,
 		status domain.Status,
 		weight int,
-		cost int,
+		cost domain.Money,
 	) (int64, error)
-	Delete(
+	Archive(
 		ctx context.Context,
 		orderID int64,
+		status domain.Status,
 	) error
+	FindExpired(
+		ctx context.Context,
+		before time.Time,
+		limit int,
+	) ([]domain.Order, error)
+}
+
+type OrderArchiveRepository interface {
+	Search(ctx context.Context, filter domain.ArchiveFilter, lastID int64, limit int) ([]domain.ArchivedOrder, error)
+}
+
+type PackageRepository interface {
+	FindAll(ctx context.Context) ([]domain.PackageSpec, error)
+	Upsert(ctx context.Context, spec domain.PackageSpec) error
+}
+
+type PickupPointRepository interface {
+	Create(ctx context.Context, point domain.PickupPoint) (int64, error)
+	Find(ctx context.Context, id int64) (domain.PickupPoint, error)
+	FindAll(ctx context.Context) ([]domain.PickupPoint, error)
+	Update(ctx context.Context, point domain.PickupPoint) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type StorageCellRepository interface {
+	Create(ctx context.Context, cell domain.StorageCell) (int64, error)
+	FindAll(ctx context.Context, pickupPointID int64) ([]domain.StorageCell, error)
+	FindByOrder(ctx context.Context, orderID int64) (domain.StorageCell, error)
+	Assign(
+		ctx context.Context,
+		pickupPointID int64,
+		orderID int64,
+		weight int,
+		dimensions domain.Dimensions,
+	) (domain.StorageCell, error)
+	Release(ctx context.Context, orderID int64) (domain.StorageCell, error)
+	Occupancy(ctx context.Context) ([]domain.StorageOccupancy, error)
+	PointOccupancy(ctx context.Context, pickupPointID int64) (domain.StorageOccupancy, error)
+}
+
+type PickupCodeRepository interface {
+	Save(ctx context.Context, code domain.PickupCode) error
+	Find(ctx context.Context, orderID int64) (domain.PickupCode, error)
+	Update(ctx context.Context, code domain.PickupCode) error
+	CreateOverride(ctx context.Context, override domain.PickupOverride) (int64, error)
+	CreateReissue(ctx context.Context, reissue domain.PickupCodeReissue) (int64, error)
+}
+
+type RefundDetailsRepository interface {
+	Save(ctx context.Context, details domain.RefundDetails) error
+	Find(ctx context.Context, orderID int64) (domain.RefundDetails, error)
+}
+
+type ReturnManifestRepository interface {
+	Create(ctx context.Context, manifest domain.ReturnManifest) (int64, error)
+	Find(ctx context.Context, id int64) (domain.ReturnManifest, error)
+	FindAll(ctx context.Context, filter domain.ManifestFilter) ([]domain.ReturnManifest, error)
+	FindReturnableOrders(
+		ctx context.Context,
+		pickupPointID int64,
+		statuses []domain.Status,
+	) ([]domain.ReturnManifestItem, error)
+	IsInOpenManifest(ctx context.Context, orderID int64) (bool, error)
+	Scan(ctx context.Context, manifestID int64, orderID int64) error
+	Close(ctx context.Context, manifestID int64) error
+}
+
+type InboundShipmentRepository interface {
+	Create(ctx context.Context, shipment domain.InboundShipment) (int64, error)
+	Find(ctx context.Context, id int64) (domain.InboundShipment, error)
+	FindAll(ctx context.Context, filter domain.ShipmentFilter) ([]domain.InboundShipment, error)
+	Scan(ctx context.Context, shipmentID int64, orderID int64) error
+	Close(ctx context.Context, report domain.ShipmentReport) error
+	FindReport(ctx context.Context, shipmentID int64) (domain.ShipmentReport, error)
+}
+
+type StocktakingRepository interface {
+	Create(ctx context.Context, session domain.StocktakingSession) (int64, error)
+	Find(ctx context.Context, id int64) (domain.StocktakingSession, error)
+	FindAll(ctx context.Context, filter domain.StocktakingFilter) ([]domain.StocktakingSession, error)
+	AddScans(ctx context.Context, sessionID int64, orderIDs []int64) error
+	Scanned(ctx context.Context, sessionID int64) ([]int64, error)
+	Finish(ctx context.Context, sessionID int64, result domain.StocktakingResult) error
+}
+
+type IncidentRepository interface {
+	Create(ctx context.Context, incident domain.Incident) (int64, error)
+	Find(ctx context.Context, id int64) (domain.Incident, error)
+	FindAll(ctx context.Context, filter domain.IncidentFilter) ([]domain.Incident, error)
+	Update(ctx context.Context, incident domain.Incident) error
+	Close(ctx context.Context, incident domain.Incident) error
+}
+
+type StorageFeeRepository interface {
+	FindTariff(ctx context.Context, pickupPointID int64) (domain.StorageTariff, error)
+	SaveTariff(ctx context.Context, tariff domain.StorageTariff) error
+	DeleteTariff(ctx context.Context, pickupPointID int64) error
+	SavePayment(ctx context.Context, payment domain.StorageFeePayment) error
+	DailyRevenue(ctx context.Context, filter domain.RevenueFilter) ([]domain.DailyRevenue, error)
+}
+
+type DeadLetterRepository interface {
+	Find(ctx context.Context, id int64) (domain.DeadLetter, error)
+	FindAll(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error)
+	Requeue(ctx context.Context, id int64) (int64, error)
+	Purge(ctx context.Context, filter domain.DeadLetterFilter) (int64, error)
+}
+
+// OrderStatusAuditRepository writes an audit entry of a status change together
+// with the outbox task that publishes it, in the transaction in ctx.
+type OrderStatusAuditRepository interface {
+	Create(ctx context.Context, info domain.AuditOrderInfo) (int64, error)
 }
 
 type AuditEntriesRepository interface {
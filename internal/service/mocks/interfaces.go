@@ -37,33 +37,33 @@ func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockOrderRepository) Create(ctx context.Context, orderID, userID int64, expirationDate time.Time, weight, cost int) (int64, error) {
+// Archive mocks base method.
+func (m *MockOrderRepository) Archive(ctx context.Context, orderID int64, status domain.Status) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, orderID, userID, expirationDate, weight, cost)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Archive", ctx, orderID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockOrderRepositoryMockRecorder) Create(ctx, orderID, userID, expirationDate, weight, cost interface{}) *gomock.Call {
+// Archive indicates an expected call of Archive.
+func (mr *MockOrderRepositoryMockRecorder) Archive(ctx, orderID, status interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderRepository)(nil).Create), ctx, orderID, userID, expirationDate, weight, cost)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockOrderRepository)(nil).Archive), ctx, orderID, status)
 }
 
-// Delete mocks base method.
-func (m *MockOrderRepository) Delete(ctx context.Context, orderID int64) error {
+// Create mocks base method.
+func (m *MockOrderRepository) Create(ctx context.Context, order domain.Order) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, orderID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Create", ctx, order)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockOrderRepositoryMockRecorder) Delete(ctx, orderID interface{}) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockOrderRepositoryMockRecorder) Create(ctx, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderRepository)(nil).Delete), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderRepository)(nil).Create), ctx, order)
 }
 
 // Find mocks base method.
@@ -82,22 +82,52 @@ func (mr *MockOrderRepositoryMockRecorder) Find(ctx, orderID interface{}) *gomoc
 }
 
 // FindAll mocks base method.
-func (m *MockOrderRepository) FindAll(ctx context.Context, filter repository.Filter, lastId *int64, limit *int) ([]domain.Order, error) {
+func (m *MockOrderRepository) FindAll(ctx context.Context, filter repository.Filter, lastID *int64, limit *int) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, filter, lastId, limit)
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter, lastID, limit)
 	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockOrderRepositoryMockRecorder) FindAll(ctx, filter, lastId, limit interface{}) *gomock.Call {
+func (mr *MockOrderRepositoryMockRecorder) FindAll(ctx, filter, lastID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderRepository)(nil).FindAll), ctx, filter, lastID, limit)
+}
+
+// FindAllUncached mocks base method.
+func (m *MockOrderRepository) FindAllUncached(ctx context.Context, filter repository.Filter) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllUncached", ctx, filter)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllUncached indicates an expected call of FindAllUncached.
+func (mr *MockOrderRepositoryMockRecorder) FindAllUncached(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUncached", reflect.TypeOf((*MockOrderRepository)(nil).FindAllUncached), ctx, filter)
+}
+
+// FindExpired mocks base method.
+func (m *MockOrderRepository) FindExpired(ctx context.Context, before time.Time, limit int) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpired", ctx, before, limit)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpired indicates an expected call of FindExpired.
+func (mr *MockOrderRepositoryMockRecorder) FindExpired(ctx, before, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderRepository)(nil).FindAll), ctx, filter, lastId, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpired", reflect.TypeOf((*MockOrderRepository)(nil).FindExpired), ctx, before, limit)
 }
 
 // Update mocks base method.
-func (m *MockOrderRepository) Update(ctx context.Context, orderID, userID int64, expirationDate time.Time, status domain.Status, weight, cost int) (int64, error) {
+func (m *MockOrderRepository) Update(ctx context.Context, orderID, userID int64, expirationDate time.Time, status domain.Status, weight int, cost domain.Money) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", ctx, orderID, userID, expirationDate, status, weight, cost)
 	ret0, _ := ret[0].(int64)
@@ -111,6 +141,1128 @@ func (mr *MockOrderRepositoryMockRecorder) Update(ctx, orderID, userID, expirati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrderRepository)(nil).Update), ctx, orderID, userID, expirationDate, status, weight, cost)
 }
 
+// MockOrderArchiveRepository is a mock of OrderArchiveRepository interface.
+type MockOrderArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderArchiveRepositoryMockRecorder
+}
+
+// MockOrderArchiveRepositoryMockRecorder is the mock recorder for MockOrderArchiveRepository.
+type MockOrderArchiveRepositoryMockRecorder struct {
+	mock *MockOrderArchiveRepository
+}
+
+// NewMockOrderArchiveRepository creates a new mock instance.
+func NewMockOrderArchiveRepository(ctrl *gomock.Controller) *MockOrderArchiveRepository {
+	mock := &MockOrderArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockOrderArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderArchiveRepository) EXPECT() *MockOrderArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockOrderArchiveRepository) Search(ctx context.Context, filter domain.ArchiveFilter, lastID int64, limit int) ([]domain.ArchivedOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, lastID, limit)
+	ret0, _ := ret[0].([]domain.ArchivedOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockOrderArchiveRepositoryMockRecorder) Search(ctx, filter, lastID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOrderArchiveRepository)(nil).Search), ctx, filter, lastID, limit)
+}
+
+// MockPackageRepository is a mock of PackageRepository interface.
+type MockPackageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPackageRepositoryMockRecorder
+}
+
+// MockPackageRepositoryMockRecorder is the mock recorder for MockPackageRepository.
+type MockPackageRepositoryMockRecorder struct {
+	mock *MockPackageRepository
+}
+
+// NewMockPackageRepository creates a new mock instance.
+func NewMockPackageRepository(ctrl *gomock.Controller) *MockPackageRepository {
+	mock := &MockPackageRepository{ctrl: ctrl}
+	mock.recorder = &MockPackageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPackageRepository) EXPECT() *MockPackageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockPackageRepository) FindAll(ctx context.Context) ([]domain.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPackageRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPackageRepository)(nil).FindAll), ctx)
+}
+
+// Upsert mocks base method.
+func (m *MockPackageRepository) Upsert(ctx context.Context, spec domain.PackageSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, spec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockPackageRepositoryMockRecorder) Upsert(ctx, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockPackageRepository)(nil).Upsert), ctx, spec)
+}
+
+// MockPickupPointRepository is a mock of PickupPointRepository interface.
+type MockPickupPointRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupPointRepositoryMockRecorder
+}
+
+// MockPickupPointRepositoryMockRecorder is the mock recorder for MockPickupPointRepository.
+type MockPickupPointRepositoryMockRecorder struct {
+	mock *MockPickupPointRepository
+}
+
+// NewMockPickupPointRepository creates a new mock instance.
+func NewMockPickupPointRepository(ctrl *gomock.Controller) *MockPickupPointRepository {
+	mock := &MockPickupPointRepository{ctrl: ctrl}
+	mock.recorder = &MockPickupPointRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupPointRepository) EXPECT() *MockPickupPointRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPickupPointRepository) Create(ctx context.Context, point domain.PickupPoint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, point)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPickupPointRepositoryMockRecorder) Create(ctx, point interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPickupPointRepository)(nil).Create), ctx, point)
+}
+
+// Delete mocks base method.
+func (m *MockPickupPointRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPickupPointRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPickupPointRepository)(nil).Delete), ctx, id)
+}
+
+// Find mocks base method.
+func (m *MockPickupPointRepository) Find(ctx context.Context, id int64) (domain.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(domain.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPickupPointRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPickupPointRepository)(nil).Find), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPickupPointRepository) FindAll(ctx context.Context) ([]domain.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret0, _ := ret[0].([]domain.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPickupPointRepositoryMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPickupPointRepository)(nil).FindAll), ctx)
+}
+
+// Update mocks base method.
+func (m *MockPickupPointRepository) Update(ctx context.Context, point domain.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, point)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPickupPointRepositoryMockRecorder) Update(ctx, point interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPickupPointRepository)(nil).Update), ctx, point)
+}
+
+// MockStorageCellRepository is a mock of StorageCellRepository interface.
+type MockStorageCellRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageCellRepositoryMockRecorder
+}
+
+// MockStorageCellRepositoryMockRecorder is the mock recorder for MockStorageCellRepository.
+type MockStorageCellRepositoryMockRecorder struct {
+	mock *MockStorageCellRepository
+}
+
+// NewMockStorageCellRepository creates a new mock instance.
+func NewMockStorageCellRepository(ctrl *gomock.Controller) *MockStorageCellRepository {
+	mock := &MockStorageCellRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageCellRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageCellRepository) EXPECT() *MockStorageCellRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockStorageCellRepository) Assign(ctx context.Context, pickupPointID, orderID int64, weight int, dimensions domain.Dimensions) (domain.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, pickupPointID, orderID, weight, dimensions)
+	ret0, _ := ret[0].(domain.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockStorageCellRepositoryMockRecorder) Assign(ctx, pickupPointID, orderID, weight, dimensions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockStorageCellRepository)(nil).Assign), ctx, pickupPointID, orderID, weight, dimensions)
+}
+
+// Create mocks base method.
+func (m *MockStorageCellRepository) Create(ctx context.Context, cell domain.StorageCell) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, cell)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStorageCellRepositoryMockRecorder) Create(ctx, cell interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStorageCellRepository)(nil).Create), ctx, cell)
+}
+
+// FindAll mocks base method.
+func (m *MockStorageCellRepository) FindAll(ctx context.Context, pickupPointID int64) ([]domain.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, pickupPointID)
+	ret0, _ := ret[0].([]domain.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStorageCellRepositoryMockRecorder) FindAll(ctx, pickupPointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStorageCellRepository)(nil).FindAll), ctx, pickupPointID)
+}
+
+// FindByOrder mocks base method.
+func (m *MockStorageCellRepository) FindByOrder(ctx context.Context, orderID int64) (domain.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrder", ctx, orderID)
+	ret0, _ := ret[0].(domain.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrder indicates an expected call of FindByOrder.
+func (mr *MockStorageCellRepositoryMockRecorder) FindByOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrder", reflect.TypeOf((*MockStorageCellRepository)(nil).FindByOrder), ctx, orderID)
+}
+
+// Occupancy mocks base method.
+func (m *MockStorageCellRepository) Occupancy(ctx context.Context) ([]domain.StorageOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Occupancy", ctx)
+	ret0, _ := ret[0].([]domain.StorageOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Occupancy indicates an expected call of Occupancy.
+func (mr *MockStorageCellRepositoryMockRecorder) Occupancy(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Occupancy", reflect.TypeOf((*MockStorageCellRepository)(nil).Occupancy), ctx)
+}
+
+// PointOccupancy mocks base method.
+func (m *MockStorageCellRepository) PointOccupancy(ctx context.Context, pickupPointID int64) (domain.StorageOccupancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PointOccupancy", ctx, pickupPointID)
+	ret0, _ := ret[0].(domain.StorageOccupancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PointOccupancy indicates an expected call of PointOccupancy.
+func (mr *MockStorageCellRepositoryMockRecorder) PointOccupancy(ctx, pickupPointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PointOccupancy", reflect.TypeOf((*MockStorageCellRepository)(nil).PointOccupancy), ctx, pickupPointID)
+}
+
+// Release mocks base method.
+func (m *MockStorageCellRepository) Release(ctx context.Context, orderID int64) (domain.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, orderID)
+	ret0, _ := ret[0].(domain.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockStorageCellRepositoryMockRecorder) Release(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockStorageCellRepository)(nil).Release), ctx, orderID)
+}
+
+// MockPickupCodeRepository is a mock of PickupCodeRepository interface.
+type MockPickupCodeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupCodeRepositoryMockRecorder
+}
+
+// MockPickupCodeRepositoryMockRecorder is the mock recorder for MockPickupCodeRepository.
+type MockPickupCodeRepositoryMockRecorder struct {
+	mock *MockPickupCodeRepository
+}
+
+// NewMockPickupCodeRepository creates a new mock instance.
+func NewMockPickupCodeRepository(ctrl *gomock.Controller) *MockPickupCodeRepository {
+	mock := &MockPickupCodeRepository{ctrl: ctrl}
+	mock.recorder = &MockPickupCodeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupCodeRepository) EXPECT() *MockPickupCodeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateOverride mocks base method.
+func (m *MockPickupCodeRepository) CreateOverride(ctx context.Context, override domain.PickupOverride) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOverride", ctx, override)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOverride indicates an expected call of CreateOverride.
+func (mr *MockPickupCodeRepositoryMockRecorder) CreateOverride(ctx, override interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOverride", reflect.TypeOf((*MockPickupCodeRepository)(nil).CreateOverride), ctx, override)
+}
+
+// CreateReissue mocks base method.
+func (m *MockPickupCodeRepository) CreateReissue(ctx context.Context, reissue domain.PickupCodeReissue) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReissue", ctx, reissue)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReissue indicates an expected call of CreateReissue.
+func (mr *MockPickupCodeRepositoryMockRecorder) CreateReissue(ctx, reissue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReissue", reflect.TypeOf((*MockPickupCodeRepository)(nil).CreateReissue), ctx, reissue)
+}
+
+// Find mocks base method.
+func (m *MockPickupCodeRepository) Find(ctx context.Context, orderID int64) (domain.PickupCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, orderID)
+	ret0, _ := ret[0].(domain.PickupCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockPickupCodeRepositoryMockRecorder) Find(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPickupCodeRepository)(nil).Find), ctx, orderID)
+}
+
+// Save mocks base method.
+func (m *MockPickupCodeRepository) Save(ctx context.Context, code domain.PickupCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPickupCodeRepositoryMockRecorder) Save(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPickupCodeRepository)(nil).Save), ctx, code)
+}
+
+// Update mocks base method.
+func (m *MockPickupCodeRepository) Update(ctx context.Context, code domain.PickupCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPickupCodeRepositoryMockRecorder) Update(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPickupCodeRepository)(nil).Update), ctx, code)
+}
+
+// MockRefundDetailsRepository is a mock of RefundDetailsRepository interface.
+type MockRefundDetailsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundDetailsRepositoryMockRecorder
+}
+
+// MockRefundDetailsRepositoryMockRecorder is the mock recorder for MockRefundDetailsRepository.
+type MockRefundDetailsRepositoryMockRecorder struct {
+	mock *MockRefundDetailsRepository
+}
+
+// NewMockRefundDetailsRepository creates a new mock instance.
+func NewMockRefundDetailsRepository(ctrl *gomock.Controller) *MockRefundDetailsRepository {
+	mock := &MockRefundDetailsRepository{ctrl: ctrl}
+	mock.recorder = &MockRefundDetailsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundDetailsRepository) EXPECT() *MockRefundDetailsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockRefundDetailsRepository) Find(ctx context.Context, orderID int64) (domain.RefundDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, orderID)
+	ret0, _ := ret[0].(domain.RefundDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockRefundDetailsRepositoryMockRecorder) Find(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockRefundDetailsRepository)(nil).Find), ctx, orderID)
+}
+
+// Save mocks base method.
+func (m *MockRefundDetailsRepository) Save(ctx context.Context, details domain.RefundDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, details)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRefundDetailsRepositoryMockRecorder) Save(ctx, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRefundDetailsRepository)(nil).Save), ctx, details)
+}
+
+// MockReturnManifestRepository is a mock of ReturnManifestRepository interface.
+type MockReturnManifestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReturnManifestRepositoryMockRecorder
+}
+
+// MockReturnManifestRepositoryMockRecorder is the mock recorder for MockReturnManifestRepository.
+type MockReturnManifestRepositoryMockRecorder struct {
+	mock *MockReturnManifestRepository
+}
+
+// NewMockReturnManifestRepository creates a new mock instance.
+func NewMockReturnManifestRepository(ctrl *gomock.Controller) *MockReturnManifestRepository {
+	mock := &MockReturnManifestRepository{ctrl: ctrl}
+	mock.recorder = &MockReturnManifestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReturnManifestRepository) EXPECT() *MockReturnManifestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockReturnManifestRepository) Close(ctx context.Context, manifestID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, manifestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockReturnManifestRepositoryMockRecorder) Close(ctx, manifestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReturnManifestRepository)(nil).Close), ctx, manifestID)
+}
+
+// Create mocks base method.
+func (m *MockReturnManifestRepository) Create(ctx context.Context, manifest domain.ReturnManifest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, manifest)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReturnManifestRepositoryMockRecorder) Create(ctx, manifest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReturnManifestRepository)(nil).Create), ctx, manifest)
+}
+
+// Find mocks base method.
+func (m *MockReturnManifestRepository) Find(ctx context.Context, id int64) (domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockReturnManifestRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockReturnManifestRepository)(nil).Find), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockReturnManifestRepository) FindAll(ctx context.Context, filter domain.ManifestFilter) ([]domain.ReturnManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.ReturnManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockReturnManifestRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockReturnManifestRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindReturnableOrders mocks base method.
+func (m *MockReturnManifestRepository) FindReturnableOrders(ctx context.Context, pickupPointID int64, statuses []domain.Status) ([]domain.ReturnManifestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReturnableOrders", ctx, pickupPointID, statuses)
+	ret0, _ := ret[0].([]domain.ReturnManifestItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReturnableOrders indicates an expected call of FindReturnableOrders.
+func (mr *MockReturnManifestRepositoryMockRecorder) FindReturnableOrders(ctx, pickupPointID, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReturnableOrders", reflect.TypeOf((*MockReturnManifestRepository)(nil).FindReturnableOrders), ctx, pickupPointID, statuses)
+}
+
+// IsInOpenManifest mocks base method.
+func (m *MockReturnManifestRepository) IsInOpenManifest(ctx context.Context, orderID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInOpenManifest", ctx, orderID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInOpenManifest indicates an expected call of IsInOpenManifest.
+func (mr *MockReturnManifestRepositoryMockRecorder) IsInOpenManifest(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInOpenManifest", reflect.TypeOf((*MockReturnManifestRepository)(nil).IsInOpenManifest), ctx, orderID)
+}
+
+// Scan mocks base method.
+func (m *MockReturnManifestRepository) Scan(ctx context.Context, manifestID, orderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, manifestID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockReturnManifestRepositoryMockRecorder) Scan(ctx, manifestID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockReturnManifestRepository)(nil).Scan), ctx, manifestID, orderID)
+}
+
+// MockInboundShipmentRepository is a mock of InboundShipmentRepository interface.
+type MockInboundShipmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInboundShipmentRepositoryMockRecorder
+}
+
+// MockInboundShipmentRepositoryMockRecorder is the mock recorder for MockInboundShipmentRepository.
+type MockInboundShipmentRepositoryMockRecorder struct {
+	mock *MockInboundShipmentRepository
+}
+
+// NewMockInboundShipmentRepository creates a new mock instance.
+func NewMockInboundShipmentRepository(ctrl *gomock.Controller) *MockInboundShipmentRepository {
+	mock := &MockInboundShipmentRepository{ctrl: ctrl}
+	mock.recorder = &MockInboundShipmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInboundShipmentRepository) EXPECT() *MockInboundShipmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockInboundShipmentRepository) Close(ctx context.Context, report domain.ShipmentReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockInboundShipmentRepositoryMockRecorder) Close(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockInboundShipmentRepository)(nil).Close), ctx, report)
+}
+
+// Create mocks base method.
+func (m *MockInboundShipmentRepository) Create(ctx context.Context, shipment domain.InboundShipment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, shipment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInboundShipmentRepositoryMockRecorder) Create(ctx, shipment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInboundShipmentRepository)(nil).Create), ctx, shipment)
+}
+
+// Find mocks base method.
+func (m *MockInboundShipmentRepository) Find(ctx context.Context, id int64) (domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockInboundShipmentRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockInboundShipmentRepository)(nil).Find), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockInboundShipmentRepository) FindAll(ctx context.Context, filter domain.ShipmentFilter) ([]domain.InboundShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.InboundShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockInboundShipmentRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockInboundShipmentRepository)(nil).FindAll), ctx, filter)
+}
+
+// FindReport mocks base method.
+func (m *MockInboundShipmentRepository) FindReport(ctx context.Context, shipmentID int64) (domain.ShipmentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReport", ctx, shipmentID)
+	ret0, _ := ret[0].(domain.ShipmentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReport indicates an expected call of FindReport.
+func (mr *MockInboundShipmentRepositoryMockRecorder) FindReport(ctx, shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReport", reflect.TypeOf((*MockInboundShipmentRepository)(nil).FindReport), ctx, shipmentID)
+}
+
+// Scan mocks base method.
+func (m *MockInboundShipmentRepository) Scan(ctx context.Context, shipmentID, orderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, shipmentID, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockInboundShipmentRepositoryMockRecorder) Scan(ctx, shipmentID, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockInboundShipmentRepository)(nil).Scan), ctx, shipmentID, orderID)
+}
+
+// MockStocktakingRepository is a mock of StocktakingRepository interface.
+type MockStocktakingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStocktakingRepositoryMockRecorder
+}
+
+// MockStocktakingRepositoryMockRecorder is the mock recorder for MockStocktakingRepository.
+type MockStocktakingRepositoryMockRecorder struct {
+	mock *MockStocktakingRepository
+}
+
+// NewMockStocktakingRepository creates a new mock instance.
+func NewMockStocktakingRepository(ctrl *gomock.Controller) *MockStocktakingRepository {
+	mock := &MockStocktakingRepository{ctrl: ctrl}
+	mock.recorder = &MockStocktakingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStocktakingRepository) EXPECT() *MockStocktakingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddScans mocks base method.
+func (m *MockStocktakingRepository) AddScans(ctx context.Context, sessionID int64, orderIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddScans", ctx, sessionID, orderIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddScans indicates an expected call of AddScans.
+func (mr *MockStocktakingRepositoryMockRecorder) AddScans(ctx, sessionID, orderIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddScans", reflect.TypeOf((*MockStocktakingRepository)(nil).AddScans), ctx, sessionID, orderIDs)
+}
+
+// Create mocks base method.
+func (m *MockStocktakingRepository) Create(ctx context.Context, session domain.StocktakingSession) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, session)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStocktakingRepositoryMockRecorder) Create(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStocktakingRepository)(nil).Create), ctx, session)
+}
+
+// Find mocks base method.
+func (m *MockStocktakingRepository) Find(ctx context.Context, id int64) (domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockStocktakingRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockStocktakingRepository)(nil).Find), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockStocktakingRepository) FindAll(ctx context.Context, filter domain.StocktakingFilter) ([]domain.StocktakingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.StocktakingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockStocktakingRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockStocktakingRepository)(nil).FindAll), ctx, filter)
+}
+
+// Finish mocks base method.
+func (m *MockStocktakingRepository) Finish(ctx context.Context, sessionID int64, result domain.StocktakingResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, sessionID, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockStocktakingRepositoryMockRecorder) Finish(ctx, sessionID, result interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockStocktakingRepository)(nil).Finish), ctx, sessionID, result)
+}
+
+// Scanned mocks base method.
+func (m *MockStocktakingRepository) Scanned(ctx context.Context, sessionID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scanned", ctx, sessionID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scanned indicates an expected call of Scanned.
+func (mr *MockStocktakingRepositoryMockRecorder) Scanned(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scanned", reflect.TypeOf((*MockStocktakingRepository)(nil).Scanned), ctx, sessionID)
+}
+
+// MockIncidentRepository is a mock of IncidentRepository interface.
+type MockIncidentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncidentRepositoryMockRecorder
+}
+
+// MockIncidentRepositoryMockRecorder is the mock recorder for MockIncidentRepository.
+type MockIncidentRepositoryMockRecorder struct {
+	mock *MockIncidentRepository
+}
+
+// NewMockIncidentRepository creates a new mock instance.
+func NewMockIncidentRepository(ctrl *gomock.Controller) *MockIncidentRepository {
+	mock := &MockIncidentRepository{ctrl: ctrl}
+	mock.recorder = &MockIncidentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncidentRepository) EXPECT() *MockIncidentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockIncidentRepository) Close(ctx context.Context, incident domain.Incident) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", ctx, incident)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockIncidentRepositoryMockRecorder) Close(ctx, incident interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockIncidentRepository)(nil).Close), ctx, incident)
+}
+
+// Create mocks base method.
+func (m *MockIncidentRepository) Create(ctx context.Context, incident domain.Incident) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, incident)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIncidentRepositoryMockRecorder) Create(ctx, incident interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIncidentRepository)(nil).Create), ctx, incident)
+}
+
+// Find mocks base method.
+func (m *MockIncidentRepository) Find(ctx context.Context, id int64) (domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockIncidentRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIncidentRepository)(nil).Find), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockIncidentRepository) FindAll(ctx context.Context, filter domain.IncidentFilter) ([]domain.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockIncidentRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockIncidentRepository)(nil).FindAll), ctx, filter)
+}
+
+// Update mocks base method.
+func (m *MockIncidentRepository) Update(ctx context.Context, incident domain.Incident) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, incident)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIncidentRepositoryMockRecorder) Update(ctx, incident interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIncidentRepository)(nil).Update), ctx, incident)
+}
+
+// MockStorageFeeRepository is a mock of StorageFeeRepository interface.
+type MockStorageFeeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageFeeRepositoryMockRecorder
+}
+
+// MockStorageFeeRepositoryMockRecorder is the mock recorder for MockStorageFeeRepository.
+type MockStorageFeeRepositoryMockRecorder struct {
+	mock *MockStorageFeeRepository
+}
+
+// NewMockStorageFeeRepository creates a new mock instance.
+func NewMockStorageFeeRepository(ctrl *gomock.Controller) *MockStorageFeeRepository {
+	mock := &MockStorageFeeRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageFeeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageFeeRepository) EXPECT() *MockStorageFeeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DailyRevenue mocks base method.
+func (m *MockStorageFeeRepository) DailyRevenue(ctx context.Context, filter domain.RevenueFilter) ([]domain.DailyRevenue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DailyRevenue", ctx, filter)
+	ret0, _ := ret[0].([]domain.DailyRevenue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DailyRevenue indicates an expected call of DailyRevenue.
+func (mr *MockStorageFeeRepositoryMockRecorder) DailyRevenue(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DailyRevenue", reflect.TypeOf((*MockStorageFeeRepository)(nil).DailyRevenue), ctx, filter)
+}
+
+// DeleteTariff mocks base method.
+func (m *MockStorageFeeRepository) DeleteTariff(ctx context.Context, pickupPointID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTariff", ctx, pickupPointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTariff indicates an expected call of DeleteTariff.
+func (mr *MockStorageFeeRepositoryMockRecorder) DeleteTariff(ctx, pickupPointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTariff", reflect.TypeOf((*MockStorageFeeRepository)(nil).DeleteTariff), ctx, pickupPointID)
+}
+
+// FindTariff mocks base method.
+func (m *MockStorageFeeRepository) FindTariff(ctx context.Context, pickupPointID int64) (domain.StorageTariff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTariff", ctx, pickupPointID)
+	ret0, _ := ret[0].(domain.StorageTariff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTariff indicates an expected call of FindTariff.
+func (mr *MockStorageFeeRepositoryMockRecorder) FindTariff(ctx, pickupPointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTariff", reflect.TypeOf((*MockStorageFeeRepository)(nil).FindTariff), ctx, pickupPointID)
+}
+
+// SavePayment mocks base method.
+func (m *MockStorageFeeRepository) SavePayment(ctx context.Context, payment domain.StorageFeePayment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePayment", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePayment indicates an expected call of SavePayment.
+func (mr *MockStorageFeeRepositoryMockRecorder) SavePayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePayment", reflect.TypeOf((*MockStorageFeeRepository)(nil).SavePayment), ctx, payment)
+}
+
+// SaveTariff mocks base method.
+func (m *MockStorageFeeRepository) SaveTariff(ctx context.Context, tariff domain.StorageTariff) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTariff", ctx, tariff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTariff indicates an expected call of SaveTariff.
+func (mr *MockStorageFeeRepositoryMockRecorder) SaveTariff(ctx, tariff interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTariff", reflect.TypeOf((*MockStorageFeeRepository)(nil).SaveTariff), ctx, tariff)
+}
+
+// MockDeadLetterRepository is a mock of DeadLetterRepository interface.
+type MockDeadLetterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterRepositoryMockRecorder
+}
+
+// MockDeadLetterRepositoryMockRecorder is the mock recorder for MockDeadLetterRepository.
+type MockDeadLetterRepositoryMockRecorder struct {
+	mock *MockDeadLetterRepository
+}
+
+// NewMockDeadLetterRepository creates a new mock instance.
+func NewMockDeadLetterRepository(ctrl *gomock.Controller) *MockDeadLetterRepository {
+	mock := &MockDeadLetterRepository{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterRepository) EXPECT() *MockDeadLetterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Find mocks base method.
+func (m *MockDeadLetterRepository) Find(ctx context.Context, id int64) (domain.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, id)
+	ret0, _ := ret[0].(domain.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockDeadLetterRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockDeadLetterRepository)(nil).Find), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockDeadLetterRepository) FindAll(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filter)
+	ret0, _ := ret[0].([]domain.DeadLetter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDeadLetterRepositoryMockRecorder) FindAll(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDeadLetterRepository)(nil).FindAll), ctx, filter)
+}
+
+// Purge mocks base method.
+func (m *MockDeadLetterRepository) Purge(ctx context.Context, filter domain.DeadLetterFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDeadLetterRepositoryMockRecorder) Purge(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDeadLetterRepository)(nil).Purge), ctx, filter)
+}
+
+// Requeue mocks base method.
+func (m *MockDeadLetterRepository) Requeue(ctx context.Context, id int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockDeadLetterRepositoryMockRecorder) Requeue(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockDeadLetterRepository)(nil).Requeue), ctx, id)
+}
+
+// MockOrderStatusAuditRepository is a mock of OrderStatusAuditRepository interface.
+type MockOrderStatusAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderStatusAuditRepositoryMockRecorder
+}
+
+// MockOrderStatusAuditRepositoryMockRecorder is the mock recorder for MockOrderStatusAuditRepository.
+type MockOrderStatusAuditRepositoryMockRecorder struct {
+	mock *MockOrderStatusAuditRepository
+}
+
+// NewMockOrderStatusAuditRepository creates a new mock instance.
+func NewMockOrderStatusAuditRepository(ctrl *gomock.Controller) *MockOrderStatusAuditRepository {
+	mock := &MockOrderStatusAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockOrderStatusAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderStatusAuditRepository) EXPECT() *MockOrderStatusAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrderStatusAuditRepository) Create(ctx context.Context, info domain.AuditOrderInfo) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, info)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrderStatusAuditRepositoryMockRecorder) Create(ctx, info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderStatusAuditRepository)(nil).Create), ctx, info)
+}
+
 // MockAuditEntriesRepository is a mock of AuditEntriesRepository interface.
 type MockAuditEntriesRepository struct {
 	ctrl     *gomock.Controller
@@ -14,6 +14,9 @@ type SearchFilter struct {
 	Status         *string
 	ExpirationTime *time.Time
 	SearchTerm     *string
+	PickupPointID  *int64
+	RefundReason   *string
+	ItemCondition  *string
 }
 
 type External struct {
@@ -23,7 +26,29 @@ type External struct {
 	UserIDRaw      string    `json:"user_id"`
 	ExpirationTime time.Time `json:"expiration_time"`
 	Weight         int       `json:"weight"`
-	Cost           int       `json:"cost"`
+	Cost           int64     `json:"cost"`
+	Currency       string    `json:"currency"`
+	PickupPointID  int64     `json:"pickup_point_id"`
+}
+
+// GetCost returns the cost in minor units of the currency from the file. Files
+// written before costs had a currency hold major units, so a cost without a
+// currency is rejected rather than read a hundred times too low.
+func (e *External) GetCost() (domain.Money, error) {
+	if e.Currency == "" {
+		return domain.Money{}, fmt.Errorf("order %d: %w", e.OrderID, domain.ErrCostCurrencyRequired)
+	}
+
+	return domain.NewMoney(e.Cost, domain.Currency(e.Currency))
+}
+
+// GetPickupPointID returns the point from the file or the default one.
+func (e *External) GetPickupPointID() int64 {
+	if e.PickupPointID == 0 {
+		return domain.DefaultPickupPointID
+	}
+
+	return e.PickupPointID
 }
 
 func (e *External) UnmarshalJSON(data []byte) error {
@@ -59,7 +84,9 @@ type OrderDto struct {
 	ExpirationTime time.Time     `db:"expiration_date"`
 	Status         domain.Status `db:"status"`
 	Weight         int           `db:"weight"`
-	Cost           int           `db:"cost"`
+	Cost           domain.Money
+	Dimensions     domain.Dimensions
+	PickupPointID  int64 `db:"pickup_point_id"`
 }
 
 func ConvertDtoToDomainOrder(dto OrderDto) domain.Order {
@@ -70,5 +97,7 @@ func ConvertDtoToDomainOrder(dto OrderDto) domain.Order {
 		Status:         dto.Status,
 		Weight:         dto.Weight,
 		Cost:           dto.Cost,
+		Dimensions:     dto.Dimensions,
+		PickupPointID:  dto.PickupPointID,
 	}
 }
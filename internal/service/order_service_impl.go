@@ -3,35 +3,76 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/tx_manager"
-	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/workers"
 	"sort"
 	"time"
 )
 
 type OrderServiceImpl struct {
-	repo      OrderRepository
-	txManager tx_manager.TxManager
-	wm        *workers.WorkerManager
+	repo         OrderRepository
+	txManager    tx_manager.TransactionManager
+	audit        OrderStatusAuditRepository
+	sm           *domain.OrderStateMachine
+	catalog      *PackagingCatalog
+	pickupPoints PickupPointRepository
+	cells        StorageCellRepository
+	codes        *PickupCodes
+	refunds      *Refunds
+	archive      OrderArchiveRepository
+	manifests    ReturnManifestRepository
+	shipments    InboundShipmentRepository
+	stocktaking  StocktakingRepository
+	incidents    IncidentRepository
+	fees         *StorageFees
+	deadLetters  DeadLetterRepository
 }
 
-func NewOrderServiceImpl(repo OrderRepository, txManager tx_manager.TxManager, wm *workers.WorkerManager) *OrderServiceImpl {
+func NewOrderServiceImpl(
+	repo OrderRepository,
+	txManager tx_manager.TransactionManager,
+	audit OrderStatusAuditRepository,
+	catalog *PackagingCatalog,
+	pickupPoints PickupPointRepository,
+	cells StorageCellRepository,
+	codes *PickupCodes,
+	refunds *Refunds,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+	stocktaking StocktakingRepository,
+	incidents IncidentRepository,
+	fees *StorageFees,
+	deadLetters DeadLetterRepository,
+) *OrderServiceImpl {
 	return &OrderServiceImpl{
-		repo:      repo,
-		txManager: txManager,
-		wm:        wm,
+		repo:         repo,
+		txManager:    txManager,
+		audit:        audit,
+		sm:           domain.NewOrderStateMachine(),
+		catalog:      catalog,
+		pickupPoints: pickupPoints,
+		cells:        cells,
+		codes:        codes,
+		refunds:      refunds,
+		archive:      archive,
+		manifests:    manifests,
+		shipments:    shipments,
+		stocktaking:  stocktaking,
+		incidents:    incidents,
+		fees:         fees,
+		deadLetters:  deadLetters,
 	}
 }
 
 func (o *OrderServiceImpl) AddOrder(
 	ctx context.Context,
 	orderDto OrderDto,
-	packageType domain.PackageType,
-	isAdditionalFilm bool,
+	packaging []domain.PackageType,
 ) (domain.Order, error) {
 	or := ConvertDtoToDomainOrder(orderDto)
 	if or.ExpirationTime.Before(time.Now()) {
@@ -39,28 +80,49 @@ func (o *OrderServiceImpl) AddOrder(
 
 		return domain.Order{}, domain.ErrExpirationDateInPast
 	}
+	if or.PickupPointID == 0 {
+		or.PickupPointID = domain.DefaultPickupPointID
+	}
+	if _, err := o.pickupPoints.Find(ctx, or.PickupPointID); err != nil {
+		monitoring.OrdersFailedCreationTotal.Inc()
 
-	packagingStrategy, err := domain.GetPackagingStrategy(packageType)
+		return domain.Order{}, err
+	}
+
+	quote, err := o.QuoteOrder(or.Weight, or.Cost, or.Dimensions, packaging)
 	if err != nil {
 		monitoring.OrdersFailedCreationTotal.Inc()
 
 		return domain.Order{}, err
 	}
+	if err := or.ApplyPackaging(quote.Packaging); err != nil {
+		monitoring.OrdersFailedCreationTotal.Inc()
 
-	finalCost, err := applyPackagingStrategy(packagingStrategy, isAdditionalFilm, or.Weight, or.Cost)
-	if err != nil {
+		return domain.Order{}, err
+	}
+	if err := o.place(&or); err != nil {
 		monitoring.OrdersFailedCreationTotal.Inc()
 
 		return domain.Order{}, err
 	}
 
 	var order domain.Order
-	if err := o.txManager.RunSerializable(ctx, func(_ context.Context) error {
-		id, err := o.repo.Create(ctx, or.OrderID, or.UserID, or.ExpirationTime, or.Weight, finalCost)
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		id, err := o.repo.Create(ctxTx, or)
+		if err != nil {
+			return err
+		}
+		cell, err := o.assignStorageCell(ctxTx, or)
+		if err != nil {
+			return err
+		}
+		code, err := o.codes.Issue(ctxTx, id)
 		if err != nil {
 			return err
 		}
-		order, err = o.repo.Find(ctx, id)
+		order, err = o.repo.Find(ctxTx, id)
+		order.StorageCell = cell.Code
+		order.PickupCode = code
 
 		return err
 	}); err != nil {
@@ -69,25 +131,86 @@ func (o *OrderServiceImpl) AddOrder(
 		return domain.Order{}, err
 	}
 
+	o.reportPointOccupancy(ctx, order.PickupPointID)
 	monitoring.OrdersCreatedTotal.Inc()
 
 	return order, nil
 }
 
-func (o *OrderServiceImpl) RetrieveOrdersFromFile(ctx context.Context, data []byte) error {
+// place puts a newly accepted order on the shelf, orders are stored only once
+// they are placed.
+func (o *OrderServiceImpl) place(or *domain.Order) error {
+	or.Status = domain.Accepted
+	status, err := o.sm.Fire(*or, domain.PlaceAction, o.transitionContext(or.UserID, 0))
+	if err != nil {
+		return err
+	}
+	or.Status = status
+
+	return nil
+}
+
+// RetrieveOrdersFromFile accepts every order of the file in one transaction
+// and returns their pickup codes, they are not stored anywhere in plain form.
+func (o *OrderServiceImpl) RetrieveOrdersFromFile(ctx context.Context, data []byte) ([]domain.IssuedPickupCode, error) {
 	var newOrders []External
 	if err := json.Unmarshal(data, &newOrders); err != nil {
-		return fmt.Errorf("failed to unmarshal orders file: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal orders file: %w", err)
 	}
 
+	orders := make([]domain.Order, 0, len(newOrders))
 	for _, order := range newOrders {
-		_, err := o.repo.Create(ctx, order.OrderID, order.UserID, order.ExpirationTime, order.Weight, order.Cost)
+		cost, err := order.GetCost()
 		if err != nil {
-			return err
+			return nil, err
+		}
+		or := domain.Order{
+			OrderID:        order.OrderID,
+			UserID:         order.UserID,
+			ExpirationTime: order.ExpirationTime,
+			Weight:         order.Weight,
+			Cost:           cost,
+			PickupPointID:  order.GetPickupPointID(),
+		}
+		if err := or.ApplyPackaging(nil); err != nil {
+			return nil, err
+		}
+		if err := o.place(&or); err != nil {
+			return nil, err
 		}
+		orders = append(orders, or)
 	}
 
-	return nil
+	var issued []domain.IssuedPickupCode
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		issued = make([]domain.IssuedPickupCode, 0, len(orders))
+		for _, or := range orders {
+			if _, err := o.repo.Create(ctxTx, or); err != nil {
+				return fmt.Errorf("order %d: %w", or.OrderID, err)
+			}
+			if _, err := o.assignStorageCell(ctxTx, or); err != nil {
+				return fmt.Errorf("order %d: %w", or.OrderID, err)
+			}
+			code, err := o.codes.Issue(ctxTx, or.OrderID)
+			if err != nil {
+				return err
+			}
+			issued = append(issued, domain.IssuedPickupCode{OrderID: or.OrderID, PickupCode: code})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("o.txManager.RunSerializable from RetrieveOrdersFromFile: %w", err)
+	}
+
+	points := make(map[int64]struct{})
+	for _, or := range orders {
+		if _, ok := points[or.PickupPointID]; !ok {
+			points[or.PickupPointID] = struct{}{}
+			o.reportPointOccupancy(ctx, or.PickupPointID)
+		}
+	}
+	return issued, nil
 }
 
 func (o *OrderServiceImpl) GetOrdersByUserID(
@@ -106,10 +229,13 @@ func (o *OrderServiceImpl) GetOrdersByUserID(
 		Status:         nil,
 	}
 
-	if err := o.txManager.RunReadUncommitted(ctx, func(_ context.Context) error {
-		orders, err = o.repo.FindAll(ctx, filter, lastID, limit)
+	if err := o.txManager.RunReadUncommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = o.repo.FindAll(ctxTx, filter, lastID, limit)
+		if err != nil {
+			return err
+		}
 
-		return err
+		return o.fees.AccrueAll(ctxTx, orders, time.Now())
 	}); err != nil {
 		return []domain.Order{}, err
 	}
@@ -117,91 +243,146 @@ func (o *OrderServiceImpl) GetOrdersByUserID(
 	return orders, err
 }
 
+// ReturnOrder hands a single order back to the courier. Orders of an open
+// return manifest leave only when the manifest is closed.
 func (o *OrderServiceImpl) ReturnOrder(ctx context.Context, orderID int64) error {
-	if err := o.txManager.RunSerializable(ctx, func(_ context.Context) error {
-		or, err := o.repo.Find(ctx, orderID)
+	var returned statusChange
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		inManifest, err := o.manifests.IsInOpenManifest(ctxTx, orderID)
 		if err != nil {
-			return domain.ErrOrderNotFound
-		}
-
-		if or.Status == domain.Completed {
-			return domain.ErrOrderAlreadyCompleted
-		}
-
-		if or.Status == domain.Confirmed {
-			if or.ExpirationTime.After(time.Now()) {
-				return domain.ErrExpirationDateInFuture
-			}
+			return err
 		}
-
-		if or.Status != domain.Refunded {
-			return domain.ErrOrderHasToBeRefunded
+		if inManifest {
+			return domain.ErrOrderIsInReturnManifest
 		}
+		returned, err = o.returnInTx(ctxTx, orderID)
 
-		return o.repo.Delete(ctx, orderID)
+		return err
 	}); err != nil {
-		return fmt.Errorf("o.txManager.RunSerializable from ReturnOrder, err: %v", err)
+		return fmt.Errorf("o.txManager.RunSerializable from ReturnOrder: %w", err)
 	}
+	o.reportPointOccupancy(ctx, returned.order.PickupPointID)
 	monitoring.OrdersReturnedTotal.Inc()
 
 	return nil
 }
 
-func (o *OrderServiceImpl) RefundOrder(ctx context.Context, orderID int64, expirationDays int) error {
-	var (
-		prevStatus domain.Status
-		newStatus  domain.Status
-	)
+// returnInTx frees the cell of the order and moves it to the archive.
+func (o *OrderServiceImpl) returnInTx(ctxTx context.Context, orderID int64) (statusChange, error) {
+	or, err := o.repo.Find(ctxTx, orderID)
+	if err != nil {
+		return statusChange{}, domain.ErrOrderNotFound
+	}
+
+	status, err := o.sm.Fire(or, domain.ReturnAction, o.transitionContext(or.UserID, 0))
+	if err != nil {
+		return statusChange{}, err
+	}
+	if err := o.releaseStorageCell(ctxTx, orderID); err != nil {
+		return statusChange{}, err
+	}
+	if err := o.repo.Archive(ctxTx, orderID, status); err != nil {
+		return statusChange{}, err
+	}
+	returned := statusChange{order: or, newStatus: status}
+
+	return returned, o.auditStatusChange(ctxTx, returned)
+}
+
+// RefundOrder accepts the order back from its owner. The reason has to be one
+// of the refund reasons catalog.
+func (o *OrderServiceImpl) RefundOrder(
+	ctx context.Context,
+	orderID int64,
+	userID int64,
+	expirationDays int,
+	details domain.RefundDetails,
+) error {
+	if err := o.refunds.Validate(details); err != nil {
+		return err
+	}
 
-	if err := o.txManager.RunSerializable(ctx, func(_ context.Context) error {
-		or, err := o.repo.Find(ctx, orderID)
+	var refunded statusChange
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		or, err := o.repo.Find(ctxTx, orderID)
 		if err != nil {
 			return domain.ErrOrderNotFound
 		}
-
-		if or.Status != domain.Completed {
-			return domain.ErrOrderNotCompleted
-		}
-
-		refundPeriod := time.Duration(24*expirationDays) * time.Hour
-		if or.LastChangedAt.After(time.Now().Add(refundPeriod)) {
-			return domain.ErrOrderCannotBeRefunded
+		if err := domain.OwnedByUser(or, o.transitionContext(userID, 0)); err != nil {
+			return err
 		}
-		status := domain.Refunded
-		prevStatus = or.Status
-
-		_, err = o.repo.Update(ctx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost)
-
-		newStatus = status
+		refunded, err = o.refundInTx(ctxTx, or, expirationDays, details)
 
 		return err
 	}); err != nil {
 		return fmt.Errorf("o.txManager.RunSerializable from RefundOrder: %w", err)
 	}
-
-	info := domain.AuditOrderInfo{
-		OrderID:        orderID,
-		PreviousStatus: prevStatus,
-		CurrentStatus:  newStatus,
-	}
-	o.wm.LogAudit(info)
+	o.reportPointOccupancy(ctx, refunded.order.PickupPointID)
 	monitoring.OrdersRefundedTotal.Inc()
 
 	return nil
 }
 
+// refundInTx moves the order to refunded within the caller's transaction if
+// its refund period has not passed yet and stores the refund details. The
+// parcel goes back on the shelf, so it gets a cell like a new one.
+func (o *OrderServiceImpl) refundInTx(
+	ctxTx context.Context,
+	or domain.Order,
+	expirationDays int,
+	details domain.RefundDetails,
+) (statusChange, error) {
+	days, err := o.expirationDays(ctxTx, or, expirationDays)
+	if err != nil {
+		return statusChange{}, err
+	}
+
+	status, err := o.sm.Fire(or, domain.RefundAction, o.transitionContext(or.UserID, days))
+	if err != nil {
+		return statusChange{}, err
+	}
+	if _, err = o.repo.Update(ctxTx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost); err != nil {
+		return statusChange{}, err
+	}
+	if _, err := o.assignStorageCell(ctxTx, or); err != nil {
+		return statusChange{}, err
+	}
+	saved, err := o.refunds.Save(ctxTx, or.OrderID, details)
+	if err != nil {
+		return statusChange{}, err
+	}
+	refunded := statusChange{order: or, newStatus: status, refund: &saved}
+
+	return refunded, o.auditStatusChange(ctxTx, refunded)
+}
+
 func (o *OrderServiceImpl) GetOrderByID(ctx context.Context, orderID int64) (domain.Order, error) {
 	var (
 		order domain.Order
 		err   error
 	)
-	if err := o.txManager.RunReadUncommitted(ctx, func(_ context.Context) error {
-		order, err = o.repo.Find(ctx, orderID)
+	if err := o.txManager.RunReadUncommitted(ctx, func(ctxTx context.Context) error {
+		order, err = o.repo.Find(ctxTx, orderID)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		cell, err := o.cells.FindByOrder(ctxTx, orderID)
+		switch {
+		case err == nil:
+			order.StorageCell = cell.Code
+		case !errors.Is(err, domain.ErrStorageCellNotFound):
+			return err
+		}
+
+		if order.Status == domain.Refunded {
+			if order.Refund, err = o.refunds.Find(ctxTx, orderID); err != nil {
+				return err
+			}
+		}
+		order.StorageFee, err = o.fees.Accrue(ctxTx, order, time.Now())
+
+		return err
 	}); err != nil {
 		return domain.Order{}, err
 	}
@@ -209,49 +390,199 @@ func (o *OrderServiceImpl) GetOrderByID(ctx context.Context, orderID int64) (dom
 	return order, nil
 }
 
-func (o *OrderServiceImpl) CompleteOrder(ctx context.Context, orderID int64, userID int64) error {
-	var (
-		prevStatus domain.Status
-		newStatus  domain.Status
-	)
+// CompleteOrder issues the order to its owner who has to show the pickup code.
+// An accrued storage fee has to be acknowledged as paid.
+func (o *OrderServiceImpl) CompleteOrder(
+	ctx context.Context,
+	orderID int64,
+	userID int64,
+	pickupCode string,
+	storageFeePaid bool,
+) error {
+	if pickupCode == "" {
+		return domain.ErrPickupCodeRequired
+	}
+
+	_, err := o.completeOrder(ctx, orderID, userID, storageFeePaid, func(ctxTx context.Context) error {
+		return o.codes.Verify(ctxTx, orderID, pickupCode)
+	})
 
-	if err := o.txManager.RunSerializable(ctx, func(_ context.Context) error {
-		or, err := o.repo.Find(ctx, orderID)
+	return err
+}
+
+// OverrideCompleteOrder issues the order without its pickup code on behalf of
+// the supervisor authenticated in ctx. The override is recorded together with
+// the status change.
+func (o *OrderServiceImpl) OverrideCompleteOrder(
+	ctx context.Context,
+	orderID int64,
+	userID int64,
+	reason string,
+	storageFeePaid bool,
+) error {
+	supervisorID, ok := SupervisorFromContext(ctx)
+	if !ok {
+		return domain.ErrSupervisorRequired
+	}
+	override, err := domain.NewPickupOverride(orderID, supervisorID, reason)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.completeOrder(ctx, orderID, userID, storageFeePaid, func(ctxTx context.Context) error {
+		return o.codes.Override(ctxTx, override)
+	})
+
+	return err
+}
+
+// IssuePickupCode replaces the pickup code of an order that waits for its
+// owner, e.g. when the customer lost the first one. Only the supervisor
+// authenticated in ctx may hand out a new code, after checking the identity of
+// the customer userID. Every reissue is recorded.
+func (o *OrderServiceImpl) IssuePickupCode(ctx context.Context, orderID int64, userID int64) (string, error) {
+	supervisorID, ok := SupervisorFromContext(ctx)
+	if !ok {
+		return "", domain.ErrSupervisorRequired
+	}
+	reissue := domain.PickupCodeReissue{OrderID: orderID, UserID: userID, SupervisorID: &supervisorID}
+
+	var code string
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		or, err := o.repo.Find(ctxTx, orderID)
 		if err != nil {
 			return domain.ErrOrderNotFound
 		}
-		if or.UserID != userID {
-			return domain.ErrOrderNotBelongToUser
+		if err := domain.OwnedByUser(or, o.transitionContext(userID, 0)); err != nil {
+			return err
 		}
-		if or.ExpirationTime.Before(time.Now()) {
-			return domain.ErrExpirationDateInPast
+		if or.Status != domain.Confirmed {
+			return domain.ErrTransitionNotAllowed
 		}
+		code, err = o.codes.Reissue(ctxTx, reissue)
 
-		if or.Status == domain.Completed {
-			return domain.ErrOrderAlreadyCompleted
-		}
+		return err
+	}); err != nil {
+		return "", fmt.Errorf("o.txManager.RunSerializable from IssuePickupCode: %w", err)
+	}
 
-		status := domain.Completed
-		prevStatus = or.Status
+	return code, nil
+}
 
-		_, err = o.repo.Update(ctx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost)
+// completeOrder moves the order to completed once authorize lets it go. A
+// rejected pickup code is still committed so that wrong attempts are counted.
+func (o *OrderServiceImpl) completeOrder(
+	ctx context.Context,
+	orderID int64,
+	userID int64,
+	storageFeePaid bool,
+	authorize func(ctxTx context.Context) error,
+) (statusChange, error) {
+	var (
+		completed statusChange
+		rejection error
+	)
+
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		completed, err = o.completeInTx(ctxTx, orderID, userID, storageFeePaid, authorize)
+		if isPickupCodeRejection(err) {
+			rejection = err
 
-		newStatus = status
+			return nil
+		}
 
 		return err
 	}); err != nil {
-		return fmt.Errorf("o.txManager.RunSerializable from CompleteOrder: %w", err)
+		return statusChange{}, fmt.Errorf("o.txManager.RunSerializable from CompleteOrder: %w", err)
+	}
+	if rejection != nil {
+		return statusChange{}, rejection
+	}
+	o.reportPointOccupancy(ctx, completed.order.PickupPointID)
+	o.logCompletion(completed)
+
+	return completed, nil
+}
+
+// statusChange is an order as it was before a transition and the status it
+// moved to. Refund is set for refunds, fee for issued orders that paid for
+// storage, incident for changes made by incidents.
+type statusChange struct {
+	order     domain.Order
+	newStatus domain.Status
+	refund    *domain.RefundDetails
+	fee       *domain.StorageFee
+	incident  *int64
+}
+
+// auditStatusChange records the change in the order status audit within the
+// transaction of the change, so its event is published only if the change
+// commits.
+func (o *OrderServiceImpl) auditStatusChange(ctxTx context.Context, change statusChange) error {
+	_, err := o.audit.Create(ctxTx, domain.AuditOrderInfo{
+		OrderID:        change.order.OrderID,
+		PreviousStatus: change.order.Status,
+		CurrentStatus:  change.newStatus,
+		Refund:         change.refund,
+		IncidentID:     change.incident,
+	})
+
+	return err
+}
+
+// completeInTx issues the order to its owner within the caller's transaction.
+// Pickup code rejections are returned as is so that the caller decides whether
+// the failed attempt is committed. The storage fee is checked before the code,
+// so that an unpaid fee does not cost the customer an attempt.
+func (o *OrderServiceImpl) completeInTx(
+	ctxTx context.Context,
+	orderID int64,
+	userID int64,
+	storageFeePaid bool,
+	authorize func(ctxTx context.Context) error,
+) (statusChange, error) {
+	or, err := o.repo.Find(ctxTx, orderID)
+	if err != nil {
+		return statusChange{}, domain.ErrOrderNotFound
 	}
 
-	info := domain.AuditOrderInfo{
-		OrderID:        orderID,
-		PreviousStatus: prevStatus,
-		CurrentStatus:  newStatus,
+	status, err := o.sm.Fire(or, domain.CompleteAction, o.transitionContext(userID, 0))
+	if err != nil {
+		return statusChange{}, err
+	}
+	now := time.Now()
+	fee, err := o.fees.Accrue(ctxTx, or, now)
+	if err != nil {
+		return statusChange{}, err
+	}
+	if fee != nil && fee.IsDue() && !storageFeePaid {
+		return statusChange{}, domain.ErrStorageFeeNotPaid
+	}
+	if err := authorize(ctxTx); err != nil {
+		return statusChange{}, err
 	}
-	o.wm.LogAudit(info)
-	monitoring.OrdersCompletedTotal.Inc()
 
-	return nil
+	if _, err = o.repo.Update(ctxTx, or.OrderID, or.UserID, or.ExpirationTime, status, or.Weight, or.Cost); err != nil {
+		return statusChange{}, err
+	}
+	if err := o.releaseStorageCell(ctxTx, orderID); err != nil {
+		return statusChange{}, err
+	}
+	if err := o.fees.Collect(ctxTx, or, fee, now); err != nil {
+		return statusChange{}, err
+	}
+	completed := statusChange{order: or, newStatus: status, fee: fee}
+
+	return completed, o.auditStatusChange(ctxTx, completed)
+}
+
+func (o *OrderServiceImpl) logCompletion(completed statusChange) {
+	monitoring.OrdersCompletedTotal.Inc()
+	if completed.fee != nil && completed.fee.IsDue() {
+		monitoring.StorageFeesCollectedTotal.WithLabelValues(string(completed.fee.Amount.Currency)).
+			Add(float64(completed.fee.Amount.Amount))
+	}
 }
 
 func (o *OrderServiceImpl) GetRefundedOrders(ctx context.Context, lastID *int64, limit *int) ([]domain.Order, error) {
@@ -261,13 +592,13 @@ func (o *OrderServiceImpl) GetRefundedOrders(ctx context.Context, lastID *int64,
 	)
 	status := domain.Refunded
 
-	if err := o.txManager.RunRepeatableRead(ctx, func(_ context.Context) error {
+	if err := o.txManager.RunRepeatableRead(ctx, func(ctxTx context.Context) error {
 		filter := repository.Filter{
 			ExpirationTime: nil,
 			Status:         &status,
 			UserID:         nil,
 		}
-		orders, err = o.repo.FindAll(ctx, filter, lastID, limit)
+		orders, err = o.repo.FindAll(ctxTx, filter, lastID, limit)
 
 		return err
 	}); err != nil {
@@ -287,7 +618,7 @@ func (o *OrderServiceImpl) GetOrders(
 		orders []domain.Order
 		err    error
 	)
-	if err := o.txManager.RunRepeatableRead(ctx, func(_ context.Context) error {
+	if err := o.txManager.RunRepeatableRead(ctx, func(ctxTx context.Context) error {
 		var filter repository.Filter
 		if searchFilter != nil {
 			filter = repository.Filter{
@@ -295,6 +626,9 @@ func (o *OrderServiceImpl) GetOrders(
 				UserID:         searchFilter.UserID,
 				ExpirationTime: searchFilter.ExpirationTime,
 				Status:         (*domain.Status)(searchFilter.Status),
+				PickupPointID:  searchFilter.PickupPointID,
+				RefundReason:   searchFilter.RefundReason,
+				ItemCondition:  (*domain.ItemCondition)(searchFilter.ItemCondition),
 			}
 			if searchFilter.SearchTerm != nil {
 				term := "%" + *searchFilter.SearchTerm + "%"
@@ -302,7 +636,7 @@ func (o *OrderServiceImpl) GetOrders(
 			}
 		}
 
-		orders, err = o.repo.FindAll(ctx, filter, lastID, limit)
+		orders, err = o.repo.FindAll(ctxTx, filter, lastID, limit)
 		if err != nil {
 			return err
 		}
@@ -310,7 +644,7 @@ func (o *OrderServiceImpl) GetOrders(
 			return orders[i].LastChangedAt.Before(orders[j].LastChangedAt)
 		})
 
-		return nil
+		return o.fees.AccrueAll(ctxTx, orders, time.Now())
 	}); err != nil {
 		return []domain.Order{}
 	}
@@ -331,7 +665,7 @@ func (o *OrderServiceImpl) GetOrdersBySpecificStatus(ctx context.Context, status
 			UserID:         nil,
 		}
 
-		orders, err = o.repo.FindAll(ctx, filter, nil, nil)
+		orders, err = o.repo.FindAll(ctxTx, filter, nil, nil)
 		if err != nil {
 			return fmt.Errorf("o.repository.FindAll: %w", err)
 		}
@@ -344,27 +678,150 @@ func (o *OrderServiceImpl) GetOrdersBySpecificStatus(ctx context.Context, status
 	return orders
 }
 
-func applyPackagingStrategy(
-	packageStrategy domain.Package,
-	withAdditionalFilm bool,
-	weight int,
-	cost int,
-) (int, error) {
-	if err := packageStrategy.ValidatePackagedOrder(weight); err != nil {
+// ListAllowedTransitions returns transitions available for the order right now
+// to the user, owner-only transitions are listed for the owner only.
+func (o *OrderServiceImpl) ListAllowedTransitions(
+	ctx context.Context,
+	orderID int64,
+	userID int64,
+	expirationDays int,
+) ([]domain.Transition, error) {
+	if userID <= 0 {
+		return nil, domain.ErrUserIDRequired
+	}
+	order, err := o.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	days, err := o.expirationDays(ctx, order, expirationDays)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.sm.AllowedTransitions(order, o.transitionContext(userID, days)), nil
+}
+
+// expirationDays returns the refund period of the pickup point the order
+// belongs to, falling back to defaultDays when the point does not override it.
+func (o *OrderServiceImpl) expirationDays(ctx context.Context, order domain.Order, defaultDays int) (int, error) {
+	if order.PickupPointID == 0 {
+		return defaultDays, nil
+	}
+	point, err := o.pickupPoints.Find(ctx, order.PickupPointID)
+	if err != nil {
 		return 0, err
 	}
 
-	totalPackagingCost := packageStrategy.GetCost()
+	return point.ExpirationDays(defaultDays), nil
+}
 
-	if withAdditionalFilm {
-		filmPackage := domain.FilmPackage{}
+func (o *OrderServiceImpl) transitionContext(userID int64, expirationDays int) domain.TransitionContext {
+	return domain.TransitionContext{
+		UserID:       userID,
+		Now:          time.Now(),
+		RefundPeriod: time.Duration(24*expirationDays) * time.Hour,
+	}
+}
 
-		if err := filmPackage.ValidatePackagedOrder(weight); err != nil {
-			return 0, err
+func (o *OrderServiceImpl) ResolvePackage(packageType domain.PackageType) (domain.PackageSpec, error) {
+	return o.catalog.Resolve(packageType)
+}
+
+func (o *OrderServiceImpl) ListPackages(_ context.Context) []domain.PackageSpec {
+	return o.catalog.List()
+}
+
+func (o *OrderServiceImpl) SavePackage(ctx context.Context, spec domain.PackageSpec) error {
+	return o.catalog.Save(ctx, spec)
+}
+
+// QuoteOrder prices an order the same way AddOrder does without storing anything.
+// An auto layer is replaced with the package the catalog recommends.
+func (o *OrderServiceImpl) QuoteOrder(
+	weight int,
+	cost domain.Money,
+	dimensions domain.Dimensions,
+	packaging []domain.PackageType,
+) (domain.Quote, error) {
+	if weight < 0 || cost.IsNegative() || dimensions.Length < 0 || dimensions.Width < 0 || dimensions.Height < 0 {
+		return domain.Quote{}, domain.ErrOrderFieldsAreIncorrect
+	}
+	if !cost.Currency.IsValid() {
+		return domain.Quote{}, fmt.Errorf("%w: %w", domain.ErrOrderFieldsAreIncorrect, domain.ErrUnknownCurrency)
+	}
+
+	resolved := make([]domain.PackageType, len(packaging))
+	for i, packageType := range packaging {
+		if packageType != domain.Auto {
+			resolved[i] = packageType
+
+			continue
+		}
+		spec, err := o.catalog.Recommend(weight, dimensions, cost.Currency)
+		if err != nil {
+			return domain.Quote{}, err
 		}
+		resolved[i] = spec.Name
+	}
 
-		totalPackagingCost += filmPackage.GetCost()
+	layers, err := o.catalog.Compose(resolved)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+
+	total, breakdown, err := applyPackagingStrategy(layers, weight, dimensions, cost)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+
+	packagingCost, err := total.Sub(cost)
+	if err != nil {
+		return domain.Quote{}, err
+	}
+
+	return domain.Quote{
+		BaseCost:      cost,
+		Packaging:     breakdown,
+		PackagingCost: packagingCost,
+		TotalCost:     total,
+	}, nil
+}
+
+// RecommendPackaging quotes the package the catalog recommends for the parcel.
+func (o *OrderServiceImpl) RecommendPackaging(
+	weight int,
+	cost domain.Money,
+	dimensions domain.Dimensions,
+) (domain.Quote, error) {
+	return o.QuoteOrder(weight, cost, dimensions, []domain.PackageType{domain.Auto})
+}
+
+// applyPackagingStrategy validates every layer against the parcel weight and size
+// and returns the final cost together with the per-layer breakdown.
+func applyPackagingStrategy(
+	layers []domain.PackageSpec,
+	weight int,
+	dimensions domain.Dimensions,
+	cost domain.Money,
+) (domain.Money, []domain.PackagingLayer, error) {
+	total := cost
+	breakdown := make([]domain.PackagingLayer, 0, len(layers))
+
+	for i, layer := range layers {
+		if err := layer.ValidatePackagedOrder(weight, dimensions); err != nil {
+			return domain.Money{}, nil, fmt.Errorf("%w: %s", err, layer.Name)
+		}
+
+		var err error
+		if total, err = total.Add(layer.GetCost()); err != nil {
+			return domain.Money{}, nil, fmt.Errorf("%w: %s", err, layer.Name)
+		}
+		breakdown = append(breakdown, domain.PackagingLayer{
+			Position:    i,
+			PackageType: layer.Name,
+			Cost:        layer.GetCost(),
+		})
 	}
 
-	return cost + totalPackagingCost, nil
+	return total, breakdown, nil
 }
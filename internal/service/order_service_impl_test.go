@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/db"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository"
 	mock_repository "gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/service/mocks"
 	"testing"
 	"time"
@@ -22,22 +26,22 @@ func TestOrderServiceImpl_AddOrder(t *testing.T) {
 		StatusString      string
 		StatusModel       domain.Status
 		Weight            int
-		Cost              int
+		Cost              domain.Money
 		PackageTypeString string
 		PackageTypeModel  domain.PackageType
 		IsAdditionalFilm  bool
-	}{1, 1, time.Now().AddDate(0, 0, 1), "confirmed", domain.Confirmed, 1, 1, "box", domain.Box, false}
+	}{1, 1, time.Now().AddDate(0, 0, 1), "confirmed", domain.Confirmed, 1, rub(100), "box", domain.Box, false}
 
 	t.Run("expiration date is before current", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		prevTime := time.Now().AddDate(0, 0, -1)
-		dto := OrderDto{correctValues.OrderId, correctValues.UserID, prevTime, correctValues.StatusModel, correctValues.Weight, correctValues.Cost}
+		dto := OrderDto{correctValues.OrderId, correctValues.UserID, prevTime, correctValues.StatusModel, correctValues.Weight, correctValues.Cost, domain.Dimensions{}, 0}
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		repo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		repo.EXPECT().Create(ctx, gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
 
-		_, err := srv.AddOrder(ctx, dto, "box", correctValues.IsAdditionalFilm)
+		_, err := srv.AddOrder(ctx, dto, domain.NewPackagingLayers(correctValues.PackageTypeModel, correctValues.IsAdditionalFilm))
 
 		require.EqualError(t, err, "expiration date is in the past")
 	})
@@ -45,116 +49,1479 @@ func TestOrderServiceImpl_AddOrder(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		dto := OrderDto{correctValues.OrderId, correctValues.UserID, correctValues.ExpirationTime, correctValues.StatusModel, correctValues.Weight, correctValues.Cost}
-		repo.EXPECT().Create(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
-		srv := OrderServiceImpl{repo: repo}
+		dto := OrderDto{correctValues.OrderId, correctValues.UserID, correctValues.ExpirationTime, correctValues.StatusModel, correctValues.Weight, correctValues.Cost, domain.Dimensions{}, 0}
+		repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) (int64, error) {
+			require.Equal(t, domain.Confirmed, order.Status)
+			require.Equal(t, domain.Box, order.PackageType)
+			require.Equal(t, correctValues.Cost, order.BaseCost)
+			require.Equal(t, testBox.Price, order.PackagingCost)
+			require.Equal(t, rub(correctValues.Cost.Amount+testBox.Price.Amount), order.Cost)
 
-		_, err := srv.AddOrder(ctx, dto, "box", correctValues.IsAdditionalFilm)
+			return order.OrderID, nil
+		}).Times(1)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{OrderID: correctValues.OrderId}, nil)
+		srv := newTestOrderService(repo)
+
+		_, err := srv.AddOrder(ctx, dto, domain.NewPackagingLayers(correctValues.PackageTypeModel, correctValues.IsAdditionalFilm))
+
+		require.NoError(t, err)
+	})
+	t.Run("auto packaging", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		dimensions := domain.Dimensions{Length: 20, Width: 20, Height: 20}
+		dto := OrderDto{correctValues.OrderId, correctValues.UserID, correctValues.ExpirationTime, correctValues.StatusModel, correctValues.Weight, correctValues.Cost, dimensions, 0}
+		repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) (int64, error) {
+			require.Equal(t, domain.Bag, order.PackageType)
+			require.Equal(t, dimensions, order.Dimensions)
+
+			return order.OrderID, nil
+		}).Times(1)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{OrderID: correctValues.OrderId}, nil)
+		srv := newTestOrderService(repo)
+
+		_, err := srv.AddOrder(ctx, dto, []domain.PackageType{domain.Auto})
+
+		require.NoError(t, err)
+	})
+	t.Run("unknown pickup point", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		dto := OrderDto{correctValues.OrderId, correctValues.UserID, correctValues.ExpirationTime, correctValues.StatusModel, correctValues.Weight, correctValues.Cost, domain.Dimensions{}, 42}
+		repo.EXPECT().Create(ctx, gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		_, err := srv.AddOrder(ctx, dto, domain.NewPackagingLayers(correctValues.PackageTypeModel, correctValues.IsAdditionalFilm))
+
+		require.ErrorIs(t, err, domain.ErrPickupPointNotFound)
+	})
+}
+
+func TestOrderServiceImpl_StorageCells(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx = context.Background()
+	)
+	const orderID int64 = 1
+	dto := OrderDto{orderID, 1, time.Now().AddDate(0, 0, 1), domain.Confirmed, 1, rub(100), domain.Dimensions{}, 0}
+	occupancy := domain.StorageOccupancy{PickupPointID: domain.DefaultPickupPointID, Total: 2, Occupied: 1}
+
+	t.Run("assigns cell", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		cells := mock_repository.NewMockStorageCellRepository(ctrl)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(orderID, nil)
+		repo.EXPECT().Find(ctx, orderID).Return(domain.Order{OrderID: orderID, PickupPointID: domain.DefaultPickupPointID}, nil)
+		cells.EXPECT().PointOccupancy(ctx, domain.DefaultPickupPointID).Return(occupancy, nil).AnyTimes()
+		cells.EXPECT().Assign(ctx, domain.DefaultPickupPointID, orderID, dto.Weight, dto.Dimensions).
+			Return(domain.StorageCell{Code: "A-01"}, nil)
+		srv := newTestOrderServiceWithCells(repo, cells)
+
+		order, err := srv.AddOrder(ctx, dto, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, "A-01", order.StorageCell)
+	})
+	t.Run("point is full", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		cells := mock_repository.NewMockStorageCellRepository(ctrl)
+		repo.EXPECT().Create(ctx, gomock.Any()).Return(orderID, nil)
+		repo.EXPECT().Find(ctx, gomock.Any()).Times(0)
+		cells.EXPECT().PointOccupancy(ctx, domain.DefaultPickupPointID).Return(occupancy, nil)
+		cells.EXPECT().Assign(ctx, domain.DefaultPickupPointID, orderID, dto.Weight, dto.Dimensions).
+			Return(domain.StorageCell{}, domain.ErrNoFreeStorageCell)
+		srv := newTestOrderServiceWithCells(repo, cells)
+
+		_, err := srv.AddOrder(ctx, dto, nil)
+
+		require.ErrorIs(t, err, domain.ErrNoFreeStorageCell)
+	})
+	t.Run("releases cell on issue", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		cells := mock_repository.NewMockStorageCellRepository(ctrl)
+		order := domain.Order{
+			OrderID:        orderID,
+			UserID:         dto.UserID,
+			ExpirationTime: dto.ExpirationTime,
+			Status:         domain.Confirmed,
+			PickupPointID:  domain.DefaultPickupPointID,
+		}
+		repo.EXPECT().Find(ctx, orderID).Return(order, nil)
+		repo.EXPECT().Update(ctx, orderID, gomock.Any(), gomock.Any(), domain.Completed, gomock.Any(), gomock.Any()).Return(orderID, nil)
+		cells.EXPECT().Release(ctx, orderID).Return(domain.StorageCell{Code: "A-01"}, nil)
+		cells.EXPECT().PointOccupancy(ctx, domain.DefaultPickupPointID).Return(occupancy, nil)
+		srv := newTestOrderServiceWithCells(repo, cells)
+
+		err := srv.CompleteOrder(ctx, orderID, dto.UserID, testPickupCode, false)
+
+		require.NoError(t, err)
+	})
+	t.Run("assigns cell on refund", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		cells := mock_repository.NewMockStorageCellRepository(ctrl)
+		order := domain.Order{
+			OrderID:       orderID,
+			UserID:        dto.UserID,
+			Status:        domain.Completed,
+			Weight:        dto.Weight,
+			PickupPointID: domain.DefaultPickupPointID,
+			LastChangedAt: time.Now().Add(-time.Hour),
+		}
+		repo.EXPECT().Find(ctx, orderID).Return(order, nil)
+		repo.EXPECT().Update(ctx, orderID, gomock.Any(), gomock.Any(), domain.Refunded, gomock.Any(), gomock.Any()).Return(orderID, nil)
+		cells.EXPECT().PointOccupancy(ctx, domain.DefaultPickupPointID).Return(occupancy, nil).Times(2)
+		cells.EXPECT().Assign(ctx, domain.DefaultPickupPointID, orderID, dto.Weight, dto.Dimensions).
+			Return(domain.StorageCell{Code: "A-02"}, nil)
+		srv := newTestOrderServiceWithCells(repo, cells)
+
+		err := srv.RefundOrder(ctx, orderID, dto.UserID, 7, testRefundDetails)
+
+		require.NoError(t, err)
+	})
+	t.Run("refund fails when point is full", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		cells := mock_repository.NewMockStorageCellRepository(ctrl)
+		order := domain.Order{
+			OrderID:       orderID,
+			UserID:        dto.UserID,
+			Status:        domain.Completed,
+			Weight:        dto.Weight,
+			PickupPointID: domain.DefaultPickupPointID,
+			LastChangedAt: time.Now().Add(-time.Hour),
+		}
+		full := domain.StorageOccupancy{PickupPointID: domain.DefaultPickupPointID, Total: 2, Occupied: 2}
+		repo.EXPECT().Find(ctx, orderID).Return(order, nil)
+		repo.EXPECT().Update(ctx, orderID, gomock.Any(), gomock.Any(), domain.Refunded, gomock.Any(), gomock.Any()).Return(orderID, nil)
+		cells.EXPECT().PointOccupancy(ctx, domain.DefaultPickupPointID).Return(full, nil)
+		cells.EXPECT().Assign(ctx, domain.DefaultPickupPointID, orderID, dto.Weight, dto.Dimensions).
+			Return(domain.StorageCell{}, domain.ErrNoFreeStorageCell)
+		srv := newTestOrderServiceWithCells(repo, cells)
+
+		err := srv.RefundOrder(ctx, orderID, dto.UserID, 7, testRefundDetails)
+
+		require.ErrorIs(t, err, domain.ErrNoFreeStorageCell)
+	})
+	t.Run("order location", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		cells := mock_repository.NewMockStorageCellRepository(ctrl)
+		repo.EXPECT().Find(ctx, orderID).Return(domain.Order{OrderID: orderID}, nil)
+		cells.EXPECT().FindByOrder(ctx, orderID).Return(domain.StorageCell{Code: "B-07"}, nil)
+		srv := newTestOrderServiceWithCells(repo, cells)
+
+		order, err := srv.GetOrderByID(ctx, orderID)
+
+		require.NoError(t, err)
+		require.Equal(t, "B-07", order.StorageCell)
+	})
+}
+
+func TestOrderServiceImpl_PickupCodes(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx = context.Background()
+	)
+	const (
+		orderID int64 = 1
+		userID  int64 = 1
+	)
+	order := domain.Order{
+		OrderID:        orderID,
+		UserID:         userID,
+		ExpirationTime: time.Now().Add(24 * time.Hour),
+		Status:         domain.Confirmed,
+	}
+
+	t.Run("code is required", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		srv := newTestOrderService(repo)
+
+		err := srv.CompleteOrder(ctx, orderID, userID, "", false)
+
+		require.ErrorIs(t, err, domain.ErrPickupCodeRequired)
+	})
+	t.Run("wrong code is counted", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		repo.EXPECT().Find(ctx, orderID).Return(order, nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		codes.EXPECT().Find(ctx, orderID).Return(testStoredPickupCode(orderID), nil)
+		codes.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, code domain.PickupCode) error {
+			require.Equal(t, 1, code.FailedAttempts)
+			require.Nil(t, code.UsedAt)
+
+			return nil
+		})
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		err := srv.CompleteOrder(ctx, orderID, userID, "000000", false)
+
+		require.ErrorIs(t, err, domain.ErrPickupCodeInvalid)
+	})
+	t.Run("last wrong attempt locks the code", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		stored := testStoredPickupCode(orderID)
+		stored.FailedAttempts = testPickupCodePolicy.MaxAttempts - 1
+		repo.EXPECT().Find(ctx, orderID).Return(order, nil)
+		codes.EXPECT().Find(ctx, orderID).Return(stored, nil)
+		codes.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, code domain.PickupCode) error {
+			require.True(t, code.IsLocked(time.Now()))
+
+			return nil
+		})
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		err := srv.CompleteOrder(ctx, orderID, userID, "000000", false)
+
+		require.ErrorIs(t, err, domain.ErrPickupCodeInvalid)
+	})
+	t.Run("locked code", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		stored := testStoredPickupCode(orderID)
+		lockedUntil := time.Now().Add(time.Minute)
+		stored.LockedUntil = &lockedUntil
+		repo.EXPECT().Find(ctx, orderID).Return(order, nil)
+		codes.EXPECT().Find(ctx, orderID).Return(stored, nil)
+		codes.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		err := srv.CompleteOrder(ctx, orderID, userID, testPickupCode, false)
+
+		require.ErrorIs(t, err, domain.ErrPickupCodeLocked)
+	})
+	t.Run("supervisor override", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		supervisorCtx := ContextWithSupervisor(ctx, 7)
+		override := domain.PickupOverride{OrderID: orderID, SupervisorID: 7, Reason: "customer lost the code"}
+		repo.EXPECT().Find(supervisorCtx, orderID).Return(order, nil)
+		repo.EXPECT().Update(supervisorCtx, orderID, userID, gomock.Any(), domain.Completed, gomock.Any(), gomock.Any()).Return(orderID, nil)
+		codes.EXPECT().Find(supervisorCtx, orderID).Return(domain.PickupCode{}, domain.ErrPickupCodeNotIssued)
+		codes.EXPECT().CreateOverride(supervisorCtx, override).Return(int64(1), nil)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		err := srv.OverrideCompleteOrder(supervisorCtx, orderID, userID, "customer lost the code", false)
+
+		require.NoError(t, err)
+	})
+	t.Run("override needs an authenticated supervisor", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		codes.EXPECT().CreateOverride(gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		err := srv.OverrideCompleteOrder(ctx, orderID, userID, "customer lost the code", false)
+
+		require.ErrorIs(t, err, domain.ErrSupervisorRequired)
+	})
+	t.Run("owner cannot reissue the code without a supervisor", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		repo.EXPECT().Find(gomock.Any(), gomock.Any()).Times(0)
+		codes.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		_, err := srv.IssuePickupCode(ctx, orderID, userID)
+
+		require.ErrorIs(t, err, domain.ErrSupervisorRequired)
+	})
+	t.Run("supervisor reissues the code", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		supervisorCtx := ContextWithSupervisor(ctx, 7)
+		supervisorID := int64(7)
+		repo.EXPECT().Find(supervisorCtx, orderID).Return(order, nil)
+		codes.EXPECT().Save(supervisorCtx, gomock.Any()).Return(nil)
+		codes.EXPECT().CreateReissue(supervisorCtx, domain.PickupCodeReissue{
+			OrderID:      orderID,
+			UserID:       userID,
+			SupervisorID: &supervisorID,
+		}).Return(int64(1), nil)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		code, err := srv.IssuePickupCode(supervisorCtx, orderID, userID)
+
+		require.NoError(t, err)
+		require.Len(t, code, domain.PickupCodeLength)
+	})
+	t.Run("supervisor cannot reissue the code to a stranger", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		supervisorCtx := ContextWithSupervisor(ctx, 7)
+		repo.EXPECT().Find(supervisorCtx, orderID).Return(order, nil)
+		codes.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		_, err := srv.IssuePickupCode(supervisorCtx, orderID, userID+1)
+
+		require.ErrorIs(t, err, domain.ErrOrderNotBelongToUser)
+	})
+}
+
+func TestOrderServiceImpl_RetrieveOrdersFromFile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	repo := mock_repository.NewMockOrderRepository(ctrl)
+	codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+	expiration := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	data := []byte(`[{"order_id":"1","user_id":"2","expiration_time":"` + expiration + `","weight":1,"cost":100,"currency":"RUB"}]`)
+	repo.EXPECT().Create(ctx, gomock.Any()).Return(int64(1), nil)
+	codes.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, code domain.PickupCode) error {
+		require.Equal(t, int64(1), code.OrderID)
+		require.NotEmpty(t, code.CodeHash)
+
+		return nil
+	})
+	srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+	issued, err := srv.RetrieveOrdersFromFile(ctx, data)
+
+	require.NoError(t, err)
+	require.Len(t, issued, 1)
+	require.Equal(t, int64(1), issued[0].OrderID)
+	require.Len(t, issued[0].PickupCode, domain.PickupCodeLength)
+}
+
+func TestExternal_GetCost(t *testing.T) {
+	t.Parallel()
+
+	cost, err := (&External{OrderID: 1, Cost: 10000, Currency: "RUB"}).GetCost()
+	require.NoError(t, err)
+	require.Equal(t, domain.Money{Amount: 10000, Currency: domain.RUB}, cost)
+
+	_, err = (&External{OrderID: 1, Cost: 100}).GetCost()
+	require.ErrorIs(t, err, domain.ErrCostCurrencyRequired)
+}
+
+func TestSupervisors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("token authenticates its supervisor", func(t *testing.T) {
+		t.Parallel()
+		supervisors, err := NewSupervisors([]config.SupervisorConfig{{ID: 7, Token: "seven"}, {ID: 8, Token: "eight"}})
+		require.NoError(t, err)
+
+		supervisorID, err := supervisors.Authenticate("eight")
+
+		require.NoError(t, err)
+		require.Equal(t, int64(8), supervisorID)
+	})
+	t.Run("unknown token", func(t *testing.T) {
+		t.Parallel()
+		supervisors, err := NewSupervisors([]config.SupervisorConfig{{ID: 7, Token: "seven"}})
+		require.NoError(t, err)
+
+		_, err = supervisors.Authenticate("")
+
+		require.ErrorIs(t, err, domain.ErrSupervisorTokenInvalid)
+	})
+	t.Run("incorrect config", func(t *testing.T) {
+		t.Parallel()
+		for _, cfg := range [][]config.SupervisorConfig{
+			{{ID: 0, Token: "zero"}},
+			{{ID: 7, Token: ""}},
+			{{ID: 7, Token: "same"}, {ID: 8, Token: "same"}},
+		} {
+			_, err := NewSupervisors(cfg)
+
+			require.ErrorIs(t, err, domain.ErrSupervisorsAreIncorrect)
+		}
+	})
+}
+
+func TestPickupCodeSecretFromConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, secret := range []string{"", DefaultPickupCodeSecret} {
+		_, err := PickupCodeSecretFromConfig(config.PickupCodeConfig{Secret: secret})
+
+		require.ErrorIs(t, err, domain.ErrPickupCodeSecretIsIncorrect)
+	}
+	secret, err := PickupCodeSecretFromConfig(config.PickupCodeConfig{Secret: testPickupCodeSecret})
+
+	require.NoError(t, err)
+	require.Equal(t, testPickupCodeSecret, secret)
+}
+
+func TestOrderServiceImpl_CompleteOrders(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx = context.Background()
+	)
+	const userID int64 = 1
+	newOrder := func(orderID int64, ownerID int64, amount int64) domain.Order {
+		return domain.Order{
+			OrderID:        orderID,
+			UserID:         ownerID,
+			ExpirationTime: time.Now().Add(24 * time.Hour),
+			Status:         domain.Confirmed,
+			Cost:           domain.Money{Amount: amount, Currency: domain.RUB},
+		}
+	}
+
+	t.Run("partial mode reports failures per order", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(newOrder(1, userID, 10000), nil)
+		repo.EXPECT().Find(ctx, int64(2)).Return(newOrder(2, 99, 20000), nil)
+		repo.EXPECT().Find(ctx, int64(3)).Return(newOrder(3, userID, 5000), nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), domain.Completed, gomock.Any(), gomock.Any()).
+			Return(int64(0), nil).Times(2)
+		srv := newTestOrderService(repo)
+
+		result, err := srv.CompleteOrders(ctx, userID, []domain.PickupItem{
+			{OrderID: 1, PickupCode: testPickupCode},
+			{OrderID: 2, PickupCode: testPickupCode},
+			{OrderID: 3, PickupCode: testPickupCode},
+		}, domain.BatchPartial)
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 3)
+		require.NoError(t, result.Items[0].Err)
+		require.ErrorIs(t, result.Items[1].Err, domain.ErrOrderNotBelongToUser)
+		require.NoError(t, result.Items[2].Err)
+		require.Equal(t, []domain.Money{{Amount: 15000, Currency: domain.RUB}}, result.Totals)
+	})
+	t.Run("atomic mode rolls back the whole batch", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		codes := mock_repository.NewMockPickupCodeRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(newOrder(1, userID, 10000), nil)
+		repo.EXPECT().Find(ctx, int64(2)).Return(newOrder(2, userID, 20000), nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(int64(0), nil).AnyTimes()
+		codes.EXPECT().Find(ctx, int64(1)).Return(testStoredPickupCode(1), nil)
+		// The wrong code is only checked in the batch and counted once after the rollback.
+		codes.EXPECT().Find(ctx, int64(2)).Return(testStoredPickupCode(2), nil).Times(2)
+		codes.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, code domain.PickupCode) error {
+			if code.OrderID == 2 {
+				require.Equal(t, 1, code.FailedAttempts)
+			}
+
+			return nil
+		}).Times(2)
+		srv := newTestOrderServiceWithCodes(repo, storageCellsStub{}, codes)
+
+		result, err := srv.CompleteOrders(ctx, userID, []domain.PickupItem{
+			{OrderID: 1, PickupCode: testPickupCode},
+			{OrderID: 2, PickupCode: "000000"},
+		}, domain.BatchAtomic)
+
+		require.NoError(t, err)
+		require.ErrorIs(t, result.Items[0].Err, domain.ErrBatchRolledBack)
+		require.ErrorIs(t, result.Items[1].Err, domain.ErrPickupCodeInvalid)
+		require.Zero(t, result.Succeeded())
+		require.Empty(t, result.Totals)
+	})
+	t.Run("duplicate orders", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		srv := newTestOrderService(repo)
+
+		_, err := srv.CompleteOrders(ctx, userID, []domain.PickupItem{
+			{OrderID: 1, PickupCode: testPickupCode},
+			{OrderID: 1, PickupCode: testPickupCode},
+		}, domain.BatchAtomic)
+
+		require.ErrorIs(t, err, domain.ErrBatchHasDuplicates)
+	})
+}
+
+func Test_applyPackagingStrategy(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		layers     []domain.PackageSpec
+		weight     int
+		dimensions domain.Dimensions
+		cost       domain.Money
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    domain.Money
+		wantErr bool
+	}{
+		{"smoke test", args{[]domain.PackageSpec{testBox}, 1, domain.Dimensions{}, rub(100)}, rub(2100), false},
+		{"smoke test", args{[]domain.PackageSpec{testBag}, 1, domain.Dimensions{}, rub(100)}, rub(600), false},
+		{"smoke test", args{[]domain.PackageSpec{testFilm}, 1, domain.Dimensions{}, rub(100)}, rub(200), false},
+		{"box with additional film", args{[]domain.PackageSpec{testBox, testFilm}, 1, domain.Dimensions{}, rub(100)}, rub(2200), false},
+		{"bag in box in film", args{[]domain.PackageSpec{testBag, testBox, testFilm}, 1, domain.Dimensions{}, rub(100)}, rub(2700), false},
+		{"no packaging", args{nil, 1, domain.Dimensions{}, rub(100)}, rub(100), false},
+		{"box with too much weight", args{[]domain.PackageSpec{testBox}, 1000, domain.Dimensions{}, rub(100)}, domain.Money{}, true},
+		{"bag with too much weight", args{[]domain.PackageSpec{testBag, testFilm}, 1000, domain.Dimensions{}, rub(100)}, domain.Money{}, true},
+		{"bag too small", args{[]domain.PackageSpec{testBag}, 1, domain.Dimensions{Length: 40, Width: 30, Height: 30}, rub(100)}, domain.Money{}, true},
+		{"bag too short", args{[]domain.PackageSpec{testBag}, 1, domain.Dimensions{Length: 100, Width: 5, Height: 5}, rub(100)}, domain.Money{}, true},
+		{"fits into box", args{[]domain.PackageSpec{testBox}, 1, domain.Dimensions{Length: 100, Width: 5, Height: 5}, rub(100)}, rub(2100), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, breakdown, err := applyPackagingStrategy(tt.args.layers, tt.args.weight, tt.args.dimensions, tt.args.cost)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("applyPackagingStrategy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("applyPackagingStrategy() got = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && len(breakdown) != len(tt.args.layers) {
+				t.Errorf("applyPackagingStrategy() breakdown = %v, want %d layers", breakdown, len(tt.args.layers))
+			}
+		})
+	}
+}
+
+func TestPackagingCatalog_Compose(t *testing.T) {
+	t.Parallel()
+	inactive := domain.PackageSpec{Name: "crate", Price: rub(5000)}
+	noWrapFilm := testFilm
+	noWrapFilm.CanWrap = false
+
+	tests := []struct {
+		name    string
+		catalog []domain.PackageSpec
+		layers  []domain.PackageType
+		wantErr error
+	}{
+		{"box with film", testPackages, []domain.PackageType{domain.Box, domain.Film}, nil},
+		{"bag in box", testPackages, []domain.PackageType{domain.Bag, domain.Box}, nil},
+		{"no packaging", testPackages, nil, nil},
+		{"unknown package", testPackages, []domain.PackageType{"envelope"}, domain.ErrPackageNotExists},
+		{"inactive package", append([]domain.PackageSpec{inactive}, testPackages...), []domain.PackageType{"crate"}, domain.ErrPackageNotActive},
+		{"film cannot wrap", []domain.PackageSpec{testBox, noWrapFilm}, []domain.PackageType{domain.Box, domain.Film}, domain.ErrPackageCannotWrap},
+		{"bag cannot wrap", testPackages, []domain.PackageType{domain.Film, domain.Bag}, domain.ErrPackageCannotWrap},
+		{"film in film", testPackages, []domain.PackageType{domain.Film, domain.Film}, domain.ErrPackagingCompositionNotAllowed},
+		{"box in box", testPackages, []domain.PackageType{domain.Box, domain.Box}, domain.ErrPackagingCompositionNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			catalog := NewPackagingCatalog(nil, tt.catalog, testCompositionRules)
+
+			_, err := catalog.Compose(tt.layers)
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPackageSpecsFromConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid packages", func(t *testing.T) {
+		t.Parallel()
+		specs, err := PackageSpecsFromConfig([]config.PackageConfig{
+			{Name: "bag", MaxWeight: 10, Price: 500, IsActive: true},
+			{Name: "film", Price: 100, CanWrap: true, IsActive: true},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, specs, 2)
+		require.Equal(t, domain.DefaultCurrency, specs[0].Price.Currency)
+	})
+
+	t.Run("invalid package fails the catalog", func(t *testing.T) {
+		t.Parallel()
+		_, err := PackageSpecsFromConfig([]config.PackageConfig{
+			{Name: "bag", MaxWeight: 10, Price: 500, IsActive: true},
+			{Name: "box", MaxWeight: -1, Price: 2000, IsActive: true},
+		})
+
+		require.ErrorIs(t, err, domain.ErrPackageFieldsAreIncorrect)
+	})
+}
+
+func TestOrderServiceImpl_QuoteOrder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		weight    int
+		cost      domain.Money
+		packaging []domain.PackageType
+		want      domain.Quote
+		wantErr   error
+	}{
+		{"no packaging", 1, rub(10000), nil, domain.Quote{
+			BaseCost:      rub(10000),
+			Packaging:     []domain.PackagingLayer{},
+			PackagingCost: rub(0),
+			TotalCost:     rub(10000),
+		}, nil},
+		{"box with film", 1, rub(10000), []domain.PackageType{domain.Box, domain.Film}, domain.Quote{
+			BaseCost: rub(10000),
+			Packaging: []domain.PackagingLayer{
+				{Position: 0, PackageType: domain.Box, Cost: rub(2000)},
+				{Position: 1, PackageType: domain.Film, Cost: rub(100)},
+			},
+			PackagingCost: rub(2100),
+			TotalCost:     rub(12100),
+		}, nil},
+		{"too heavy for bag", 11, rub(10000), []domain.PackageType{domain.Bag}, domain.Quote{}, domain.ErrIncorrectWeightForApplyPackage},
+		{"unknown package", 1, rub(10000), []domain.PackageType{"envelope"}, domain.Quote{}, domain.ErrPackageNotExists},
+		{"negative cost", 1, rub(-1), nil, domain.Quote{}, domain.ErrOrderFieldsAreIncorrect},
+		{"unknown currency", 1, domain.Money{Amount: 100, Currency: "XXX"}, nil, domain.Quote{}, domain.ErrUnknownCurrency},
+		{"currency mismatch", 1, domain.Money{Amount: 100, Currency: domain.USD}, []domain.PackageType{domain.Box}, domain.Quote{}, domain.ErrCurrencyMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			repo := mock_repository.NewMockOrderRepository(ctrl)
+			srv := newTestOrderService(repo)
+
+			got, err := srv.QuoteOrder(tt.weight, tt.cost, domain.Dimensions{}, tt.packaging)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOrderServiceImpl_RecommendPackaging(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		catalog    []domain.PackageSpec
+		weight     int
+		dimensions domain.Dimensions
+		want       domain.PackageType
+		wantErr    error
+	}{
+		{"bag beats cheaper film", testPackages, 1, domain.Dimensions{Length: 20, Width: 20, Height: 20}, domain.Bag, nil},
+		{"heavy parcel goes into box before film", testPackages, 20, domain.Dimensions{}, domain.Box, nil},
+		{"film when nothing else fits", testPackages, 50, domain.Dimensions{Length: 200, Width: 100, Height: 100}, domain.Film, nil},
+		{"small parcel goes into bag", []domain.PackageSpec{testBox, testBag}, 1, domain.Dimensions{Length: 20, Width: 20, Height: 20}, domain.Bag, nil},
+		{"long parcel goes into box", []domain.PackageSpec{testBox, testBag}, 1, domain.Dimensions{Length: 100, Width: 5, Height: 5}, domain.Box, nil},
+		{"heavy parcel goes into box", []domain.PackageSpec{testBox, testBag}, 20, domain.Dimensions{}, domain.Box, nil},
+		{"nothing fits", []domain.PackageSpec{testBox, testBag}, 50, domain.Dimensions{}, "", domain.ErrNoSuitablePackage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := OrderServiceImpl{catalog: NewPackagingCatalog(nil, tt.catalog, testCompositionRules)}
+
+			quote, err := srv.RecommendPackaging(tt.weight, rub(10000), tt.dimensions)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Len(t, quote.Packaging, 1)
+				require.Equal(t, tt.want, quote.Packaging[0].PackageType)
+			}
+		})
+	}
+}
+
+func TestOrderServiceImpl_ReturnOrder(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx = context.Background()
+	)
+	correctValues := struct {
+		OrderId           int64
+		UserID            int64
+		ExpirationTime    time.Time
+		StatusString      string
+		StatusModel       domain.Status
+		Weight            int
+		Cost              domain.Money
+		PackageTypeString string
+		PackageTypeModel  domain.PackageType
+		IsAdditionalFilm  bool
+	}{1, 1, time.Now(), "confirmed", domain.Confirmed, 1, rub(100), "box", domain.Box, false}
+
+	t.Run("order not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{}, domain.ErrOrderNotFound)
+		repo.EXPECT().Archive(ctx, gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+
+		require.ErrorIs(t, err, domain.ErrOrderNotFound)
+	})
+	t.Run("order already completed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: domain.Completed}, nil)
+		repo.EXPECT().Archive(ctx, gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+
+		require.ErrorIs(t, err, domain.ErrOrderAlreadyCompleted)
+	})
+	t.Run("order has to be refunded", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: correctValues.StatusModel}, nil)
+		repo.EXPECT().Archive(ctx, gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+
+		require.ErrorIs(t, err, domain.ErrOrderHasToBeRefunded)
+	})
+	t.Run("expiration date is in the future", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		futureDate := time.Now().AddDate(0, 0, 1)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: correctValues.StatusModel, ExpirationTime: futureDate}, nil)
+		repo.EXPECT().Archive(ctx, gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+
+		require.ErrorIs(t, err, domain.ErrExpirationDateInFuture)
+	})
+	t.Run("refunded order is archived", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{OrderID: correctValues.OrderId, Status: domain.Refunded}, nil)
+		repo.EXPECT().Archive(ctx, correctValues.OrderId, domain.ReturnedToCourier).Return(nil)
+		srv := newTestOrderService(repo)
+
+		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestOrderServiceImpl_ExpireOrders(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		past = time.Now().AddDate(0, 0, -1)
+	)
+
+	t.Run("expired orders await return", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().FindExpired(ctx, gomock.Any(), 10).Return([]domain.Order{
+			{OrderID: 1, UserID: 1, Status: domain.Confirmed, ExpirationTime: past},
+			{OrderID: 2, UserID: 2, Status: domain.Confirmed, ExpirationTime: past},
+		}, nil)
+		repo.EXPECT().Update(ctx, int64(1), int64(1), past, domain.AwaitingReturn, gomock.Any(), gomock.Any()).Return(int64(1), nil)
+		repo.EXPECT().Update(ctx, int64(2), int64(2), past, domain.AwaitingReturn, gomock.Any(), gomock.Any()).Return(int64(2), nil)
+		srv := newTestOrderService(repo)
+
+		expired, err := srv.ExpireOrders(ctx, 10)
+
+		require.NoError(t, err)
+		require.Equal(t, 2, expired)
+	})
+	t.Run("nothing expired", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().FindExpired(ctx, gomock.Any(), 10).Return(nil, nil)
+		repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		expired, err := srv.ExpireOrders(ctx, 10)
+
+		require.NoError(t, err)
+		require.Zero(t, expired)
+	})
+	t.Run("order awaiting return cannot be completed", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(domain.Order{OrderID: 1, UserID: 1, Status: domain.AwaitingReturn, ExpirationTime: past}, nil)
+		srv := newTestOrderService(repo)
+
+		err := srv.CompleteOrder(ctx, 1, 1, testPickupCode, false)
+
+		require.ErrorIs(t, err, domain.ErrExpirationDateInPast)
+	})
+	t.Run("order awaiting return is archived", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(domain.Order{OrderID: 1, Status: domain.AwaitingReturn, ExpirationTime: past}, nil)
+		repo.EXPECT().Archive(ctx, int64(1), domain.ReturnedToCourier).Return(nil)
+		srv := newTestOrderService(repo)
+
+		err := srv.ReturnOrder(ctx, 1)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestOrderServiceImpl_ReturnManifests(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx       = context.Background()
+		scannedAt = time.Date(2025, 4, 22, 10, 0, 0, 0, time.UTC)
+		items     = []domain.ReturnManifestItem{
+			{OrderID: 1, UserID: 10, OrderStatus: domain.Refunded},
+			{OrderID: 2, UserID: 20, OrderStatus: domain.AwaitingReturn},
+		}
+	)
+	newManifest := func(status domain.ManifestStatus, items ...domain.ReturnManifestItem) domain.ReturnManifest {
+		return domain.ReturnManifest{
+			ID:            5,
+			PickupPointID: testPickupPoint.ID,
+			CourierName:   "Ivan",
+			Status:        status,
+			CreatedAt:     scannedAt,
+			Items:         items,
+		}
+	}
+
+	t.Run("create takes returnable orders", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		manifests.EXPECT().FindReturnableOrders(ctx, testPickupPoint.ID, domain.ReturnableStatuses).Return(items, nil)
+		manifests.EXPECT().Create(ctx, domain.ReturnManifest{
+			PickupPointID: testPickupPoint.ID,
+			CourierName:   "Ivan",
+			Status:        domain.ManifestOpen,
+			Items:         items,
+		}).Return(int64(5), nil)
+		manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestOpen, items...), nil)
+		srv := newTestOrderServiceWithManifests(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		manifest, err := srv.CreateReturnManifest(ctx, testPickupPoint.ID, " Ivan ")
+
+		require.NoError(t, err)
+		require.Equal(t, int64(5), manifest.ID)
+		require.Len(t, manifest.Items, 2)
+	})
+	t.Run("create without orders to return", func(t *testing.T) {
+		t.Parallel()
+		srv := newTestOrderServiceWithManifests(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, returnManifestsStub{})
+
+		_, err := srv.CreateReturnManifest(ctx, testPickupPoint.ID, "Ivan")
+
+		require.ErrorIs(t, err, domain.ErrNothingToReturn)
+	})
+	t.Run("scan order of another manifest", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestOpen, items...), nil)
+		manifests.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithManifests(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		_, err := srv.ScanReturnManifestOrder(ctx, 5, 3)
+
+		require.ErrorIs(t, err, domain.ErrOrderNotInManifest)
+	})
+	t.Run("close returns scanned orders only", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		scanned := items[0]
+		scanned.ScannedAt = &scannedAt
+		gomock.InOrder(
+			manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestOpen, scanned, items[1]), nil),
+			manifests.EXPECT().Close(ctx, int64(5)).Return(nil),
+			manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestClosed, scanned), nil),
+		)
+		repo.EXPECT().Find(ctx, int64(1)).Return(domain.Order{OrderID: 1, UserID: 10, Status: domain.Refunded}, nil)
+		repo.EXPECT().Archive(ctx, int64(1), domain.ReturnedToCourier).Return(nil)
+		srv := newTestOrderServiceWithManifests(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		manifest, err := srv.CloseReturnManifest(ctx, 5)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.ManifestClosed, manifest.Status)
+		require.Len(t, manifest.Items, 1)
+	})
+	t.Run("close without scanned orders", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestOpen, items...), nil)
+		manifests.EXPECT().Close(gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithManifests(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		_, err := srv.CloseReturnManifest(ctx, 5)
+
+		require.ErrorIs(t, err, domain.ErrNoScannedOrders)
+	})
+	t.Run("closed manifest cannot be closed again", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestClosed, items...), nil)
+		srv := newTestOrderServiceWithManifests(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		_, err := srv.CloseReturnManifest(ctx, 5)
+
+		require.ErrorIs(t, err, domain.ErrReturnManifestIsClosed)
+	})
+	t.Run("order of open manifest cannot be returned alone", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		manifests.EXPECT().IsInOpenManifest(ctx, int64(1)).Return(true, nil)
+		repo.EXPECT().Archive(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithManifests(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		err := srv.ReturnOrder(ctx, 1)
+
+		require.ErrorIs(t, err, domain.ErrOrderIsInReturnManifest)
+	})
+	t.Run("export as csv", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		manifests := mock_repository.NewMockReturnManifestRepository(ctrl)
+		scanned := items[0]
+		scanned.ScannedAt = &scannedAt
+		manifests.EXPECT().Find(ctx, int64(5)).Return(newManifest(domain.ManifestOpen, scanned, items[1]), nil)
+		srv := newTestOrderServiceWithManifests(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, orderArchiveStub{}, manifests)
+
+		data, err := srv.ExportReturnManifest(ctx, 5, domain.ExportCSV)
 
 		require.NoError(t, err)
+		require.Equal(t, "manifest_id,pickup_point_id,courier_name,manifest_status,created_at,closed_at,order_id,user_id,order_status,scanned_at\n"+
+			"5,2,Ivan,open,2025-04-22T10:00:00Z,,1,10,refunded,2025-04-22T10:00:00Z\n"+
+			"5,2,Ivan,open,2025-04-22T10:00:00Z,,2,20,awaiting_return,\n", string(data))
 	})
 }
 
-func Test_applyPackagingStrategy(t *testing.T) {
+func TestOrderServiceImpl_InboundShipments(t *testing.T) {
 	t.Parallel()
-	type args struct {
-		packageStrategy    domain.Package
-		withAdditionalFilm bool
-		weight             int
-		cost               int
-	}
-	tests := []struct {
-		name    string
-		args    args
-		want    int
-		wantErr bool
-	}{
-		{"smoke test", args{&domain.BoxPackage{}, false, 1, 1}, 21, false},
-		{"smoke test", args{&domain.BagPackage{}, false, 1, 1}, 6, false},
-		{"smoke test", args{&domain.FilmPackage{}, false, 1, 1}, 2, false},
-		{"box with too much weight", args{&domain.BoxPackage{}, false, 1000, 1}, 0, true},
-		{"bag with too much weight", args{&domain.BagPackage{}, false, 1000, 1}, 0, true},
+	var (
+		ctx   = context.Background()
+		valid = time.Now().Add(24 * time.Hour)
+		items = []domain.ShipmentItem{
+			{ShipmentID: 7, OrderID: 1, UserID: 10, ExpirationTime: valid, Weight: 1, Cost: domain.Money{Amount: 100, Currency: domain.RUB}},
+			{ShipmentID: 7, OrderID: 2, UserID: 20, ExpirationTime: time.Now().Add(-time.Hour), Cost: domain.Money{Currency: domain.RUB}},
+			{ShipmentID: 7, OrderID: 3, UserID: 30, ExpirationTime: valid, Cost: domain.Money{Currency: domain.RUB}},
+		}
+	)
+	newShipment := func(status domain.ShipmentStatus, scanned ...int64) domain.InboundShipment {
+		return domain.InboundShipment{
+			ID:            7,
+			PickupPointID: testPickupPoint.ID,
+			Reference:     "WB-7",
+			Status:        status,
+			Items:         items,
+			Scanned:       scanned,
+		}
 	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := applyPackagingStrategy(tt.args.packageStrategy, tt.args.withAdditionalFilm, tt.args.weight, tt.args.cost)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("applyPackagingStrategy() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want {
-				t.Errorf("applyPackagingStrategy() got = %v, want %v", got, tt.want)
-			}
+
+	t.Run("close creates received orders and reports discrepancies", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		shipments := mock_repository.NewMockInboundShipmentRepository(ctrl)
+		want := domain.ShipmentReport{
+			ShipmentID: 7,
+			Received:   []int64{1},
+			Missing:    []int64{3},
+			Unexpected: []int64{4},
+			Rejected:   []domain.RejectedItem{{OrderID: 2, Reason: domain.ErrExpirationDateInPast.Error()}},
+		}
+		shipments.EXPECT().Find(ctx, int64(7)).Return(newShipment(domain.ShipmentOpen, 1, 2, 4), nil)
+		repo.EXPECT().Find(ctx, int64(1)).Return(domain.Order{}, domain.ErrOrderNotFound)
+		repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) (int64, error) {
+			require.Equal(t, int64(1), order.OrderID)
+			require.Equal(t, testPickupPoint.ID, order.PickupPointID)
+
+			return order.OrderID, nil
 		})
+		shipments.EXPECT().Close(ctx, want).Return(nil)
+		shipments.EXPECT().FindReport(ctx, int64(7)).Return(want, nil)
+		srv := newTestOrderServiceWithShipments(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, shipments)
+
+		report, err := srv.CloseShipment(ctx, 7)
+
+		require.NoError(t, err)
+		require.Len(t, report.PickupCodes, 1)
+		require.Equal(t, int64(1), report.PickupCodes[0].OrderID)
+		require.Len(t, report.PickupCodes[0].PickupCode, domain.PickupCodeLength)
+		report.PickupCodes = nil
+		require.Equal(t, want, report)
+		require.True(t, report.HasDiscrepancies())
+	})
+	t.Run("existing order is rejected", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		shipments := mock_repository.NewMockInboundShipmentRepository(ctrl)
+		shipments.EXPECT().Find(ctx, int64(7)).Return(newShipment(domain.ShipmentOpen, 1), nil)
+		repo.EXPECT().Find(ctx, int64(1)).Return(domain.Order{OrderID: 1}, nil)
+		repo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		shipments.EXPECT().Close(ctx, domain.ShipmentReport{
+			ShipmentID: 7,
+			Missing:    []int64{2, 3},
+			Rejected:   []domain.RejectedItem{{OrderID: 1, Reason: domain.ErrOrderAlreadyExists.Error()}},
+		}).Return(nil)
+		shipments.EXPECT().FindReport(ctx, int64(7)).Return(domain.ShipmentReport{ShipmentID: 7}, nil)
+		srv := newTestOrderServiceWithShipments(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, shipments)
+
+		_, err := srv.CloseShipment(ctx, 7)
+
+		require.NoError(t, err)
+	})
+	t.Run("closed shipment cannot be scanned", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		shipments := mock_repository.NewMockInboundShipmentRepository(ctrl)
+		shipments.EXPECT().Find(ctx, int64(7)).Return(newShipment(domain.ShipmentClosed), nil)
+		shipments.EXPECT().Scan(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithShipments(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, shipments)
+
+		_, err := srv.ScanShipmentOrder(ctx, 7, 1)
+
+		require.ErrorIs(t, err, domain.ErrShipmentIsClosed)
+	})
+	t.Run("file order bound to another point", func(t *testing.T) {
+		t.Parallel()
+		srv := newTestOrderService(nil)
+		data := []byte(`[{"order_id": "1", "user_id": "10", "expiration_time": "2030-01-01T00:00:00Z", "pickup_point_id": 9}]`)
+
+		_, err := srv.RegisterShipmentFromFile(ctx, testPickupPoint.ID, "WB-7", data)
+
+		require.ErrorIs(t, err, domain.ErrShipmentFieldsAreIncorrect)
+	})
+}
+
+func TestOrderServiceImpl_Stocktaking(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	newSession := func(status domain.StocktakingStatus) domain.StocktakingSession {
+		return domain.StocktakingSession{ID: 3, PickupPointID: testPickupPoint.ID, Operator: "Anna", Status: status}
 	}
+
+	t.Run("finish diffs scans against stored orders", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		stocktaking := mock_repository.NewMockStocktakingRepository(ctrl)
+		stored := map[domain.Status][]domain.Order{
+			domain.Confirmed:      {{OrderID: 1}, {OrderID: 2}},
+			domain.Refunded:       {{OrderID: 3}},
+			domain.AwaitingReturn: nil,
+			domain.Blocked:        {{OrderID: 5}, {OrderID: 6}},
+		}
+		repo.EXPECT().FindAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		repo.EXPECT().FindAllUncached(ctx, gomock.Any()).Times(len(domain.StoredStatuses) + 1).DoAndReturn(
+			func(_ context.Context, filter repository.Filter) ([]domain.Order, error) {
+				require.Equal(t, testPickupPoint.ID, *filter.PickupPointID)
+
+				return stored[*filter.Status], nil
+			})
+		// blocked 5 is on the shelf and 6 is lost, neither is reported
+		want := domain.StocktakingResult{Expected: 3, Found: 2, Missing: []int64{2}, Unknown: []int64{9}}
+		gomock.InOrder(
+			stocktaking.EXPECT().Find(ctx, int64(3)).Return(newSession(domain.StocktakingOpen), nil),
+			stocktaking.EXPECT().Scanned(ctx, int64(3)).Return([]int64{1, 3, 5, 9}, nil),
+			stocktaking.EXPECT().Finish(ctx, int64(3), want).Return(nil),
+			stocktaking.EXPECT().Find(ctx, int64(3)).Return(domain.StocktakingSession{ID: 3, Result: &want}, nil),
+		)
+		srv := newTestOrderServiceWithStocktaking(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktaking)
+
+		session, err := srv.FinishStocktaking(ctx, 3)
+
+		require.NoError(t, err)
+		require.Equal(t, &want, session.Result)
+	})
+	t.Run("finished session takes no scans", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		stocktaking := mock_repository.NewMockStocktakingRepository(ctrl)
+		stocktaking.EXPECT().Find(ctx, int64(3)).Return(newSession(domain.StocktakingFinished), nil)
+		stocktaking.EXPECT().AddScans(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithStocktaking(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktaking)
+
+		_, err := srv.AddStocktakingScans(ctx, 3, []int64{1})
+
+		require.ErrorIs(t, err, domain.ErrStocktakingIsFinished)
+	})
+	t.Run("invalid scanned id", func(t *testing.T) {
+		t.Parallel()
+		srv := newTestOrderService(nil)
+
+		_, err := srv.AddStocktakingScans(ctx, 3, []int64{1, 0})
+
+		require.ErrorIs(t, err, domain.ErrStocktakingFieldsAreIncorrect)
+	})
 }
 
-func TestOrderServiceImpl_ReturnOrder(t *testing.T) {
+func TestOrderServiceImpl_Incidents(t *testing.T) {
 	t.Parallel()
-	var (
-		ctx = context.Background()
-	)
-	correctValues := struct {
-		OrderId           int64
-		UserID            int64
-		ExpirationTime    time.Time
-		StatusString      string
-		StatusModel       domain.Status
-		Weight            int
-		Cost              int
-		PackageTypeString string
-		PackageTypeModel  domain.PackageType
-		IsAdditionalFilm  bool
-	}{1, 1, time.Now(), "confirmed", domain.Confirmed, 1, 1, "box", domain.Box, false}
+	ctx := context.Background()
+	order := domain.Order{OrderID: 5, UserID: 1, Status: domain.Confirmed, ExpirationTime: time.Now().Add(24 * time.Hour)}
+	blocked := order
+	blocked.Status = domain.Blocked
+	openIncident := domain.Incident{
+		ID:          2,
+		OrderID:     order.OrderID,
+		Type:        domain.IncidentLost,
+		Description: "not on the shelf",
+		Operator:    "Anna",
+		Status:      domain.IncidentOpen,
+		OrderStatus: domain.Confirmed,
+	}
 
-	t.Run("order not found", func(t *testing.T) {
+	t.Run("open blocks the order", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{}, domain.ErrOrderNotFound)
-		repo.EXPECT().Delete(ctx, gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		incidents := mock_repository.NewMockIncidentRepository(ctrl)
+		repo.EXPECT().Find(ctx, order.OrderID).Return(order, nil)
+		repo.EXPECT().Update(ctx, order.OrderID, order.UserID, order.ExpirationTime, domain.Blocked, order.Weight, order.Cost).
+			Return(order.OrderID, nil)
+		want := openIncident
+		want.ID = 0
+		incidents.EXPECT().Create(ctx, want).Return(int64(2), nil)
+		incidents.EXPECT().Find(ctx, int64(2)).Return(openIncident, nil)
+		srv := newTestOrderServiceWithIncidents(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidents)
 
-		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+		incident, err := srv.OpenIncident(ctx, order.OrderID, domain.IncidentLost, " not on the shelf ", "Anna")
 
-		require.EqualError(t, err, "order not found")
+		require.NoError(t, err)
+		require.Equal(t, openIncident, incident)
 	})
-	t.Run("order already completed", func(t *testing.T) {
+	t.Run("blocked order cannot be completed", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: domain.Completed}, nil)
-		repo.EXPECT().Delete(ctx, gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		repo.EXPECT().Find(gomock.Any(), order.OrderID).Return(blocked, nil)
+		srv := newTestOrderService(repo)
 
-		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+		err := srv.CompleteOrder(ctx, order.OrderID, order.UserID, testPickupCode, false)
 
-		require.EqualError(t, err, "order already completed")
+		require.ErrorIs(t, err, domain.ErrOrderIsBlocked)
 	})
-	t.Run("order has to be refunded", func(t *testing.T) {
+	t.Run("found order gets its status back", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: correctValues.StatusModel}, nil)
-		repo.EXPECT().Delete(ctx, gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		incidents := mock_repository.NewMockIncidentRepository(ctrl)
+		incidents.EXPECT().Find(ctx, int64(2)).Return(openIncident, nil)
+		repo.EXPECT().Find(ctx, order.OrderID).Return(blocked, nil)
+		repo.EXPECT().Update(ctx, order.OrderID, order.UserID, order.ExpirationTime, domain.Confirmed, order.Weight, order.Cost).
+			Return(order.OrderID, nil)
+		incidents.EXPECT().Close(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, incident domain.Incident) error {
+			require.Equal(t, domain.IncidentResolved, incident.Status)
+			require.Equal(t, domain.OutcomeFound, *incident.Outcome)
 
-		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+			return nil
+		})
+		incidents.EXPECT().Find(ctx, int64(2)).Return(openIncident, nil)
+		srv := newTestOrderServiceWithIncidents(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidents)
 
-		require.EqualError(t, err, "order has to be refunded")
+		_, err := srv.ResolveIncident(ctx, 2, domain.OutcomeFound, "behind the shelf", "Anna")
+
+		require.NoError(t, err)
 	})
-	t.Run("expiration date is in the future", func(t *testing.T) {
+	t.Run("compensated order leaves the point", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		futureDate := time.Now().AddDate(0, 0, 1)
-		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: correctValues.StatusModel, ExpirationTime: futureDate}, nil)
-		repo.EXPECT().Delete(ctx, gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		incidents := mock_repository.NewMockIncidentRepository(ctrl)
+		incidents.EXPECT().Find(ctx, int64(2)).Return(openIncident, nil).Times(2)
+		repo.EXPECT().Find(ctx, order.OrderID).Return(blocked, nil)
+		repo.EXPECT().Update(ctx, order.OrderID, order.UserID, order.ExpirationTime, domain.Compensated, order.Weight, order.Cost).
+			Return(order.OrderID, nil)
+		incidents.EXPECT().Close(ctx, gomock.Any()).Return(nil)
+		srv := newTestOrderServiceWithIncidents(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidents)
 
-		err := srv.ReturnOrder(ctx, correctValues.OrderId)
+		_, err := srv.ResolveIncident(ctx, 2, domain.OutcomeCompensated, "", "Anna")
+
+		require.NoError(t, err)
+	})
+	t.Run("closed incident cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		incidents := mock_repository.NewMockIncidentRepository(ctrl)
+		closed := openIncident
+		closed.Status = domain.IncidentCancelled
+		incidents.EXPECT().Find(ctx, int64(2)).Return(closed, nil)
+		srv := newTestOrderServiceWithIncidents(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidents)
+
+		_, err := srv.ResolveIncident(ctx, 2, domain.OutcomeWrittenOff, "", "Anna")
+
+		require.ErrorIs(t, err, domain.ErrIncidentIsClosed)
+	})
+}
+
+func TestOrderServiceImpl_StatusAudit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	newService := func(repo OrderRepository, audit OrderStatusAuditRepository) *OrderServiceImpl {
+		return newTestOrderServiceWithAudit(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidentsStub{},
+			storageFeesStub{}, audit)
+	}
+	confirmed := domain.Order{
+		OrderID:        5,
+		UserID:         1,
+		Status:         domain.Confirmed,
+		ExpirationTime: time.Now().Add(24 * time.Hour),
+		LastChangedAt:  time.Now(),
+		Cost:           rub(100),
+	}
+
+	t.Run("completion is audited in its transaction", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		audit := mock_repository.NewMockOrderStatusAuditRepository(ctrl)
+		repo.EXPECT().Find(ctx, confirmed.OrderID).Return(confirmed, nil)
+		update := repo.EXPECT().Update(ctx, confirmed.OrderID, confirmed.UserID, confirmed.ExpirationTime,
+			domain.Completed, confirmed.Weight, confirmed.Cost).Return(confirmed.OrderID, nil)
+		audit.EXPECT().Create(ctx, domain.AuditOrderInfo{
+			OrderID:        confirmed.OrderID,
+			PreviousStatus: domain.Confirmed,
+			CurrentStatus:  domain.Completed,
+		}).Return(int64(1), nil).After(update)
+		srv := newService(repo, audit)
+
+		err := srv.CompleteOrder(ctx, confirmed.OrderID, confirmed.UserID, testPickupCode, false)
+
+		require.NoError(t, err)
+	})
+	t.Run("refund carries its details", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		audit := mock_repository.NewMockOrderStatusAuditRepository(ctrl)
+		completed := confirmed
+		completed.Status = domain.Completed
+		repo.EXPECT().Find(ctx, completed.OrderID).Return(completed, nil)
+		repo.EXPECT().Update(ctx, completed.OrderID, completed.UserID, completed.ExpirationTime,
+			domain.Refunded, completed.Weight, completed.Cost).Return(completed.OrderID, nil)
+		audit.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, info domain.AuditOrderInfo) (int64, error) {
+			require.Equal(t, domain.Refunded, info.CurrentStatus)
+			require.NotNil(t, info.Refund)
+			require.Equal(t, testRefundDetails.ReasonCode, info.Refund.ReasonCode)
+
+			return 1, nil
+		})
+		srv := newService(repo, audit)
+
+		err := srv.RefundOrder(ctx, completed.OrderID, completed.UserID, 7, testRefundDetails)
+
+		require.NoError(t, err)
+	})
+	t.Run("rejected change is not audited", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		audit := mock_repository.NewMockOrderStatusAuditRepository(ctrl)
+		repo.EXPECT().Find(ctx, confirmed.OrderID).Return(confirmed, nil)
+		audit.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		srv := newService(repo, audit)
+
+		err := srv.CompleteOrder(ctx, confirmed.OrderID, confirmed.UserID+1, testPickupCode, false)
+
+		require.Error(t, err)
+	})
+	t.Run("audit failure fails the change", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		audit := mock_repository.NewMockOrderStatusAuditRepository(ctrl)
+		repo.EXPECT().Find(ctx, confirmed.OrderID).Return(confirmed, nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(confirmed.OrderID, nil)
+		audit.EXPECT().Create(ctx, gomock.Any()).Return(int64(0), errors.New("insert order status audit: conn closed"))
+		srv := newService(repo, audit)
+
+		err := srv.CompleteOrder(ctx, confirmed.OrderID, confirmed.UserID, testPickupCode, false)
+
+		require.Error(t, err)
+	})
+}
+
+func TestOrderServiceImpl_StorageFees(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pointID := testPickupPoint.ID
+	tariff := domain.StorageTariff{PickupPointID: &pointID, FreeDays: 1, DailyRate: rub(5000)}
+	order := domain.Order{
+		OrderID:        5,
+		UserID:         1,
+		Status:         domain.Confirmed,
+		ExpirationTime: time.Now().Add(24 * time.Hour),
+		// a later update of the order does not restart the accrual
+		LastChangedAt: time.Now(),
+		ArrivedAt:     time.Now().Add(-3*24*time.Hour + time.Hour),
+		PickupPointID: pointID,
+		Cost:          rub(100000),
+	}
+
+	t.Run("order shows accrued fee", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		fees := mock_repository.NewMockStorageFeeRepository(ctrl)
+		repo.EXPECT().Find(ctx, order.OrderID).Return(order, nil)
+		fees.EXPECT().FindTariff(ctx, pointID).Return(tariff, nil)
+		srv := newTestOrderServiceWithStorageFees(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidentsStub{}, fees)
+
+		got, err := srv.GetOrderByID(ctx, order.OrderID)
+
+		require.NoError(t, err)
+		require.NotNil(t, got.StorageFee)
+		require.Equal(t, 3, got.StorageFee.StoredDays)
+		require.Equal(t, 2, got.StorageFee.ChargedDays)
+		require.Equal(t, rub(10000), got.StorageFee.Amount)
+	})
+	t.Run("unpaid fee keeps the order", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		fees := mock_repository.NewMockStorageFeeRepository(ctrl)
+		repo.EXPECT().Find(ctx, order.OrderID).Return(order, nil)
+		repo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		fees.EXPECT().FindTariff(ctx, pointID).Return(tariff, nil)
+		srv := newTestOrderServiceWithStorageFees(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidentsStub{}, fees)
+
+		err := srv.CompleteOrder(ctx, order.OrderID, order.UserID, testPickupCode, false)
+
+		require.ErrorIs(t, err, domain.ErrStorageFeeNotPaid)
+	})
+	t.Run("paid fee is collected", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		fees := mock_repository.NewMockStorageFeeRepository(ctrl)
+		repo.EXPECT().Find(ctx, order.OrderID).Return(order, nil)
+		repo.EXPECT().Update(ctx, order.OrderID, order.UserID, order.ExpirationTime, domain.Completed, order.Weight, order.Cost).
+			Return(order.OrderID, nil)
+		fees.EXPECT().FindTariff(ctx, pointID).Return(tariff, nil)
+		fees.EXPECT().SavePayment(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, payment domain.StorageFeePayment) error {
+			require.Equal(t, order.OrderID, payment.OrderID)
+			require.Equal(t, pointID, payment.PickupPointID)
+			require.Equal(t, 2, payment.ChargedDays)
+			require.Equal(t, rub(10000), payment.Amount)
+
+			return nil
+		})
+		srv := newTestOrderServiceWithStorageFees(repo, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{},
+			orderArchiveStub{}, returnManifestsStub{}, inboundShipmentsStub{}, stocktakingStub{}, incidentsStub{}, fees)
+
+		err := srv.CompleteOrder(ctx, order.OrderID, order.UserID, testPickupCode, true)
+
+		require.NoError(t, err)
+	})
+	t.Run("point without tariff uses the global one", func(t *testing.T) {
+		t.Parallel()
+		srv := newTestOrderService(nil)
+
+		got, err := srv.fees.Accrue(ctx, order, time.Now())
+
+		require.NoError(t, err)
+		require.False(t, got.IsDue())
+		require.Equal(t, testStorageTariff.FreeDays, got.FreeDays)
+	})
+}
+
+func TestStorageTariffFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tariff, err := StorageTariffFromConfig(config.StorageTariffConfig{FreeDays: 3, DailyRate: 5000})
+	require.NoError(t, err)
+	require.Equal(t, rub(5000), tariff.DailyRate)
+
+	_, err = StorageTariffFromConfig(config.StorageTariffConfig{FreeDays: -1, DailyRate: 5000})
+	require.ErrorIs(t, err, domain.ErrStorageTariffFieldsAreIncorrect)
+}
+
+func TestOrderServiceImpl_SearchArchivedOrders(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx    = context.Background()
+		userID = int64(7)
+	)
+
+	t.Run("default page", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		archive := mock_repository.NewMockOrderArchiveRepository(ctrl)
+		filter := domain.ArchiveFilter{UserID: &userID}
+		archive.EXPECT().Search(ctx, filter, int64(0), DefaultArchivePageSize).Return(nil, nil)
+		srv := newTestOrderServiceWithArchive(nil, storageCellsStub{}, pickupCodesStub{}, refundDetailsStub{}, archive)
+
+		orders, err := srv.SearchArchivedOrders(ctx, filter, nil, nil)
+
+		require.NoError(t, err)
+		require.Empty(t, orders)
+	})
+	t.Run("refund details come with the search", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		archive := mock_repository.NewMockOrderArchiveRepository(ctrl)
+		refunds := mock_repository.NewMockRefundDetailsRepository(ctrl)
+		lastID, limit := int64(10), 2
+		details := testRefundDetails
+		details.OrderID = 11
+		archive.EXPECT().Search(ctx, domain.ArchiveFilter{}, lastID, limit).Return([]domain.ArchivedOrder{
+			{Order: domain.Order{OrderID: 11, Status: domain.ReturnedToCourier, Refund: &details}},
+			{Order: domain.Order{OrderID: 12, Status: domain.ReturnedToCourier}},
+		}, nil)
+		refunds.EXPECT().Find(gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderServiceWithArchive(nil, storageCellsStub{}, pickupCodesStub{}, refunds, archive)
+
+		orders, err := srv.SearchArchivedOrders(ctx, domain.ArchiveFilter{}, &lastID, &limit)
 
-		require.EqualError(t, err, "expiration date is in the future")
+		require.NoError(t, err)
+		require.Len(t, orders, 2)
+		require.Equal(t, &details, orders[0].Refund)
+		require.Nil(t, orders[1].Refund)
 	})
 }
 
@@ -170,26 +1537,41 @@ func TestOrderServiceImpl_RefundOrder(t *testing.T) {
 		StatusString      string
 		StatusModel       domain.Status
 		Weight            int
-		Cost              int
+		Cost              domain.Money
 		PackageTypeString string
 		PackageTypeModel  domain.PackageType
 		IsAdditionalFilm  bool
-	}{1, 1, time.Now(), "confirmed", domain.Confirmed, 1, 1, "box", domain.Box, false}
+	}{1, 1, time.Now(), "confirmed", domain.Confirmed, 1, rub(100), "box", domain.Box, false}
 	const expirationDays = 7
 
-	t.Run("order not found", func(t *testing.T) {
+	t.Run("order not found", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{}, domain.ErrOrderNotFound)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, testRefundDetails)
+
+		require.ErrorIs(t, err, domain.ErrOrderNotFound)
+	})
+
+	t.Run("order of another user", func(t *testing.T) {
 		t.Parallel()
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{}, domain.ErrOrderNotFound)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{UserID: correctValues.UserID + 1, Status: domain.Completed}, nil)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.RefundOrder(ctx, correctValues.OrderId, expirationDays)
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, testRefundDetails)
 
-		require.EqualError(t, err, "order not found")
+		require.ErrorIs(t, err, domain.ErrOrderNotBelongToUser)
 	})
 
 	t.Run("order not completed", func(t *testing.T) {
@@ -198,13 +1580,13 @@ func TestOrderServiceImpl_RefundOrder(t *testing.T) {
 		defer ctrl.Finish()
 
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{Status: domain.Confirmed}, nil)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{UserID: correctValues.UserID, Status: domain.Confirmed}, nil)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.RefundOrder(ctx, correctValues.OrderId, expirationDays)
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, testRefundDetails)
 
-		require.EqualError(t, err, "order is not completed")
+		require.ErrorIs(t, err, domain.ErrOrderNotCompleted)
 	})
 
 	t.Run("refund period exceeded", func(t *testing.T) {
@@ -213,17 +1595,18 @@ func TestOrderServiceImpl_RefundOrder(t *testing.T) {
 		defer ctrl.Finish()
 
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		lastChangedAt := time.Now().Add(time.Duration(24*expirationDays+1) * time.Hour)
+		lastChangedAt := time.Now().Add(-time.Duration(24*expirationDays+1) * time.Hour)
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{
+			UserID:        correctValues.UserID,
 			Status:        domain.Completed,
 			LastChangedAt: lastChangedAt,
 		}, nil)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.RefundOrder(ctx, correctValues.OrderId, expirationDays)
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, testRefundDetails)
 
-		require.EqualError(t, err, "order cannot be refunded")
+		require.ErrorIs(t, err, domain.ErrOrderCannotBeRefunded)
 	})
 
 	t.Run("successful refund", func(t *testing.T) {
@@ -245,11 +1628,152 @@ func TestOrderServiceImpl_RefundOrder(t *testing.T) {
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(order, nil)
 		repo.EXPECT().Update(ctx, order.OrderID, order.UserID, order.ExpirationTime, domain.Refunded, order.Weight, order.Cost).
 			Return(correctValues.OrderId, nil)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
+
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, testRefundDetails)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown refund reason", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+		details := testRefundDetails
+		details.ReasonCode = "too_expensive"
+
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, details)
+
+		require.ErrorIs(t, err, domain.ErrUnknownRefundReason)
+	})
+
+	t.Run("refund details are stored", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		refunds := mock_repository.NewMockRefundDetailsRepository(ctrl)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{
+			OrderID:       correctValues.OrderId,
+			UserID:        correctValues.UserID,
+			Status:        domain.Completed,
+			LastChangedAt: time.Now(),
+		}, nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), domain.Refunded, gomock.Any(), gomock.Any()).
+			Return(correctValues.OrderId, nil)
+		want := testRefundDetails
+		want.OrderID = correctValues.OrderId
+		want.Comment = "screen is cracked"
+		refunds.EXPECT().Save(ctx, want).Return(nil)
+		srv := newTestOrderServiceWithRefunds(repo, storageCellsStub{}, pickupCodesStub{}, refunds)
+		details := testRefundDetails
+		details.Comment = "screen is cracked"
+
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, details)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("pickup point refund period", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		lastChangedAt := time.Now().Add(-time.Duration(24*expirationDays+1) * time.Hour)
+		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{
+			UserID:        correctValues.UserID,
+			Status:        domain.Completed,
+			LastChangedAt: lastChangedAt,
+			PickupPointID: testPickupPoint.ID,
+		}, nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), domain.Refunded, gomock.Any(), gomock.Any()).
+			Return(correctValues.OrderId, nil)
+		srv := newTestOrderService(repo)
+
+		err := srv.RefundOrder(ctx, correctValues.OrderId, correctValues.UserID, expirationDays, testRefundDetails)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestOrderServiceImpl_RefundOrders(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx = context.Background()
+	)
+	const (
+		userID         int64 = 1
+		expirationDays       = 7
+	)
+	newOrder := func(orderID int64, ownerID int64, changedAgo time.Duration) domain.Order {
+		return domain.Order{
+			OrderID:       orderID,
+			UserID:        ownerID,
+			Status:        domain.Completed,
+			Cost:          rub(100),
+			LastChangedAt: time.Now().Add(-changedAgo),
+		}
+	}
+
+	t.Run("partial mode reports failures per order", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(newOrder(1, userID, time.Hour), nil)
+		repo.EXPECT().Find(ctx, int64(2)).Return(newOrder(2, 99, time.Hour), nil)
+		repo.EXPECT().Find(ctx, int64(3)).Return(newOrder(3, userID, 30*24*time.Hour), nil)
+		repo.EXPECT().Find(ctx, int64(4)).Return(domain.Order{}, domain.ErrOrderNotFound)
+		repo.EXPECT().Update(ctx, int64(1), userID, gomock.Any(), domain.Refunded, gomock.Any(), gomock.Any()).
+			Return(int64(1), nil)
+		srv := newTestOrderService(repo)
+
+		result, err := srv.RefundOrders(ctx, userID, []int64{1, 2, 3, 4}, expirationDays, testRefundDetails, domain.BatchPartial)
+
+		require.NoError(t, err)
+		require.NoError(t, result.Items[0].Err)
+		require.ErrorIs(t, result.Items[1].Err, domain.ErrOrderNotBelongToUser)
+		require.ErrorIs(t, result.Items[2].Err, domain.ErrOrderCannotBeRefunded)
+		require.ErrorIs(t, result.Items[3].Err, domain.ErrOrderNotFound)
+		require.Equal(t, []domain.Money{rub(100)}, result.Totals)
+	})
+	t.Run("atomic mode rolls back the whole batch", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(newOrder(1, userID, time.Hour), nil)
+		repo.EXPECT().Find(ctx, int64(2)).Return(newOrder(2, 99, time.Hour), nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(int64(0), nil).AnyTimes()
+		srv := newTestOrderService(repo)
+
+		result, err := srv.RefundOrders(ctx, userID, []int64{1, 2}, expirationDays, testRefundDetails, domain.BatchAtomic)
+
+		require.NoError(t, err)
+		require.ErrorIs(t, result.Items[0].Err, domain.ErrBatchRolledBack)
+		require.ErrorIs(t, result.Items[1].Err, domain.ErrOrderNotBelongToUser)
+		require.Empty(t, result.Totals)
+	})
+	t.Run("atomic mode refunds every order", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(ctx, int64(1)).Return(newOrder(1, userID, time.Hour), nil)
+		repo.EXPECT().Find(ctx, int64(2)).Return(newOrder(2, userID, time.Hour), nil)
+		repo.EXPECT().Update(ctx, gomock.Any(), userID, gomock.Any(), domain.Refunded, gomock.Any(), gomock.Any()).
+			Return(int64(0), nil).Times(2)
+		srv := newTestOrderService(repo)
 
-		err := srv.RefundOrder(ctx, correctValues.OrderId, expirationDays)
+		result, err := srv.RefundOrders(ctx, userID, []int64{1, 2}, expirationDays, testRefundDetails, domain.BatchAtomic)
 
 		require.NoError(t, err)
+		require.Equal(t, 2, result.Succeeded())
+		require.Equal(t, []domain.Money{rub(200)}, result.Totals)
 	})
 }
 
@@ -264,8 +1788,8 @@ func TestOrderServiceImpl_CompleteOrder(t *testing.T) {
 		ExpirationTime time.Time
 		StatusModel    domain.Status
 		Weight         int
-		Cost           int
-	}{1, 1, time.Now().Add(24 * time.Hour), domain.Confirmed, 1, 1} // Future expiration time
+		Cost           domain.Money
+	}{1, 1, time.Now().Add(24 * time.Hour), domain.Confirmed, 1, rub(100)} // Future expiration time
 
 	t.Run("order not found", func(t *testing.T) {
 		t.Parallel()
@@ -275,11 +1799,11 @@ func TestOrderServiceImpl_CompleteOrder(t *testing.T) {
 		repo := mock_repository.NewMockOrderRepository(ctrl)
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(domain.Order{}, domain.ErrOrderNotFound)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID)
+		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID, testPickupCode, false)
 
-		require.EqualError(t, err, "order not found")
+		require.ErrorIs(t, err, domain.ErrOrderNotFound)
 	})
 
 	t.Run("order does not belong to user", func(t *testing.T) {
@@ -288,14 +1812,14 @@ func TestOrderServiceImpl_CompleteOrder(t *testing.T) {
 		defer ctrl.Finish()
 
 		repo := mock_repository.NewMockOrderRepository(ctrl)
-		order := domain.Order{UserID: correctValues.UserID + 1, ExpirationTime: correctValues.ExpirationTime}
+		order := domain.Order{UserID: correctValues.UserID + 1, ExpirationTime: correctValues.ExpirationTime, Status: correctValues.StatusModel}
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(order, nil)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID)
+		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID, testPickupCode, false)
 
-		require.EqualError(t, err, "order is not belong to user")
+		require.ErrorIs(t, err, domain.ErrOrderNotBelongToUser)
 	})
 
 	t.Run("expiration date in past", func(t *testing.T) {
@@ -313,11 +1837,11 @@ func TestOrderServiceImpl_CompleteOrder(t *testing.T) {
 		}
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(order, nil)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID)
+		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID, testPickupCode, false)
 
-		require.EqualError(t, err, "expiration date is in the past")
+		require.ErrorIs(t, err, domain.ErrExpirationDateInPast)
 	})
 
 	t.Run("order already completed", func(t *testing.T) {
@@ -334,11 +1858,11 @@ func TestOrderServiceImpl_CompleteOrder(t *testing.T) {
 		}
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(order, nil)
 		repo.EXPECT().Update(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID)
+		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID, testPickupCode, false)
 
-		require.EqualError(t, err, "order already completed")
+		require.ErrorIs(t, err, domain.ErrOrderAlreadyCompleted)
 	})
 
 	t.Run("successful completion", func(t *testing.T) {
@@ -358,10 +1882,502 @@ func TestOrderServiceImpl_CompleteOrder(t *testing.T) {
 		repo.EXPECT().Find(ctx, correctValues.OrderId).Return(order, nil)
 		repo.EXPECT().Update(ctx, order.OrderID, order.UserID, order.ExpirationTime, domain.Completed, order.Weight, order.Cost).
 			Return(correctValues.OrderId, nil)
-		srv := OrderServiceImpl{repo: repo}
+		srv := newTestOrderService(repo)
 
-		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID)
+		err := srv.CompleteOrder(ctx, correctValues.OrderId, correctValues.UserID, testPickupCode, false)
 
 		require.NoError(t, err)
 	})
 }
+
+func TestOrderServiceImpl_ListAllowedTransitions(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx = context.Background()
+	)
+	const expirationDays = 7
+	future := time.Now().Add(24 * time.Hour)
+	past := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name   string
+		order  domain.Order
+		userID int64
+		want   []domain.Action
+	}{
+		{"confirmed order can be completed by owner", domain.Order{OrderID: 1, UserID: 1, Status: domain.Confirmed, ExpirationTime: future}, 1, []domain.Action{domain.CompleteAction, domain.BlockAction}},
+		{"confirmed order cannot be completed by stranger", domain.Order{OrderID: 1, UserID: 1, Status: domain.Confirmed, ExpirationTime: future}, 2, []domain.Action{domain.BlockAction}},
+		{"expired order cannot be completed", domain.Order{OrderID: 1, UserID: 1, Status: domain.Confirmed, ExpirationTime: past}, 1, []domain.Action{domain.ExpireAction, domain.BlockAction}},
+		{"expired order goes back to courier", domain.Order{OrderID: 1, UserID: 1, Status: domain.AwaitingReturn, ExpirationTime: past}, 1, []domain.Action{domain.ReturnAction, domain.BlockAction}},
+		{"completed order within refund period", domain.Order{OrderID: 1, UserID: 1, Status: domain.Completed, LastChangedAt: time.Now()}, 1, []domain.Action{domain.RefundAction}},
+		{"completed order after refund period", domain.Order{OrderID: 1, UserID: 1, Status: domain.Completed, LastChangedAt: time.Now().AddDate(0, 0, -expirationDays-1)}, 1, nil},
+		{"refunded order goes back to courier", domain.Order{OrderID: 1, UserID: 1, Status: domain.Refunded}, 1, []domain.Action{domain.ReturnAction, domain.BlockAction}},
+		{"accepted order is placed on shelf", domain.Order{OrderID: 1, UserID: 1, Status: domain.Accepted}, 1, []domain.Action{domain.PlaceAction}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			repo := mock_repository.NewMockOrderRepository(ctrl)
+			repo.EXPECT().Find(ctx, tt.order.OrderID).Return(tt.order, nil)
+			srv := newTestOrderService(repo)
+
+			transitions, err := srv.ListAllowedTransitions(ctx, tt.order.OrderID, tt.userID, expirationDays)
+
+			require.NoError(t, err)
+			var got []domain.Action
+			for _, tr := range transitions {
+				got = append(got, tr.Action)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+	t.Run("anonymous caller", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		repo := mock_repository.NewMockOrderRepository(ctrl)
+		repo.EXPECT().Find(gomock.Any(), gomock.Any()).Times(0)
+		srv := newTestOrderService(repo)
+
+		_, err := srv.ListAllowedTransitions(ctx, 1, 0, expirationDays)
+
+		require.ErrorIs(t, err, domain.ErrUserIDRequired)
+	})
+}
+
+var (
+	testBox      = domain.PackageSpec{Name: domain.Box, MaxWeight: 30, MaxVolume: 125000, MaxLength: 100, Price: rub(2000), CanWrap: true, IsActive: true}
+	testBag      = domain.PackageSpec{Name: domain.Bag, MaxWeight: 10, MaxVolume: 30000, MaxLength: 40, Price: rub(500), IsActive: true}
+	testFilm     = domain.PackageSpec{Name: domain.Film, Price: rub(100), CanWrap: true, IsActive: true}
+	testPackages = []domain.PackageSpec{testBox, testBag, testFilm}
+
+	testCompositionRules = []domain.CompositionRule{
+		{Outer: domain.Film, Inner: domain.Film},
+		{Outer: domain.Box, Inner: domain.Box},
+	}
+
+	testPickupPoint = domain.PickupPoint{ID: 2, Name: "north", Timezone: "UTC", OrderExpirationDays: 14}
+
+	testPickupCodeSecret = "secret"
+	testPickupCodePolicy = domain.PickupCodePolicy{MaxAttempts: 3, Lockout: time.Hour}
+
+	testRefundReasons = []domain.RefundReason{{Code: "defective", Description: "Item is defective"}}
+	testRefundDetails = domain.RefundDetails{ReasonCode: "defective", Condition: domain.Damaged}
+
+	// testStorageTariff keeps orders for free unless a test sets a point tariff.
+	testStorageTariff = domain.StorageTariff{FreeDays: 7, DailyRate: rub(0)}
+)
+
+const testPickupCode = "123456"
+
+func rub(amount int64) domain.Money {
+	return domain.Money{Amount: amount, Currency: domain.RUB}
+}
+
+type txManagerStub struct{}
+
+func (txManagerStub) GetQueryEngine(_ context.Context) db.DB { return nil }
+
+func (txManagerStub) RunReadUncommitted(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (txManagerStub) RunSerializable(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (txManagerStub) RunRepeatableRead(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return fn(ctx)
+}
+
+type statusAuditStub struct{}
+
+func (statusAuditStub) Create(_ context.Context, _ domain.AuditOrderInfo) (int64, error) {
+	return 1, nil
+}
+
+// pickupPointsStub knows the default point and testPickupPoint.
+type pickupPointsStub struct{}
+
+func (pickupPointsStub) Create(_ context.Context, _ domain.PickupPoint) (int64, error) { return 0, nil }
+
+func (pickupPointsStub) Find(_ context.Context, id int64) (domain.PickupPoint, error) {
+	switch id {
+	case domain.DefaultPickupPointID:
+		return domain.PickupPoint{ID: id, Name: "default", Timezone: "UTC"}, nil
+	case testPickupPoint.ID:
+		return testPickupPoint, nil
+	}
+
+	return domain.PickupPoint{}, domain.ErrPickupPointNotFound
+}
+
+func (pickupPointsStub) FindAll(_ context.Context) ([]domain.PickupPoint, error) { return nil, nil }
+
+func (pickupPointsStub) Update(_ context.Context, _ domain.PickupPoint) error { return nil }
+
+func (pickupPointsStub) Delete(_ context.Context, _ int64) error { return nil }
+
+// storageCellsStub is a storage without cells, so that no order needs one.
+type storageCellsStub struct{}
+
+func (storageCellsStub) Create(_ context.Context, _ domain.StorageCell) (int64, error) { return 0, nil }
+
+func (storageCellsStub) FindAll(_ context.Context, _ int64) ([]domain.StorageCell, error) {
+	return nil, nil
+}
+
+func (storageCellsStub) FindByOrder(_ context.Context, _ int64) (domain.StorageCell, error) {
+	return domain.StorageCell{}, domain.ErrStorageCellNotFound
+}
+
+func (storageCellsStub) Assign(_ context.Context, _ int64, _ int64, _ int, _ domain.Dimensions) (domain.StorageCell, error) {
+	return domain.StorageCell{}, domain.ErrNoFreeStorageCell
+}
+
+func (storageCellsStub) Release(_ context.Context, _ int64) (domain.StorageCell, error) {
+	return domain.StorageCell{}, domain.ErrStorageCellNotFound
+}
+
+func (storageCellsStub) Occupancy(_ context.Context) ([]domain.StorageOccupancy, error) {
+	return nil, nil
+}
+
+func (storageCellsStub) PointOccupancy(_ context.Context, id int64) (domain.StorageOccupancy, error) {
+	return domain.StorageOccupancy{PickupPointID: id}, nil
+}
+
+// returnManifestsStub has no manifests, so no order is held by one.
+type returnManifestsStub struct{}
+
+func (returnManifestsStub) Create(_ context.Context, _ domain.ReturnManifest) (int64, error) {
+	return 1, nil
+}
+
+func (returnManifestsStub) Find(_ context.Context, _ int64) (domain.ReturnManifest, error) {
+	return domain.ReturnManifest{}, domain.ErrReturnManifestNotFound
+}
+
+func (returnManifestsStub) FindAll(_ context.Context, _ domain.ManifestFilter) ([]domain.ReturnManifest, error) {
+	return nil, nil
+}
+
+func (returnManifestsStub) FindReturnableOrders(
+	_ context.Context,
+	_ int64,
+	_ []domain.Status,
+) ([]domain.ReturnManifestItem, error) {
+	return nil, nil
+}
+
+func (returnManifestsStub) IsInOpenManifest(_ context.Context, _ int64) (bool, error) {
+	return false, nil
+}
+
+func (returnManifestsStub) Scan(_ context.Context, _ int64, _ int64) error {
+	return domain.ErrOrderNotInManifest
+}
+
+func (returnManifestsStub) Close(_ context.Context, _ int64) error { return nil }
+
+// inboundShipmentsStub has no shipments.
+type inboundShipmentsStub struct{}
+
+func (inboundShipmentsStub) Create(_ context.Context, _ domain.InboundShipment) (int64, error) {
+	return 1, nil
+}
+
+func (inboundShipmentsStub) Find(_ context.Context, _ int64) (domain.InboundShipment, error) {
+	return domain.InboundShipment{}, domain.ErrShipmentNotFound
+}
+
+func (inboundShipmentsStub) FindAll(_ context.Context, _ domain.ShipmentFilter) ([]domain.InboundShipment, error) {
+	return nil, nil
+}
+
+func (inboundShipmentsStub) Scan(_ context.Context, _ int64, _ int64) error {
+	return domain.ErrShipmentNotFound
+}
+
+func (inboundShipmentsStub) Close(_ context.Context, _ domain.ShipmentReport) error {
+	return domain.ErrShipmentNotFound
+}
+
+func (inboundShipmentsStub) FindReport(_ context.Context, _ int64) (domain.ShipmentReport, error) {
+	return domain.ShipmentReport{}, domain.ErrShipmentReportNotFound
+}
+
+// stocktakingStub has no sessions.
+type stocktakingStub struct{}
+
+func (stocktakingStub) Create(_ context.Context, _ domain.StocktakingSession) (int64, error) {
+	return 1, nil
+}
+
+func (stocktakingStub) Find(_ context.Context, _ int64) (domain.StocktakingSession, error) {
+	return domain.StocktakingSession{}, domain.ErrStocktakingNotFound
+}
+
+func (stocktakingStub) FindAll(_ context.Context, _ domain.StocktakingFilter) ([]domain.StocktakingSession, error) {
+	return nil, nil
+}
+
+func (stocktakingStub) AddScans(_ context.Context, _ int64, _ []int64) error {
+	return domain.ErrStocktakingNotFound
+}
+
+func (stocktakingStub) Scanned(_ context.Context, _ int64) ([]int64, error) { return nil, nil }
+
+func (stocktakingStub) Finish(_ context.Context, _ int64, _ domain.StocktakingResult) error {
+	return domain.ErrStocktakingNotFound
+}
+
+// storageFeesStub has no point tariffs, every point uses the global one.
+type storageFeesStub struct{}
+
+func (storageFeesStub) FindTariff(_ context.Context, _ int64) (domain.StorageTariff, error) {
+	return domain.StorageTariff{}, domain.ErrStorageTariffNotFound
+}
+
+func (storageFeesStub) SaveTariff(_ context.Context, _ domain.StorageTariff) error { return nil }
+
+func (storageFeesStub) DeleteTariff(_ context.Context, _ int64) error {
+	return domain.ErrStorageTariffNotFound
+}
+
+func (storageFeesStub) SavePayment(_ context.Context, _ domain.StorageFeePayment) error { return nil }
+
+func (storageFeesStub) DailyRevenue(_ context.Context, _ domain.RevenueFilter) ([]domain.DailyRevenue, error) {
+	return nil, nil
+}
+
+type deadLettersStub struct{}
+
+func (deadLettersStub) Find(_ context.Context, _ int64) (domain.DeadLetter, error) {
+	return domain.DeadLetter{}, domain.ErrDeadLetterNotFound
+}
+
+func (deadLettersStub) FindAll(_ context.Context, _ domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	return nil, nil
+}
+
+func (deadLettersStub) Requeue(_ context.Context, _ int64) (int64, error) {
+	return 0, domain.ErrDeadLetterNotFound
+}
+
+func (deadLettersStub) Purge(_ context.Context, _ domain.DeadLetterFilter) (int64, error) {
+	return 0, nil
+}
+
+type incidentsStub struct{}
+
+func (incidentsStub) Create(_ context.Context, _ domain.Incident) (int64, error) { return 1, nil }
+
+func (incidentsStub) Find(_ context.Context, _ int64) (domain.Incident, error) {
+	return domain.Incident{}, domain.ErrIncidentNotFound
+}
+
+func (incidentsStub) FindAll(_ context.Context, _ domain.IncidentFilter) ([]domain.Incident, error) {
+	return nil, nil
+}
+
+func (incidentsStub) Update(_ context.Context, _ domain.Incident) error {
+	return domain.ErrIncidentNotFound
+}
+
+func (incidentsStub) Close(_ context.Context, _ domain.Incident) error {
+	return domain.ErrIncidentNotFound
+}
+
+type orderArchiveStub struct{}
+
+func (orderArchiveStub) Search(_ context.Context, _ domain.ArchiveFilter, _ int64, _ int) ([]domain.ArchivedOrder, error) {
+	return nil, nil
+}
+
+type refundDetailsStub struct{}
+
+func (refundDetailsStub) Save(_ context.Context, _ domain.RefundDetails) error { return nil }
+
+func (refundDetailsStub) Find(_ context.Context, _ int64) (domain.RefundDetails, error) {
+	return domain.RefundDetails{}, domain.ErrRefundDetailsNotFound
+}
+
+// pickupCodesStub accepts testPickupCode for every order.
+type pickupCodesStub struct{}
+
+func (pickupCodesStub) Save(_ context.Context, _ domain.PickupCode) error { return nil }
+
+func (pickupCodesStub) Find(_ context.Context, orderID int64) (domain.PickupCode, error) {
+	return testStoredPickupCode(orderID), nil
+}
+
+func (pickupCodesStub) Update(_ context.Context, _ domain.PickupCode) error { return nil }
+
+func (pickupCodesStub) CreateReissue(_ context.Context, _ domain.PickupCodeReissue) (int64, error) {
+	return 1, nil
+}
+
+func (pickupCodesStub) CreateOverride(_ context.Context, _ domain.PickupOverride) (int64, error) {
+	return 1, nil
+}
+
+func testStoredPickupCode(orderID int64) domain.PickupCode {
+	return domain.PickupCode{
+		OrderID:  orderID,
+		CodeHash: hashPickupCode([]byte(testPickupCodeSecret), orderID, testPickupCode),
+	}
+}
+
+func newTestOrderService(repo OrderRepository) *OrderServiceImpl {
+	return newTestOrderServiceWithCells(repo, storageCellsStub{})
+}
+
+func newTestOrderServiceWithCells(repo OrderRepository, cells StorageCellRepository) *OrderServiceImpl {
+	return newTestOrderServiceWithCodes(repo, cells, pickupCodesStub{})
+}
+
+func newTestOrderServiceWithCodes(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithRefunds(repo, cells, codes, refundDetailsStub{})
+}
+
+func newTestOrderServiceWithRefunds(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithArchive(repo, cells, codes, refunds, orderArchiveStub{})
+}
+
+func newTestOrderServiceWithArchive(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithManifests(repo, cells, codes, refunds, archive, returnManifestsStub{})
+}
+
+func newTestOrderServiceWithManifests(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithShipments(repo, cells, codes, refunds, archive, manifests, inboundShipmentsStub{})
+}
+
+func newTestOrderServiceWithShipments(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithStocktaking(repo, cells, codes, refunds, archive, manifests, shipments, stocktakingStub{})
+}
+
+func newTestOrderServiceWithStocktaking(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+	stocktaking StocktakingRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithIncidents(repo, cells, codes, refunds, archive, manifests, shipments, stocktaking,
+		incidentsStub{})
+}
+
+func newTestOrderServiceWithIncidents(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+	stocktaking StocktakingRepository,
+	incidents IncidentRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithStorageFees(repo, cells, codes, refunds, archive, manifests, shipments, stocktaking,
+		incidents, storageFeesStub{})
+}
+
+func newTestOrderServiceWithStorageFees(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+	stocktaking StocktakingRepository,
+	incidents IncidentRepository,
+	fees StorageFeeRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithAudit(repo, cells, codes, refunds, archive, manifests, shipments, stocktaking,
+		incidents, fees, statusAuditStub{})
+}
+
+func newTestOrderServiceWithAudit(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+	stocktaking StocktakingRepository,
+	incidents IncidentRepository,
+	fees StorageFeeRepository,
+	audit OrderStatusAuditRepository,
+) *OrderServiceImpl {
+	return newTestOrderServiceWithDeadLetters(repo, cells, codes, refunds, archive, manifests, shipments, stocktaking,
+		incidents, fees, audit, deadLettersStub{})
+}
+
+func newTestOrderServiceWithDeadLetters(
+	repo OrderRepository,
+	cells StorageCellRepository,
+	codes PickupCodeRepository,
+	refunds RefundDetailsRepository,
+	archive OrderArchiveRepository,
+	manifests ReturnManifestRepository,
+	shipments InboundShipmentRepository,
+	stocktaking StocktakingRepository,
+	incidents IncidentRepository,
+	fees StorageFeeRepository,
+	audit OrderStatusAuditRepository,
+	deadLetters DeadLetterRepository,
+) *OrderServiceImpl {
+	return NewOrderServiceImpl(
+		repo,
+		txManagerStub{},
+		audit,
+		NewPackagingCatalog(nil, testPackages, testCompositionRules),
+		pickupPointsStub{},
+		cells,
+		NewPickupCodes(codes, testPickupCodeSecret, testPickupCodePolicy),
+		NewRefunds(refunds, testRefundReasons),
+		archive,
+		manifests,
+		shipments,
+		stocktaking,
+		incidents,
+		NewStorageFees(fees, testStorageTariff),
+		deadLetters,
+	)
+}
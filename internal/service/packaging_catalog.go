@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// PackagingCatalog keeps package types in memory so that order creation does
+// not hit the packages table on every request.
+type PackagingCatalog struct {
+	repo     PackageRepository
+	rules    []domain.CompositionRule
+	mu       sync.RWMutex
+	packages map[domain.PackageType]domain.PackageSpec
+}
+
+func NewPackagingCatalog(
+	repo PackageRepository,
+	defaults []domain.PackageSpec,
+	rules []domain.CompositionRule,
+) *PackagingCatalog {
+	c := &PackagingCatalog{
+		repo:     repo,
+		rules:    rules,
+		packages: make(map[domain.PackageType]domain.PackageSpec, len(defaults)),
+	}
+	for _, spec := range defaults {
+		c.packages[spec.Name] = spec
+	}
+
+	return c
+}
+
+// PackageSpecsFromConfig fails on the first invalid package, a catalog that
+// silently lacks a configured package would pack orders into the wrong one.
+func PackageSpecsFromConfig(packages []config.PackageConfig) ([]domain.PackageSpec, error) {
+	specs := make([]domain.PackageSpec, 0, len(packages))
+	for _, p := range packages {
+		currency := domain.Currency(p.Currency)
+		if currency == "" {
+			currency = domain.DefaultCurrency
+		}
+		spec, err := domain.NewPackageSpec(
+			p.Name,
+			p.MaxWeight,
+			p.MaxVolume,
+			p.MaxLength,
+			domain.Money{Amount: p.Price, Currency: currency},
+			p.CanWrap,
+			p.IsActive,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("package %q: %w", p.Name, err)
+		}
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+func CompositionRulesFromConfig(rules []config.PackagingRuleConfig) []domain.CompositionRule {
+	compositionRules := make([]domain.CompositionRule, 0, len(rules))
+	for _, r := range rules {
+		compositionRules = append(compositionRules, domain.CompositionRule{
+			Outer: domain.PackageType(r.Outer),
+			Inner: domain.PackageType(r.Inner),
+		})
+	}
+
+	return compositionRules
+}
+
+// Load replaces the catalog with the packages table. Config defaults are kept
+// when the table is empty.
+func (c *PackagingCatalog) Load(ctx context.Context) error {
+	specs, err := c.repo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("c.repo.FindAll: %w", err)
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+
+	packages := make(map[domain.PackageType]domain.PackageSpec, len(specs))
+	for _, spec := range specs {
+		packages[spec.Name] = spec
+	}
+
+	c.mu.Lock()
+	c.packages = packages
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Resolve returns an active package by its name.
+func (c *PackagingCatalog) Resolve(packageType domain.PackageType) (domain.PackageSpec, error) {
+	c.mu.RLock()
+	spec, ok := c.packages[packageType]
+	c.mu.RUnlock()
+
+	if !ok {
+		return domain.PackageSpec{}, domain.ErrPackageNotExists
+	}
+	if !spec.IsActive {
+		return domain.PackageSpec{}, domain.ErrPackageNotActive
+	}
+
+	return spec, nil
+}
+
+// Compose resolves packaging layers ordered from the innermost to the outermost
+// and checks that they can be nested into each other.
+func (c *PackagingCatalog) Compose(layers []domain.PackageType) ([]domain.PackageSpec, error) {
+	specs := make([]domain.PackageSpec, 0, len(layers))
+	for _, layer := range layers {
+		spec, err := c.Resolve(layer)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, layer)
+		}
+		specs = append(specs, spec)
+	}
+
+	if err := domain.ValidatePackagingComposition(specs, c.rules); err != nil {
+		return nil, err
+	}
+
+	return specs, nil
+}
+
+// Recommend returns an active package priced in the currency the parcel fits
+// into. A container is preferred to a package without limits, which fits
+// anything and would win on price every time, then the cheapest one wins.
+// Extra layers never make a parcel fit, so the recommendation is a single
+// package.
+func (c *PackagingCatalog) Recommend(
+	weight int,
+	dimensions domain.Dimensions,
+	currency domain.Currency,
+) (domain.PackageSpec, error) {
+	var (
+		best  domain.PackageSpec
+		found bool
+	)
+	for _, spec := range c.List() {
+		if !spec.IsActive || spec.Price.Currency != currency || spec.ValidatePackagedOrder(weight, dimensions) != nil {
+			continue
+		}
+		if !found || betterRecommendation(spec, best) {
+			best, found = spec, true
+		}
+	}
+	if !found {
+		return domain.PackageSpec{}, domain.ErrNoSuitablePackage
+	}
+
+	return best, nil
+}
+
+func betterRecommendation(spec domain.PackageSpec, than domain.PackageSpec) bool {
+	if spec.IsContainer() != than.IsContainer() {
+		return spec.IsContainer()
+	}
+
+	return spec.Price.Amount < than.Price.Amount
+}
+
+func (c *PackagingCatalog) List() []domain.PackageSpec {
+	c.mu.RLock()
+	specs := make([]domain.PackageSpec, 0, len(c.packages))
+	for _, spec := range c.packages {
+		specs = append(specs, spec)
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].Name < specs[j].Name
+	})
+
+	return specs
+}
+
+func (c *PackagingCatalog) Save(ctx context.Context, spec domain.PackageSpec) error {
+	if err := c.repo.Upsert(ctx, spec); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.packages[spec.Name] = spec
+	c.mu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// PickupCodes issues and checks one-time pickup codes. Codes are stored as
+// HMAC so that a leaked table does not reveal them.
+type PickupCodes struct {
+	repo   PickupCodeRepository
+	secret []byte
+	policy domain.PickupCodePolicy
+}
+
+func NewPickupCodes(repo PickupCodeRepository, secret string, policy domain.PickupCodePolicy) *PickupCodes {
+	return &PickupCodes{
+		repo:   repo,
+		secret: []byte(secret),
+		policy: policy,
+	}
+}
+
+// DefaultPickupCodeSecret is the placeholder secret of the example config.
+const DefaultPickupCodeSecret = "change-me"
+
+// PickupCodeSecretFromConfig refuses an empty or the placeholder secret, codes
+// hashed with a known key are as good as stored in plain text.
+func PickupCodeSecretFromConfig(cfg config.PickupCodeConfig) (string, error) {
+	if cfg.Secret == "" || cfg.Secret == DefaultPickupCodeSecret {
+		return "", domain.ErrPickupCodeSecretIsIncorrect
+	}
+
+	return cfg.Secret, nil
+}
+
+func PickupCodePolicyFromConfig(cfg config.PickupCodeConfig) domain.PickupCodePolicy {
+	return domain.PickupCodePolicy{
+		MaxAttempts: cfg.MaxAttempts,
+		Lockout:     time.Duration(cfg.LockoutMinutes) * time.Minute,
+	}
+}
+
+// Issue generates a new code for the order and returns it in plain text. It is
+// the only moment the code is known to the service. A code that replaces
+// another one keeps its failed attempts and lock.
+func (p *PickupCodes) Issue(ctx context.Context, orderID int64) (string, error) {
+	code, err := domain.NewPickupCodeValue()
+	if err != nil {
+		return "", err
+	}
+	if err := p.repo.Save(ctx, domain.PickupCode{
+		OrderID:  orderID,
+		CodeHash: hashPickupCode(p.secret, orderID, code),
+	}); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// Reissue replaces the code of the order and records who asked for it.
+func (p *PickupCodes) Reissue(ctx context.Context, reissue domain.PickupCodeReissue) (string, error) {
+	code, err := p.Issue(ctx, reissue.OrderID)
+	if err != nil {
+		return "", err
+	}
+	if _, err := p.repo.CreateReissue(ctx, reissue); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// Verify checks the code and stores the attempt. The caller has to commit the
+// transaction even when the code is rejected, otherwise attempts are not counted.
+func (p *PickupCodes) Verify(ctx context.Context, orderID int64, code string) error {
+	stored, err := p.repo.Find(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	verifyErr := stored.Verify(hashPickupCode(p.secret, orderID, code), time.Now(), p.policy)
+	if err := p.repo.Update(ctx, stored); err != nil {
+		return err
+	}
+
+	return verifyErr
+}
+
+// Check verifies the code like Verify but stores only a successful use, a
+// rejected attempt is left for the caller to count once, e.g. after the batch
+// it was part of is rolled back.
+func (p *PickupCodes) Check(ctx context.Context, orderID int64, code string) error {
+	stored, err := p.repo.Find(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if err := stored.Verify(hashPickupCode(p.secret, orderID, code), time.Now(), p.policy); err != nil {
+		return err
+	}
+
+	return p.repo.Update(ctx, stored)
+}
+
+// Override uses up the code of the order, if it has one, and records who
+// issued the order without it and why.
+func (p *PickupCodes) Override(ctx context.Context, override domain.PickupOverride) error {
+	stored, err := p.repo.Find(ctx, override.OrderID)
+	switch {
+	case err == nil:
+		stored.Use(time.Now())
+		if err := p.repo.Update(ctx, stored); err != nil {
+			return err
+		}
+	case !errors.Is(err, domain.ErrPickupCodeNotIssued):
+		return err
+	}
+
+	_, err = p.repo.CreateOverride(ctx, override)
+
+	return err
+}
+
+// isPickupCodeRejection tells apart a wrong or unusable code from a failure
+// to check it.
+func isPickupCodeRejection(err error) bool {
+	return errors.Is(err, domain.ErrPickupCodeInvalid) ||
+		errors.Is(err, domain.ErrPickupCodeLocked) ||
+		errors.Is(err, domain.ErrPickupCodeUsed) ||
+		errors.Is(err, domain.ErrPickupCodeNotIssued)
+}
+
+func hashPickupCode(secret []byte, orderID int64, code string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(orderID, 10) + ":" + code))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+func (o *OrderServiceImpl) CreatePickupPoint(ctx context.Context, point domain.PickupPoint) (domain.PickupPoint, error) {
+	id, err := o.pickupPoints.Create(ctx, point)
+	if err != nil {
+		return domain.PickupPoint{}, err
+	}
+	point.ID = id
+
+	return point, nil
+}
+
+func (o *OrderServiceImpl) GetPickupPoint(ctx context.Context, id int64) (domain.PickupPoint, error) {
+	return o.pickupPoints.Find(ctx, id)
+}
+
+func (o *OrderServiceImpl) ListPickupPoints(ctx context.Context) ([]domain.PickupPoint, error) {
+	return o.pickupPoints.FindAll(ctx)
+}
+
+func (o *OrderServiceImpl) UpdatePickupPoint(ctx context.Context, point domain.PickupPoint) (domain.PickupPoint, error) {
+	if err := o.pickupPoints.Update(ctx, point); err != nil {
+		return domain.PickupPoint{}, err
+	}
+
+	return point, nil
+}
+
+// DeletePickupPoint removes a point that has no orders. The default point
+// cannot be removed because orders without a point fall back to it.
+func (o *OrderServiceImpl) DeletePickupPoint(ctx context.Context, id int64) error {
+	if id == domain.DefaultPickupPointID {
+		return domain.ErrDefaultPickupPoint
+	}
+
+	return o.pickupPoints.Delete(ctx, id)
+}
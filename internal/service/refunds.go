@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// Refunds keeps the details of accepted refunds and the catalog of reasons a
+// customer may give for them.
+type Refunds struct {
+	repo    RefundDetailsRepository
+	reasons []domain.RefundReason
+}
+
+func NewRefunds(repo RefundDetailsRepository, reasons []domain.RefundReason) *Refunds {
+	return &Refunds{
+		repo:    repo,
+		reasons: reasons,
+	}
+}
+
+func RefundReasonsFromConfig(reasons []config.RefundReasonConfig) []domain.RefundReason {
+	catalog := make([]domain.RefundReason, 0, len(reasons))
+	for _, r := range reasons {
+		if r.Code == "" {
+			continue
+		}
+		catalog = append(catalog, domain.RefundReason{Code: r.Code, Description: r.Description})
+	}
+
+	return catalog
+}
+
+func (r *Refunds) Reasons() []domain.RefundReason {
+	return r.reasons
+}
+
+// Validate checks that the reason is in the catalog.
+func (r *Refunds) Validate(details domain.RefundDetails) error {
+	for _, reason := range r.reasons {
+		if reason.Code == details.ReasonCode {
+			return nil
+		}
+	}
+
+	return domain.ErrUnknownRefundReason
+}
+
+func (r *Refunds) Save(ctx context.Context, orderID int64, details domain.RefundDetails) (domain.RefundDetails, error) {
+	details.OrderID = orderID
+	if err := r.repo.Save(ctx, details); err != nil {
+		return domain.RefundDetails{}, err
+	}
+
+	return details, nil
+}
+
+// Find returns nil when the order has no refund details.
+func (r *Refunds) Find(ctx context.Context, orderID int64) (*domain.RefundDetails, error) {
+	details, err := r.repo.Find(ctx, orderID)
+	if errors.Is(err, domain.ErrRefundDetailsNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+func (o *OrderServiceImpl) ListRefundReasons(_ context.Context) []domain.RefundReason {
+	return o.refunds.Reasons()
+}
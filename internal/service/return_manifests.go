@@ -0,0 +1,203 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+)
+
+// CreateReturnManifest puts every refunded or expired order of the point that
+// is not in another open manifest into a new manifest for the courier.
+func (o *OrderServiceImpl) CreateReturnManifest(
+	ctx context.Context,
+	pickupPointID int64,
+	courierName string,
+) (domain.ReturnManifest, error) {
+	manifest, err := domain.NewReturnManifest(pickupPointID, courierName)
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		if _, err := o.pickupPoints.Find(ctxTx, pickupPointID); err != nil {
+			return err
+		}
+		manifest.Items, err = o.manifests.FindReturnableOrders(ctxTx, pickupPointID, domain.ReturnableStatuses)
+		if err != nil {
+			return err
+		}
+		if len(manifest.Items) == 0 {
+			return domain.ErrNothingToReturn
+		}
+		id, err := o.manifests.Create(ctxTx, manifest)
+		if err != nil {
+			return err
+		}
+		manifest, err = o.manifests.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.ReturnManifest{}, fmt.Errorf("o.txManager.RunSerializable from CreateReturnManifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func (o *OrderServiceImpl) GetReturnManifest(ctx context.Context, id int64) (domain.ReturnManifest, error) {
+	return o.manifests.Find(ctx, id)
+}
+
+func (o *OrderServiceImpl) ListReturnManifests(
+	ctx context.Context,
+	filter domain.ManifestFilter,
+) ([]domain.ReturnManifest, error) {
+	return o.manifests.FindAll(ctx, filter)
+}
+
+// ScanReturnManifestOrder confirms that the order is handed to the courier.
+func (o *OrderServiceImpl) ScanReturnManifestOrder(
+	ctx context.Context,
+	manifestID int64,
+	orderID int64,
+) (domain.ReturnManifest, error) {
+	var manifest domain.ReturnManifest
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		manifest, err = o.manifests.Find(ctxTx, manifestID)
+		if err != nil {
+			return err
+		}
+		if !manifest.IsOpen() {
+			return domain.ErrReturnManifestIsClosed
+		}
+		if !manifest.HasOrder(orderID) {
+			return domain.ErrOrderNotInManifest
+		}
+		if err := o.manifests.Scan(ctxTx, manifestID, orderID); err != nil {
+			return err
+		}
+		manifest, err = o.manifests.Find(ctxTx, manifestID)
+
+		return err
+	}); err != nil {
+		return domain.ReturnManifest{}, fmt.Errorf("o.txManager.RunSerializable from ScanReturnManifestOrder: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// CloseReturnManifest returns every scanned order to the courier in one
+// transaction. Unscanned orders are dropped from the manifest and stay at
+// the point.
+func (o *OrderServiceImpl) CloseReturnManifest(ctx context.Context, id int64) (domain.ReturnManifest, error) {
+	var (
+		manifest domain.ReturnManifest
+		returned []statusChange
+	)
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		returned = returned[:0]
+		var err error
+		manifest, err = o.manifests.Find(ctxTx, id)
+		if err != nil {
+			return err
+		}
+		if !manifest.IsOpen() {
+			return domain.ErrReturnManifestIsClosed
+		}
+		scanned := manifest.Scanned()
+		if len(scanned) == 0 {
+			return domain.ErrNoScannedOrders
+		}
+		for _, orderID := range scanned {
+			change, err := o.returnInTx(ctxTx, orderID)
+			if err != nil {
+				return fmt.Errorf("order %d: %w", orderID, err)
+			}
+			returned = append(returned, change)
+		}
+		if err := o.manifests.Close(ctxTx, id); err != nil {
+			return err
+		}
+		manifest, err = o.manifests.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.ReturnManifest{}, fmt.Errorf("o.txManager.RunSerializable from CloseReturnManifest: %w", err)
+	}
+	monitoring.OrdersReturnedTotal.Add(float64(len(returned)))
+	o.reportPointOccupancy(ctx, manifest.PickupPointID)
+
+	return manifest, nil
+}
+
+// ExportReturnManifest renders the manifest as a JSON document or as a CSV
+// table with one row per order.
+func (o *OrderServiceImpl) ExportReturnManifest(
+	ctx context.Context,
+	id int64,
+	format domain.ExportFormat,
+) ([]byte, error) {
+	manifest, err := o.manifests.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case domain.ExportJSON:
+		return json.Marshal(manifest)
+	case domain.ExportCSV:
+		return manifestToCSV(manifest)
+	default:
+		return nil, domain.ErrUnknownExportFormat
+	}
+}
+
+var manifestCSVHeader = []string{
+	"manifest_id", "pickup_point_id", "courier_name", "manifest_status", "created_at", "closed_at",
+	"order_id", "user_id", "order_status", "scanned_at",
+}
+
+func manifestToCSV(manifest domain.ReturnManifest) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(manifestCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, item := range manifest.Items {
+		if err := w.Write([]string{
+			strconv.FormatInt(manifest.ID, 10),
+			strconv.FormatInt(manifest.PickupPointID, 10),
+			manifest.CourierName,
+			string(manifest.Status),
+			manifest.CreatedAt.Format(time.RFC3339),
+			formatOptionalTime(manifest.ClosedAt),
+			strconv.FormatInt(item.OrderID, 10),
+			strconv.FormatInt(item.UserID, 10),
+			string(item.OrderStatus),
+			formatOptionalTime(item.ScannedAt),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
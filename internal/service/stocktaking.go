@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository"
+)
+
+// StocktakingScanBatchSize is how many streamed scans are stored at once.
+const StocktakingScanBatchSize = 500
+
+// StartStocktaking opens a session for the point, a point has one open
+// session at a time.
+func (o *OrderServiceImpl) StartStocktaking(
+	ctx context.Context,
+	pickupPointID int64,
+	operator string,
+) (domain.StocktakingSession, error) {
+	session, err := domain.NewStocktakingSession(pickupPointID, operator)
+	if err != nil {
+		return domain.StocktakingSession{}, err
+	}
+
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		if _, err := o.pickupPoints.Find(ctxTx, pickupPointID); err != nil {
+			return err
+		}
+		id, err := o.stocktaking.Create(ctxTx, session)
+		if err != nil {
+			return err
+		}
+		session, err = o.stocktaking.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.StocktakingSession{}, fmt.Errorf("o.txManager.RunSerializable from StartStocktaking: %w", err)
+	}
+
+	return session, nil
+}
+
+// AddStocktakingScans records a chunk of scanned parcels. Parcels unknown to
+// the point are accepted, they are reported when the session is finished.
+func (o *OrderServiceImpl) AddStocktakingScans(
+	ctx context.Context,
+	sessionID int64,
+	orderIDs []int64,
+) (domain.StocktakingSession, error) {
+	for _, id := range orderIDs {
+		if id <= 0 {
+			return domain.StocktakingSession{}, domain.ErrStocktakingFieldsAreIncorrect
+		}
+	}
+
+	var session domain.StocktakingSession
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		session, err = o.stocktaking.Find(ctxTx, sessionID)
+		if err != nil {
+			return err
+		}
+		if !session.IsOpen() {
+			return domain.ErrStocktakingIsFinished
+		}
+		if len(orderIDs) == 0 {
+			return nil
+		}
+		if err := o.stocktaking.AddScans(ctxTx, sessionID, orderIDs); err != nil {
+			return err
+		}
+		session, err = o.stocktaking.Find(ctxTx, sessionID)
+
+		return err
+	}); err != nil {
+		return domain.StocktakingSession{}, fmt.Errorf("o.txManager.RunSerializable from AddStocktakingScans: %w", err)
+	}
+
+	return session, nil
+}
+
+// FinishStocktaking diffs the scans against the orders stored at the point
+// and keeps the result with the session.
+func (o *OrderServiceImpl) FinishStocktaking(ctx context.Context, id int64) (domain.StocktakingSession, error) {
+	var session domain.StocktakingSession
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		var err error
+		session, err = o.stocktaking.Find(ctxTx, id)
+		if err != nil {
+			return err
+		}
+		if !session.IsOpen() {
+			return domain.ErrStocktakingIsFinished
+		}
+		stored, blocked, err := o.storedOrderIDs(ctxTx, session.PickupPointID)
+		if err != nil {
+			return err
+		}
+		scanned, err := o.stocktaking.Scanned(ctxTx, id)
+		if err != nil {
+			return err
+		}
+		if err := o.stocktaking.Finish(ctxTx, id, domain.NewStocktakingResult(stored, blocked, scanned)); err != nil {
+			return err
+		}
+		session, err = o.stocktaking.Find(ctxTx, id)
+
+		return err
+	}); err != nil {
+		return domain.StocktakingSession{}, fmt.Errorf("o.txManager.RunSerializable from FinishStocktaking: %w", err)
+	}
+
+	return session, nil
+}
+
+func (o *OrderServiceImpl) GetStocktaking(ctx context.Context, id int64) (domain.StocktakingSession, error) {
+	return o.stocktaking.Find(ctx, id)
+}
+
+func (o *OrderServiceImpl) ListStocktakings(
+	ctx context.Context,
+	filter domain.StocktakingFilter,
+) ([]domain.StocktakingSession, error) {
+	return o.stocktaking.FindAll(ctx, filter)
+}
+
+// storedOrderIDs returns the orders kept at the point and, apart, the blocked
+// ones. The list cache is bypassed, it may not know recent status changes.
+func (o *OrderServiceImpl) storedOrderIDs(ctx context.Context, pickupPointID int64) ([]int64, []int64, error) {
+	ids := func(status domain.Status) ([]int64, error) {
+		orders, err := o.repo.FindAllUncached(ctx, repository.Filter{PickupPointID: &pickupPointID, Status: &status})
+		if err != nil && !errors.Is(err, domain.ErrOrderNotFound) {
+			return nil, err
+		}
+		ids := make([]int64, 0, len(orders))
+		for _, order := range orders {
+			ids = append(ids, order.OrderID)
+		}
+
+		return ids, nil
+	}
+
+	var stored []int64
+	for _, status := range domain.StoredStatuses {
+		statusIDs, err := ids(status)
+		if err != nil {
+			return nil, nil, err
+		}
+		stored = append(stored, statusIDs...)
+	}
+	blocked, err := ids(domain.Blocked)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stored, blocked, nil
+}
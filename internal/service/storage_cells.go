@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+)
+
+func (o *OrderServiceImpl) CreateStorageCell(ctx context.Context, cell domain.StorageCell) (domain.StorageCell, error) {
+	id, err := o.cells.Create(ctx, cell)
+	if err != nil {
+		return domain.StorageCell{}, err
+	}
+	cell.ID = id
+	o.reportPointOccupancy(ctx, cell.PickupPointID)
+
+	return cell, nil
+}
+
+func (o *OrderServiceImpl) ListStorageCells(ctx context.Context, pickupPointID int64) ([]domain.StorageCell, error) {
+	if _, err := o.pickupPoints.Find(ctx, pickupPointID); err != nil {
+		return nil, err
+	}
+
+	return o.cells.FindAll(ctx, pickupPointID)
+}
+
+// GetStorageOccupancy reports cell usage of every point that has cells and
+// refreshes the fill ratio gauge with it.
+func (o *OrderServiceImpl) GetStorageOccupancy(ctx context.Context) ([]domain.StorageOccupancy, error) {
+	occupancy, err := o.cells.Occupancy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, oc := range occupancy {
+		setFillRatio(oc)
+	}
+
+	return occupancy, nil
+}
+
+// assignStorageCell puts the order into a cell of its pickup point. Points
+// without any cells are not capacity managed and accept every parcel.
+func (o *OrderServiceImpl) assignStorageCell(ctx context.Context, order domain.Order) (domain.StorageCell, error) {
+	occupancy, err := o.cells.PointOccupancy(ctx, order.PickupPointID)
+	if err != nil {
+		return domain.StorageCell{}, err
+	}
+	if occupancy.Total == 0 {
+		return domain.StorageCell{}, nil
+	}
+
+	return o.cells.Assign(ctx, order.PickupPointID, order.OrderID, order.Weight, order.Dimensions)
+}
+
+func (o *OrderServiceImpl) releaseStorageCell(ctx context.Context, orderID int64) error {
+	if _, err := o.cells.Release(ctx, orderID); err != nil && !errors.Is(err, domain.ErrStorageCellNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// reportPointOccupancy refreshes the fill ratio gauge of the point. Metrics
+// are best effort, so a failed lookup leaves the previous value.
+func (o *OrderServiceImpl) reportPointOccupancy(ctx context.Context, pickupPointID int64) {
+	if pickupPointID == 0 {
+		return
+	}
+	occupancy, err := o.cells.PointOccupancy(ctx, pickupPointID)
+	if err != nil {
+		return
+	}
+	setFillRatio(occupancy)
+}
+
+func setFillRatio(occupancy domain.StorageOccupancy) {
+	monitoring.StorageCellsFillRatio.
+		WithLabelValues(strconv.FormatInt(occupancy.PickupPointID, 10)).
+		Set(occupancy.FillRatio())
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// StorageFees charges orders for the days they wait for their owners on the
+// shelf. Points without a tariff of their own use the global one.
+type StorageFees struct {
+	repo   StorageFeeRepository
+	global domain.StorageTariff
+}
+
+func NewStorageFees(repo StorageFeeRepository, global domain.StorageTariff) *StorageFees {
+	return &StorageFees{
+		repo:   repo,
+		global: global,
+	}
+}
+
+// StorageTariffFromConfig fails on a tariff that is not valid, it must not
+// turn into free storage unnoticed.
+func StorageTariffFromConfig(cfg config.StorageTariffConfig) (domain.StorageTariff, error) {
+	currency := domain.Currency(cfg.Currency)
+	if currency == "" {
+		currency = domain.DefaultCurrency
+	}
+	tariff, err := domain.NewStorageTariff(nil, cfg.FreeDays, domain.Money{Amount: cfg.DailyRate, Currency: currency})
+	if err != nil {
+		return domain.StorageTariff{}, fmt.Errorf("storage tariff: %w", err)
+	}
+
+	return tariff, nil
+}
+
+// Tariff returns the tariff of the point or the global one.
+func (f *StorageFees) Tariff(ctx context.Context, pickupPointID int64) (domain.StorageTariff, error) {
+	tariff, err := f.repo.FindTariff(ctx, pickupPointID)
+	if errors.Is(err, domain.ErrStorageTariffNotFound) {
+		return f.global, nil
+	}
+	if err != nil {
+		return domain.StorageTariff{}, err
+	}
+
+	return tariff, nil
+}
+
+// Accrue returns the fee the order accrued by now, nil for orders that are
+// not charged for storage.
+func (f *StorageFees) Accrue(ctx context.Context, order domain.Order, now time.Time) (*domain.StorageFee, error) {
+	if !domain.AccruesStorageFee(order) {
+		return nil, nil
+	}
+	tariff, err := f.Tariff(ctx, order.PickupPointID)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := tariff.Fee(order.ArrivedAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fee, nil
+}
+
+// AccrueAll fills the fees of the orders, the tariff of every point is looked
+// up once.
+func (f *StorageFees) AccrueAll(ctx context.Context, orders []domain.Order, now time.Time) error {
+	tariffs := make(map[int64]domain.StorageTariff)
+	for i, order := range orders {
+		if !domain.AccruesStorageFee(order) {
+			continue
+		}
+		tariff, ok := tariffs[order.PickupPointID]
+		if !ok {
+			var err error
+			if tariff, err = f.Tariff(ctx, order.PickupPointID); err != nil {
+				return err
+			}
+			tariffs[order.PickupPointID] = tariff
+		}
+		fee, err := tariff.Fee(order.ArrivedAt, now)
+		if err != nil {
+			return err
+		}
+		orders[i].StorageFee = &fee
+	}
+
+	return nil
+}
+
+// Collect records the fee paid for the order when it was issued.
+func (f *StorageFees) Collect(ctx context.Context, order domain.Order, fee *domain.StorageFee, paidAt time.Time) error {
+	if fee == nil || !fee.IsDue() {
+		return nil
+	}
+
+	return f.repo.SavePayment(ctx, domain.StorageFeePayment{
+		OrderID:       order.OrderID,
+		PickupPointID: order.PickupPointID,
+		ChargedDays:   fee.ChargedDays,
+		Amount:        fee.Amount,
+		PaidAt:        paidAt,
+	})
+}
+
+// GetStorageTariff returns the tariff the point charges, its own or the
+// global one.
+func (o *OrderServiceImpl) GetStorageTariff(ctx context.Context, pickupPointID int64) (domain.StorageTariff, error) {
+	if _, err := o.pickupPoints.Find(ctx, pickupPointID); err != nil {
+		return domain.StorageTariff{}, err
+	}
+
+	return o.fees.Tariff(ctx, pickupPointID)
+}
+
+// SetStorageTariff overrides the global tariff for the point.
+func (o *OrderServiceImpl) SetStorageTariff(
+	ctx context.Context,
+	pickupPointID int64,
+	freeDays int,
+	dailyRate domain.Money,
+) (domain.StorageTariff, error) {
+	tariff, err := domain.NewStorageTariff(&pickupPointID, freeDays, dailyRate)
+	if err != nil {
+		return domain.StorageTariff{}, err
+	}
+
+	if err := o.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		if err := o.fees.repo.SaveTariff(ctxTx, tariff); err != nil {
+			return err
+		}
+		tariff, err = o.fees.Tariff(ctxTx, pickupPointID)
+
+		return err
+	}); err != nil {
+		return domain.StorageTariff{}, fmt.Errorf("o.txManager.RunSerializable from SetStorageTariff: %w", err)
+	}
+
+	return tariff, nil
+}
+
+// DeleteStorageTariff brings the point back to the global tariff.
+func (o *OrderServiceImpl) DeleteStorageTariff(ctx context.Context, pickupPointID int64) error {
+	return o.fees.repo.DeleteTariff(ctx, pickupPointID)
+}
+
+// GetDailyRevenue reports issued orders and collected storage fees by day.
+func (o *OrderServiceImpl) GetDailyRevenue(
+	ctx context.Context,
+	filter domain.RevenueFilter,
+) ([]domain.DailyRevenue, error) {
+	return o.fees.repo.DailyRevenue(ctx, filter)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+)
+
+// SupervisorTokenHeader carries the token of a supervisor, as an HTTP header
+// and, lower cased, as gRPC metadata.
+const SupervisorTokenHeader = "X-Supervisor-Token"
+
+type supervisorKey struct{}
+
+// Supervisors authenticates the supervisors configured for the service. The
+// transport puts the authenticated one into the request context, the service
+// never trusts a supervisor id sent by the client.
+type Supervisors struct {
+	tokens map[string]int64
+}
+
+func NewSupervisors(cfg []config.SupervisorConfig) (*Supervisors, error) {
+	s := &Supervisors{tokens: make(map[string]int64, len(cfg))}
+	for _, supervisor := range cfg {
+		if supervisor.ID <= 0 || supervisor.Token == "" {
+			return nil, domain.ErrSupervisorsAreIncorrect
+		}
+		if _, ok := s.tokens[supervisor.Token]; ok {
+			return nil, domain.ErrSupervisorsAreIncorrect
+		}
+		s.tokens[supervisor.Token] = supervisor.ID
+	}
+
+	return s, nil
+}
+
+// Authenticate returns the id of the supervisor the token belongs to. Every
+// token is compared so that the time taken does not tell how close a guess was.
+func (s *Supervisors) Authenticate(token string) (int64, error) {
+	var supervisorID int64
+	for known, id := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(known)) == 1 {
+			supervisorID = id
+		}
+	}
+	if supervisorID == 0 {
+		return 0, domain.ErrSupervisorTokenInvalid
+	}
+
+	return supervisorID, nil
+}
+
+func ContextWithSupervisor(ctx context.Context, supervisorID int64) context.Context {
+	return context.WithValue(ctx, supervisorKey{}, supervisorID)
+}
+
+// SupervisorFromContext returns the supervisor authenticated for the request.
+func SupervisorFromContext(ctx context.Context) (int64, bool) {
+	supervisorID, ok := ctx.Value(supervisorKey{}).(int64)
+
+	return supervisorID, ok && supervisorID > 0
+}
@@ -0,0 +1,67 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/logger"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository/postgresql"
+	"go.uber.org/zap"
+)
+
+const defaultArchivePurgeInterval = time.Hour
+
+// ArchivePurger removes archived orders once the retention period is over.
+type ArchivePurger struct {
+	repo      postgresql.OrderArchiveRepository
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewArchivePurger(repo postgresql.OrderArchiveRepository, cfg config.ArchiveConfig) *ArchivePurger {
+	interval := time.Duration(cfg.PurgeIntervalMinutes) * time.Minute
+	if interval <= 0 {
+		interval = defaultArchivePurgeInterval
+	}
+
+	return &ArchivePurger{
+		repo:      repo,
+		retention: time.Duration(cfg.RetentionDays) * 24 * time.Hour,
+		interval:  interval,
+	}
+}
+
+// Start runs the purge on every tick until ctx is done. Nothing is purged when
+// the retention period is not set.
+func (ap *ArchivePurger) Start(ctx context.Context) {
+	if ap.retention <= 0 {
+		logger.ZapLogger.Info("archive retention is not set, archived orders are kept forever")
+
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(ap.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purged, err := ap.repo.Purge(ctx, time.Now().Add(-ap.retention))
+				if err != nil {
+					logger.ZapLogger.Error("purge archived orders failed", zap.String("archivepurger", err.Error()))
+
+					continue
+				}
+				if purged > 0 {
+					monitoring.ArchivedOrdersPurgedTotal.Add(float64(purged))
+					logger.ZapLogger.Info("archived orders purged", zap.Int64("count", purged))
+				}
+			}
+		}
+	}()
+}
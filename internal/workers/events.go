@@ -0,0 +1,67 @@
+package workers
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events"
+)
+
+var errEntryNotFound = errors.New("audit entry of the task is not found")
+
+// credentialHeaders are request headers that never leave the service.
+var credentialHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "X-Supervisor-Token"}
+
+// newEvent wraps the entry of the task in an envelope. The event ID depends on
+// the entry only, a task that is published again, or requeued from dead
+// letters, keeps it, so consumers can drop duplicates.
+func newEvent(task domain.Task) (events.Envelope, error) {
+	trace := events.TraceContext{TraceParent: task.TraceParent, TraceState: task.TraceState}
+	id := fmt.Sprintf("%s-%d", task.TaskType, task.EntryID)
+
+	switch {
+	case task.TaskType == domain.OrderStatusLog && task.StatusChange != nil:
+		return events.NewEnvelope(id, task.StatusChange.CreatedAt, trace, orderStatusChanged(*task.StatusChange))
+	case task.TaskType == domain.AuditLog && task.Request != nil:
+		return events.NewEnvelope(id, task.Request.CreatedAt, trace, httpRequestAudited(*task.Request))
+	default:
+		return events.Envelope{}, fmt.Errorf("%w: %s %d", errEntryNotFound, task.TaskType, task.EntryID)
+	}
+}
+
+func orderStatusChanged(change domain.AuditOrderInfo) events.OrderStatusChanged {
+	payload := events.OrderStatusChanged{
+		OrderID:        change.OrderID,
+		PreviousStatus: string(change.PreviousStatus),
+		CurrentStatus:  string(change.CurrentStatus),
+		IncidentID:     change.IncidentID,
+	}
+	if change.Refund != nil {
+		payload.Refund = &events.Refund{
+			ReasonCode:    change.Refund.ReasonCode,
+			Comment:       change.Refund.Comment,
+			ItemCondition: string(change.Refund.Condition),
+		}
+	}
+
+	return payload
+}
+
+func httpRequestAudited(record domain.AuditLogRecord) events.HTTPRequestAudited {
+	header := record.RequestHeader.Clone()
+	for _, name := range credentialHeaders {
+		header.Del(name)
+	}
+
+	return events.HTTPRequestAudited{
+		EntryID:       record.EntryID,
+		Method:        record.Method,
+		Path:          record.Path,
+		RequestHeader: header,
+		RequestBody:   record.RequestBody,
+		QueryParams:   record.QueryParams,
+		StatusCode:    record.StatusCode,
+		ResponseBody:  record.ResponseBody,
+	}
+}
@@ -0,0 +1,67 @@
+package workers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Parallel()
+	createdAt := time.Date(2025, 4, 29, 10, 0, 0, 0, time.UTC)
+
+	t.Run("status change carries the refund", func(t *testing.T) {
+		t.Parallel()
+		change := domain.NewAuditOrderInfo(42, domain.Completed, domain.Refunded)
+		change.EntryID, change.CreatedAt = 5, createdAt
+		change.Refund = &domain.RefundDetails{OrderID: 42, ReasonCode: "size", Condition: domain.Opened}
+		task := domain.Task{TaskID: 9, TaskType: domain.OrderStatusLog, EntryID: 5, StatusChange: change}
+
+		event, err := newEvent(task)
+
+		require.NoError(t, err)
+		require.Equal(t, "ORDER_STATUS_LOG-5", event.ID)
+		require.Equal(t, "42", event.AggregateID)
+		require.Equal(t, createdAt, event.Time)
+		var payload events.OrderStatusChanged
+		require.NoError(t, event.DecodeData(&payload))
+		require.Equal(t, events.OrderStatusChanged{
+			OrderID:        42,
+			PreviousStatus: string(domain.Completed),
+			CurrentStatus:  string(domain.Refunded),
+			Refund:         &events.Refund{ReasonCode: "size", ItemCondition: string(domain.Opened)},
+		}, payload)
+	})
+	t.Run("request drops credentials", func(t *testing.T) {
+		t.Parallel()
+		record := &domain.AuditLogRecord{
+			EntryID:       3,
+			Method:        http.MethodPost,
+			Path:          "/orders",
+			RequestHeader: http.Header{"Authorization": {"Basic secret"}, "Content-Type": {"application/json"}},
+			StatusCode:    http.StatusOK,
+			CreatedAt:     createdAt,
+		}
+		task := domain.Task{TaskType: domain.AuditLog, EntryID: 3, TraceParent: "00-trace", Request: record}
+
+		event, err := newEvent(task)
+
+		require.NoError(t, err)
+		require.Equal(t, "00-trace", event.TraceParent)
+		var payload events.HTTPRequestAudited
+		require.NoError(t, event.DecodeData(&payload))
+		require.Equal(t, http.Header{"Content-Type": {"application/json"}}, payload.RequestHeader)
+		require.Contains(t, record.RequestHeader, "Authorization")
+	})
+	t.Run("missing entry", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newEvent(domain.Task{TaskType: domain.OrderStatusLog, EntryID: 1})
+
+		require.ErrorIs(t, err, errEntryNotFound)
+	})
+}
@@ -0,0 +1,84 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/logger"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultExpirySweepInterval = time.Minute
+	defaultExpirySweepBatch    = 100
+)
+
+type OrderExpirer interface {
+	ExpireOrders(ctx context.Context, limit int) (int, error)
+}
+
+// ExpirySweeper periodically moves confirmed orders past their expiration
+// date to awaiting return.
+type ExpirySweeper struct {
+	expirer   OrderExpirer
+	interval  time.Duration
+	batchSize int
+}
+
+func NewExpirySweeper(expirer OrderExpirer, cfg config.ExpirySweeperConfig) *ExpirySweeper {
+	interval := time.Duration(cfg.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultExpirySweepInterval
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultExpirySweepBatch
+	}
+
+	return &ExpirySweeper{
+		expirer:   expirer,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (es *ExpirySweeper) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(es.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expired := es.sweep(ctx)
+				monitoring.OrdersExpiredPerRun.Observe(float64(expired))
+				if expired > 0 {
+					logger.ZapLogger.Info("expired orders moved to awaiting return", zap.Int("count", expired))
+				}
+			}
+		}
+	}()
+}
+
+// sweep expires orders batch by batch until a batch comes back incomplete.
+func (es *ExpirySweeper) sweep(ctx context.Context) int {
+	total := 0
+	for ctx.Err() == nil {
+		expired, err := es.expirer.ExpireOrders(ctx, es.batchSize)
+		if err != nil {
+			logger.ZapLogger.Error("expire orders failed", zap.String("expirysweeper", err.Error()))
+
+			break
+		}
+		total += expired
+		if expired < es.batchSize {
+			break
+		}
+	}
+
+	return total
+}
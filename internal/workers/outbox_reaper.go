@@ -0,0 +1,133 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/config"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/logger"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository/postgresql"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultOutboxLease        = 30 * time.Second
+	defaultOutboxReapInterval = 10 * time.Second
+)
+
+// errLeaseExpired is the last error of a task reclaimed by the reaper.
+var errLeaseExpired = errors.New("lease expired")
+
+// OutboxReaper makes outbox tasks claimable again once the lease of the
+// replica that fetched them expired, e.g. because it crashed mid-publish. The
+// expired lease counts as a failed attempt of the task.
+type OutboxReaper struct {
+	repo     postgresql.OutboxRepository
+	interval time.Duration
+}
+
+func NewOutboxReaper(repo postgresql.OutboxRepository, cfg config.OutboxConfig) *OutboxReaper {
+	interval := time.Duration(cfg.ReapIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = defaultOutboxReapInterval
+	}
+
+	return &OutboxReaper{
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+func (or *OutboxReaper) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(or.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reclaimed, err := or.repo.ReclaimExpired(ctx, errLeaseExpired.Error())
+				if err != nil {
+					logger.ZapLogger.Error("reclaim outbox tasks failed", zap.String("outboxreaper", err.Error()))
+
+					continue
+				}
+				if reclaimed > 0 {
+					monitoring.OutboxTasksReclaimedTotal.Add(float64(reclaimed))
+					logger.ZapLogger.Info("outbox tasks with expired lease reclaimed", zap.Int64("count", reclaimed))
+				}
+			}
+		}
+	}()
+}
+
+func outboxLease(cfg config.OutboxConfig) time.Duration {
+	lease := time.Duration(cfg.LeaseSeconds) * time.Second
+	if lease <= 0 {
+		return defaultOutboxLease
+	}
+
+	return lease
+}
+
+// outboxCodec encodes events with protobuf unless the config asks for another
+// known encoding.
+func outboxCodec(cfg config.OutboxConfig) events.Codec {
+	if cfg.Encoding != "" {
+		codec, err := events.NewCodec(events.Encoding(cfg.Encoding))
+		if err == nil {
+			return codec
+		}
+		logger.ZapLogger.Error("unknown outbox encoding, using protobuf", zap.Error(err))
+	}
+	codec, _ := events.NewCodec(events.EncodingProtobuf)
+
+	return codec
+}
+
+// leaseOwner names this process among the replicas sharing the outbox.
+func leaseOwner() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// retryPoliciesFromConfig skips policies of unknown task types and incorrect
+// policies, their tasks use the default policy.
+func retryPoliciesFromConfig(cfgs []config.RetryPolicyConfig) domain.RetryPolicies {
+	policies := make(domain.RetryPolicies, len(cfgs))
+	for _, cfg := range cfgs {
+		taskType, err := domain.ParseTaskType(cfg.TaskType)
+		if err != nil {
+			logger.ZapLogger.Error("skipping outbox retry policy", zap.String("task_type", cfg.TaskType), zap.Error(err))
+
+			continue
+		}
+		policy, err := domain.NewRetryPolicy(
+			cfg.MaxAttempts,
+			time.Duration(cfg.BaseDelayMs)*time.Millisecond,
+			cfg.Multiplier,
+			cfg.Jitter,
+			time.Duration(cfg.MaxDelayMs)*time.Millisecond,
+		)
+		if err != nil {
+			logger.ZapLogger.Error("skipping outbox retry policy", zap.String("task_type", cfg.TaskType), zap.Error(err))
+
+			continue
+		}
+		policies[taskType] = policy
+	}
+
+	return policies
+}
@@ -6,16 +6,20 @@ import (
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/domain"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/kafka_broker"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/logger"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/monitoring"
 	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/internal/pkg/repository/postgresql"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events"
 	"go.uber.org/zap"
+	"math/rand"
 	"strings"
 	"sync"
 	"time"
 )
 
+// WorkerDb stores HTTP request audit records with their outbox tasks. Order
+// status changes are audited by the service in their own transaction.
 type WorkerDb struct {
 	ar    postgresql.AuditRepository
-	osar  postgresql.OrderStatusAuditRepository
 	ob    postgresql.OutboxRepository
 	batch []interface{}
 	timer *time.Timer
@@ -24,13 +28,11 @@ type WorkerDb struct {
 func NewWorkerDb(
 	ob postgresql.OutboxRepository,
 	ar postgresql.AuditRepository,
-	osar postgresql.OrderStatusAuditRepository,
 ) *WorkerDb {
 	return &WorkerDb{
 		batch: make([]interface{}, 0),
 		ob:    ob,
 		ar:    ar,
-		osar:  osar,
 		timer: time.NewTimer(500 * time.Millisecond),
 	}
 }
@@ -133,29 +135,11 @@ func FormatAudit(job interface{}) string {
 	if ok {
 		return formatAuditLog(auditJob)
 	}
-	orderStatusAudit, ok := job.(domain.AuditOrderInfo)
-	if ok {
-		return formatOrderStatusLog(orderStatusAudit)
-	}
 	logger.ZapLogger.Debug("job cannot be used as an audit record")
 
 	return ""
 }
 
-func formatOrderStatusLog(log domain.AuditOrderInfo) string {
-	return fmt.Sprintf(`
-Audit OrderStatusLog Entry:
----------------
-OrderID: %d
-Previous Status: %s
-Current Status: %s
-`,
-		log.OrderID,
-		log.PreviousStatus,
-		log.CurrentStatus,
-	)
-}
-
 func formatAuditLog(auditJob domain.AuditLogRecord) string {
 	const noneString = "none"
 	requestBody := noneString
@@ -270,9 +254,10 @@ func (w *WorkerDb) processBatch(ctx context.Context, batch []interface{}) error
 				continue
 			}
 
-			// add to outbox
+			// add to outbox, the event continues the trace the client sent
 			taskStatus := domain.AuditLog
-			_, err = w.ob.Create(ctx, entryID, taskStatus)
+			traceCtx := events.ContextWithTrace(ctx, events.TraceFromHeaders(auditRecord.RequestHeader))
+			_, err = w.ob.Create(traceCtx, entryID, taskStatus)
 			if err != nil {
 				return err
 			}
@@ -280,47 +265,46 @@ func (w *WorkerDb) processBatch(ctx context.Context, batch []interface{}) error
 			continue
 		}
 
-		orderStatusLog, ok := job.(domain.AuditOrderInfo)
-		if ok {
-			// add to audit table
-			entryID, err := w.osar.Create(ctx, orderStatusLog)
-			if err != nil {
-				logger.ZapLogger.Debug("dbworker cannot create new audit record", zap.String("dbworker", err.Error()))
-
-				continue
-			}
-
-			// add to outbox
-			taskType := domain.OrderStatusLog
-			_, err = w.ob.Create(ctx, entryID, taskType)
-			if err != nil {
-				logger.ZapLogger.Error("outbox cannot create an entry", zap.String("outbox", err.Error()))
-
-				return err
-			}
-
-			continue
-		}
-
-		return fmt.Errorf("worker: invalid job type, expected AuditLogRecord or AuditOrderInfo")
+		return fmt.Errorf("worker: invalid job type, expected AuditLogRecord")
 	}
 
 	return nil
 }
 
+// OutboxWorker publishes outbox tasks leased to owner. A task is leased for
+// lease, if the worker dies before it is done the reaper reclaims the task.
+// Failed tasks are retried by the policy of their type and end up in dead
+// letters once the policy is exhausted. Events are encoded by codec.
 type OutboxWorker struct {
 	client   *kafka_broker.Client
 	wg       *sync.WaitGroup
 	repo     postgresql.OutboxRepository
 	interval time.Duration
+	owner    string
+	lease    time.Duration
+	policies domain.RetryPolicies
+	codec    events.Codec
 }
 
-func NewOutboxWorker(client *kafka_broker.Client, wg *sync.WaitGroup, repo postgresql.OutboxRepository, interval time.Duration) *OutboxWorker {
+func NewOutboxWorker(
+	client *kafka_broker.Client,
+	wg *sync.WaitGroup,
+	repo postgresql.OutboxRepository,
+	interval time.Duration,
+	owner string,
+	lease time.Duration,
+	policies domain.RetryPolicies,
+	codec events.Codec,
+) *OutboxWorker {
 	return &OutboxWorker{
 		client:   client,
 		wg:       wg,
 		repo:     repo,
 		interval: interval,
+		owner:    owner,
+		lease:    lease,
+		policies: policies,
+		codec:    codec,
 	}
 }
 
@@ -335,7 +319,7 @@ func (ow *OutboxWorker) ProcessOutbox(ctx context.Context) {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
-				tasks, err := ow.repo.FetchAndMarkProcessing(ctx, 10)
+				tasks, err := ow.repo.FetchAndMarkProcessing(ctx, ow.owner, ow.lease, 10)
 				if err != nil {
 					logger.ZapLogger.Error("fetch tasks failed", zap.String("obworker", err.Error()))
 
@@ -345,20 +329,74 @@ func (ow *OutboxWorker) ProcessOutbox(ctx context.Context) {
 					continue
 				}
 
-				var failedIDs []int64
+				var publishedIDs []int64
 				for _, t := range tasks {
-					key := fmt.Sprint(t.TaskID)
-					if err := ow.client.Publish(ctx, key, t); err != nil {
+					if ow.policies.For(t.TaskType).Exhausted(t.AttemptsCount) {
+						ow.bury(ctx, t, t.AttemptsCount, t.LastError)
+
+						continue
+					}
+					if err := ow.publish(ctx, t); err != nil {
 						logger.ZapLogger.Error("failed publishing task_id", zap.String("obworker", fmt.Sprintf("task: %d, error: %v", t.TaskID, err)))
+						ow.fail(ctx, t, err)
 
-						failedIDs = append(failedIDs, t.TaskID)
+						continue
 					}
+					publishedIDs = append(publishedIDs, t.TaskID)
 				}
 
-				if err := ow.repo.DeleteSuccessful(ctx, tasks, failedIDs); err != nil {
+				if err := ow.repo.DeleteSuccessful(ctx, ow.owner, publishedIDs); err != nil {
 					logger.ZapLogger.Error("failed cleanup tasks", zap.String("obworker", err.Error()))
 				}
 			}
 		}
 	}()
 }
+
+// publish sends the event of the task keyed by its aggregate, so events of
+// one order stay in order.
+func (ow *OutboxWorker) publish(ctx context.Context, task domain.Task) error {
+	event, err := newEvent(task)
+	if err != nil {
+		return err
+	}
+
+	value, err := ow.codec.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return ow.client.Publish(ctx, event.AggregateID, value, map[string]string{
+		events.ContentTypeHeader: ow.codec.ContentType(),
+	})
+}
+
+// fail schedules the next attempt of the task or moves it to dead letters when
+// its retry policy is exhausted.
+func (ow *OutboxWorker) fail(ctx context.Context, task domain.Task, publishErr error) {
+	attempts := task.AttemptsCount + 1
+	policy := ow.policies.For(task.TaskType)
+	if policy.Exhausted(attempts) {
+		ow.bury(ctx, task, attempts, publishErr.Error())
+
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(policy.Delay(attempts, rand.Float64()))
+	if err := ow.repo.Retry(ctx, ow.owner, task.TaskID, nextAttemptAt, publishErr.Error()); err != nil {
+		logger.ZapLogger.Error("failed scheduling task retry", zap.String("obworker", err.Error()))
+	}
+}
+
+// bury moves the task that failed attempts times to dead letters with the
+// error of its last attempt. A task fetched with no attempts left, e.g. after
+// its leases kept expiring, is buried without publishing and keeps the last
+// error stored with it.
+func (ow *OutboxWorker) bury(ctx context.Context, task domain.Task, attempts int, lastError string) {
+	if err := ow.repo.MoveToDeadLetters(ctx, ow.owner, task.TaskID, attempts, lastError); err != nil {
+		logger.ZapLogger.Error("failed moving task to dead letters", zap.String("obworker", err.Error()))
+
+		return
+	}
+	monitoring.OutboxDeadLettersTotal.WithLabelValues(string(task.TaskType)).Inc()
+}
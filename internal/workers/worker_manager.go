@@ -26,19 +26,28 @@ type WorkerManager struct {
 func NewWorkerManager(
 	client *kafka_broker.Client,
 	ar postgresql.AuditRepository,
-	osar postgresql.OrderStatusAuditRepository,
 	or postgresql.OutboxRepository,
 	bufferSize int,
 	cancel func(),
 	cfg config.Config,
 ) *WorkerManager {
 	wg := sync.WaitGroup{}
+	outboxWorker := NewOutboxWorker(
+		client,
+		&wg,
+		or,
+		time.Duration(5)*time.Second,
+		leaseOwner(),
+		outboxLease(cfg.Outbox),
+		retryPoliciesFromConfig(cfg.Outbox.Retry),
+		outboxCodec(cfg.Outbox),
+	)
 
 	return &WorkerManager{
 		input:        make(chan interface{}, bufferSize),
-		dbWorker:     NewWorkerDb(or, ar, osar),
+		dbWorker:     NewWorkerDb(or, ar),
 		stdOutWorker: NewWorkerStdOut(cfg.FilterWord),
-		outboxWorker: NewOutboxWorker(client, &wg, or, time.Duration(5)*time.Second),
+		outboxWorker: outboxWorker,
 		cancel:       cancel,
 		wg:           &wg,
 	}
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS packages (
+    name varchar(255) PRIMARY KEY,
+    max_weight integer NOT NULL DEFAULT 0,
+    price integer NOT NULL,
+    can_wrap boolean NOT NULL DEFAULT false,
+    is_active boolean NOT NULL DEFAULT true
+);
+
+INSERT INTO packages (name, max_weight, price, can_wrap, is_active)
+VALUES ('box', 30, 20, true, true),
+       ('bag', 10, 5, false, true),
+       ('film', 0, 1, true, true)
+ON CONFLICT (name) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS packages;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS package_type varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS is_additional_film boolean NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS base_cost integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS packaging_cost integer NOT NULL DEFAULT 0;
+
+-- orders created before the breakdown existed keep their cost as the goods price
+-- unless the packaging layers tell otherwise
+UPDATE orders o
+SET package_type       = COALESCE(o.packaging -> 0 ->> 'package_type', ''),
+    is_additional_film = EXISTS (SELECT 1
+                                 FROM jsonb_array_elements(o.packaging) l
+                                 WHERE (l ->> 'position')::int > 0
+                                   AND l ->> 'package_type' = 'film'),
+    packaging_cost     = p.total,
+    base_cost          = o.cost - p.total
+FROM (SELECT order_id,
+             COALESCE((SELECT SUM((l ->> 'cost')::int) FROM jsonb_array_elements(packaging) l), 0) AS total
+      FROM orders) p
+WHERE o.order_id = p.order_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS package_type,
+    DROP COLUMN IF EXISTS is_additional_film,
+    DROP COLUMN IF EXISTS base_cost,
+    DROP COLUMN IF EXISTS packaging_cost;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS length integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS width integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS height integer NOT NULL DEFAULT 0;
+
+ALTER TABLE packages
+    ADD COLUMN IF NOT EXISTS max_volume integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_length integer NOT NULL DEFAULT 0;
+
+UPDATE packages SET max_volume = 125000, max_length = 100 WHERE name = 'box';
+UPDATE packages SET max_volume = 30000, max_length = 40 WHERE name = 'bag';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE packages
+    DROP COLUMN IF EXISTS max_volume,
+    DROP COLUMN IF EXISTS max_length;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS length,
+    DROP COLUMN IF EXISTS width,
+    DROP COLUMN IF EXISTS height;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- costs used to be whole roubles, money is stored in kopecks from now on
+ALTER TABLE orders
+    ALTER COLUMN cost TYPE bigint USING cost * 100,
+    ALTER COLUMN base_cost TYPE bigint USING base_cost * 100,
+    ALTER COLUMN packaging_cost TYPE bigint USING packaging_cost * 100,
+    ADD COLUMN IF NOT EXISTS currency varchar(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE orders
+SET packaging = COALESCE((SELECT jsonb_agg(jsonb_set(l, '{cost}', jsonb_build_object(
+        'amount', (l ->> 'cost')::bigint * 100,
+        'currency', 'RUB')) ORDER BY (l ->> 'position')::int)
+                          FROM jsonb_array_elements(packaging) l), '[]'::jsonb);
+
+ALTER TABLE packages
+    ALTER COLUMN price TYPE bigint USING price * 100,
+    ADD COLUMN IF NOT EXISTS currency varchar(3) NOT NULL DEFAULT 'RUB';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE packages
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN price TYPE integer USING price / 100;
+
+UPDATE orders
+SET packaging = COALESCE((SELECT jsonb_agg(jsonb_set(l, '{cost}', to_jsonb((l -> 'cost' ->> 'amount')::bigint / 100))
+                                           ORDER BY (l ->> 'position')::int)
+                          FROM jsonb_array_elements(packaging) l), '[]'::jsonb);
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN cost TYPE integer USING cost / 100,
+    ALTER COLUMN base_cost TYPE integer USING base_cost / 100,
+    ALTER COLUMN packaging_cost TYPE integer USING packaging_cost / 100;
+-- +goose StatementEnd
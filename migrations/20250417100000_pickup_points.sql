@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pickup_points (
+    id bigserial PRIMARY KEY,
+    name varchar(255) NOT NULL,
+    address text NOT NULL,
+    timezone varchar(64) NOT NULL DEFAULT 'Europe/Moscow',
+    opens_at varchar(5) NOT NULL DEFAULT '09:00',
+    closes_at varchar(5) NOT NULL DEFAULT '21:00',
+    order_expiration_days integer NOT NULL DEFAULT 0
+);
+
+-- every existing order was received at the only point the service knew about
+INSERT INTO pickup_points (id, name, address)
+VALUES (1, 'default', 'default pickup point')
+ON CONFLICT (id) DO NOTHING;
+SELECT setval('pickup_points_id_seq', (SELECT MAX(id) FROM pickup_points));
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS pickup_point_id bigint NOT NULL DEFAULT 1 REFERENCES pickup_points (id);
+CREATE INDEX IF NOT EXISTS orders_pickup_point_id_idx ON orders (pickup_point_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_pickup_point_id_idx;
+ALTER TABLE orders DROP COLUMN IF EXISTS pickup_point_id;
+DROP TABLE IF EXISTS pickup_points;
+-- +goose StatementEnd
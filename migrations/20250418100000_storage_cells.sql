@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS storage_cells (
+    id bigserial PRIMARY KEY,
+    pickup_point_id bigint NOT NULL REFERENCES pickup_points (id) ON DELETE CASCADE,
+    code varchar(32) NOT NULL,
+    max_weight integer NOT NULL DEFAULT 0,
+    max_volume integer NOT NULL DEFAULT 0,
+    max_length integer NOT NULL DEFAULT 0,
+    order_id bigint UNIQUE REFERENCES orders (order_id) ON DELETE SET NULL,
+    UNIQUE (pickup_point_id, code)
+);
+
+CREATE INDEX IF NOT EXISTS storage_cells_free_idx ON storage_cells (pickup_point_id) WHERE order_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS storage_cells;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pickup_codes (
+    order_id bigint PRIMARY KEY REFERENCES orders (order_id) ON DELETE CASCADE,
+    code_hash varchar(64) NOT NULL,
+    failed_attempts integer NOT NULL DEFAULT 0,
+    locked_until timestamptz,
+    used_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- overrides outlive the orders they were made for
+CREATE TABLE IF NOT EXISTS pickup_overrides (
+    entry_id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    supervisor_id bigint NOT NULL,
+    reason text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pickup_overrides;
+DROP TABLE IF EXISTS pickup_codes;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- details outlive the orders they were given for, disputes may come later
+CREATE TABLE IF NOT EXISTS refund_details (
+    order_id bigint PRIMARY KEY,
+    reason_code varchar(64) NOT NULL,
+    comment text NOT NULL DEFAULT '',
+    item_condition varchar(16) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS refund_details_reason_code_idx ON refund_details (reason_code);
+CREATE INDEX IF NOT EXISTS refund_details_item_condition_idx ON refund_details (item_condition);
+
+ALTER TABLE order_status_audit
+    ADD COLUMN IF NOT EXISTS refund_reason varchar(64),
+    ADD COLUMN IF NOT EXISTS refund_comment text,
+    ADD COLUMN IF NOT EXISTS item_condition varchar(16);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_status_audit
+    DROP COLUMN IF EXISTS item_condition,
+    DROP COLUMN IF EXISTS refund_comment,
+    DROP COLUMN IF EXISTS refund_reason;
+
+DROP TABLE IF EXISTS refund_details;
+-- +goose StatementEnd
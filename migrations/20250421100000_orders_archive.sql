@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- returned orders are moved here instead of being deleted, rows are purged
+-- by the archive purger once the retention period is over
+CREATE TABLE IF NOT EXISTS orders_archive (
+    order_id bigint PRIMARY KEY,
+    user_id bigint NOT NULL,
+    expiration_date timestamp NOT NULL,
+    status varchar(255) NOT NULL,
+    last_changed_at timestamptz NOT NULL,
+    weight integer NOT NULL,
+    cost bigint NOT NULL,
+    packaging jsonb NOT NULL DEFAULT '[]'::jsonb,
+    package_type varchar(255) NOT NULL DEFAULT '',
+    is_additional_film boolean NOT NULL DEFAULT false,
+    base_cost bigint NOT NULL DEFAULT 0,
+    packaging_cost bigint NOT NULL DEFAULT 0,
+    length integer NOT NULL DEFAULT 0,
+    width integer NOT NULL DEFAULT 0,
+    height integer NOT NULL DEFAULT 0,
+    currency varchar(3) NOT NULL DEFAULT 'RUB',
+    pickup_point_id bigint NOT NULL,
+    archived_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS orders_archive_user_id_idx ON orders_archive (user_id);
+CREATE INDEX IF NOT EXISTS orders_archive_pickup_point_id_idx ON orders_archive (pickup_point_id);
+CREATE INDEX IF NOT EXISTS orders_archive_archived_at_idx ON orders_archive (archived_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS orders_archive;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS return_manifests (
+    id bigserial PRIMARY KEY,
+    pickup_point_id bigint NOT NULL REFERENCES pickup_points (id),
+    courier_name varchar(255) NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'open',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    closed_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS return_manifests_pickup_point_id_idx ON return_manifests (pickup_point_id, status);
+
+-- items outlive the orders, closed manifests keep what left with the courier
+CREATE TABLE IF NOT EXISTS return_manifest_items (
+    manifest_id bigint NOT NULL REFERENCES return_manifests (id) ON DELETE CASCADE,
+    order_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    order_status varchar(255) NOT NULL,
+    scanned_at timestamptz,
+    PRIMARY KEY (manifest_id, order_id)
+);
+
+CREATE INDEX IF NOT EXISTS return_manifest_items_order_id_idx ON return_manifest_items (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS return_manifest_items;
+DROP TABLE IF EXISTS return_manifests;
+-- +goose StatementEnd
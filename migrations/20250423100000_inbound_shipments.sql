@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS inbound_shipments (
+    id bigserial PRIMARY KEY,
+    pickup_point_id bigint NOT NULL REFERENCES pickup_points (id),
+    reference varchar(255) NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'open',
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    closed_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS inbound_shipments_pickup_point_id_idx ON inbound_shipments (pickup_point_id, status);
+
+-- the expected manifest, orders are created from it when the shipment is closed
+CREATE TABLE IF NOT EXISTS inbound_shipment_items (
+    shipment_id bigint NOT NULL REFERENCES inbound_shipments (id) ON DELETE CASCADE,
+    order_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    expiration_date timestamp NOT NULL,
+    weight integer NOT NULL,
+    cost bigint NOT NULL,
+    currency varchar(3) NOT NULL,
+    length integer NOT NULL DEFAULT 0,
+    width integer NOT NULL DEFAULT 0,
+    height integer NOT NULL DEFAULT 0,
+    PRIMARY KEY (shipment_id, order_id)
+);
+
+-- every scanned order, including the ones the manifest does not list
+CREATE TABLE IF NOT EXISTS inbound_shipment_scans (
+    shipment_id bigint NOT NULL REFERENCES inbound_shipments (id) ON DELETE CASCADE,
+    order_id bigint NOT NULL,
+    scanned_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (shipment_id, order_id)
+);
+
+CREATE TABLE IF NOT EXISTS inbound_shipment_reports (
+    shipment_id bigint PRIMARY KEY REFERENCES inbound_shipments (id) ON DELETE CASCADE,
+    received bigint[] NOT NULL DEFAULT '{}',
+    missing bigint[] NOT NULL DEFAULT '{}',
+    unexpected bigint[] NOT NULL DEFAULT '{}',
+    rejected jsonb NOT NULL DEFAULT '[]',
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS inbound_shipment_reports;
+DROP TABLE IF EXISTS inbound_shipment_scans;
+DROP TABLE IF EXISTS inbound_shipment_items;
+DROP TABLE IF EXISTS inbound_shipments;
+-- +goose StatementEnd
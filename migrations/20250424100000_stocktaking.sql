@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS stocktaking_sessions (
+    id bigserial PRIMARY KEY,
+    pickup_point_id bigint NOT NULL REFERENCES pickup_points (id),
+    operator varchar(255) NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'open',
+    started_at timestamptz NOT NULL DEFAULT NOW(),
+    finished_at timestamptz,
+    -- the result, set when the session is finished
+    expected_count integer,
+    found_count integer,
+    missing bigint[],
+    unknown bigint[]
+);
+
+-- a point is counted by one session at a time
+CREATE UNIQUE INDEX IF NOT EXISTS stocktaking_sessions_open_idx ON stocktaking_sessions (pickup_point_id)
+    WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS stocktaking_scans (
+    session_id bigint NOT NULL REFERENCES stocktaking_sessions (id) ON DELETE CASCADE,
+    order_id bigint NOT NULL,
+    scanned_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, order_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stocktaking_scans;
+DROP TABLE IF EXISTS stocktaking_sessions;
+-- +goose StatementEnd
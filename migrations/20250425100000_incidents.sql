@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- incidents outlive the orders, compensated orders may be archived later
+CREATE TABLE IF NOT EXISTS incidents (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    type varchar(16) NOT NULL,
+    description text NOT NULL,
+    operator varchar(255) NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'open',
+    order_status varchar(255) NOT NULL,
+    outcome varchar(16),
+    resolution text NOT NULL DEFAULT '',
+    resolved_by varchar(255),
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    resolved_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS incidents_order_id_idx ON incidents (order_id);
+-- an order is blocked by one incident at a time
+CREATE UNIQUE INDEX IF NOT EXISTS incidents_open_order_id_idx ON incidents (order_id) WHERE status = 'open';
+
+ALTER TABLE order_status_audit
+    ADD COLUMN IF NOT EXISTS incident_id bigint;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_status_audit
+    DROP COLUMN IF EXISTS incident_id;
+
+DROP TABLE IF EXISTS incidents;
+-- +goose StatementEnd
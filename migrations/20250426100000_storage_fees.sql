@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- points without a row use the global tariff of the config
+CREATE TABLE IF NOT EXISTS storage_tariffs (
+    pickup_point_id bigint PRIMARY KEY REFERENCES pickup_points (id) ON DELETE CASCADE,
+    free_days integer NOT NULL,
+    daily_rate bigint NOT NULL,
+    currency varchar(3) NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- payments outlive the orders they were collected for
+CREATE TABLE IF NOT EXISTS storage_fee_payments (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    pickup_point_id bigint NOT NULL,
+    charged_days integer NOT NULL,
+    amount bigint NOT NULL,
+    currency varchar(3) NOT NULL,
+    paid_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS storage_fee_payments_paid_at_idx ON storage_fee_payments (paid_at);
+CREATE INDEX IF NOT EXISTS orders_status_last_changed_at_idx ON orders (status, last_changed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_status_last_changed_at_idx;
+DROP TABLE IF EXISTS storage_fee_payments;
+DROP TABLE IF EXISTS storage_tariffs;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- a PROCESSING task belongs to lease_owner until lease_expires_at, expired
+-- leases are reclaimed by the outbox reaper
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS lease_owner varchar(255),
+    ADD COLUMN IF NOT EXISTS lease_expires_at timestamptz;
+
+-- tasks left in PROCESSING before leases existed are reclaimed on the next run
+UPDATE outbox SET lease_expires_at = NOW() WHERE task_status = 'PROCESSING';
+
+CREATE INDEX IF NOT EXISTS outbox_task_status_lease_expires_at_idx ON outbox (task_status, lease_expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS outbox_task_status_lease_expires_at_idx;
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS lease_expires_at,
+    DROP COLUMN IF EXISTS lease_owner;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS last_error text;
+
+-- tasks that failed every attempt of their retry policy
+CREATE TABLE IF NOT EXISTS outbox_dead_letters (
+    id bigserial PRIMARY KEY,
+    task_id bigint NOT NULL,
+    task_type varchar(255) NOT NULL,
+    entry_id bigint NOT NULL,
+    attempts_count integer NOT NULL,
+    last_error text NOT NULL,
+    created_at timestamp NOT NULL,
+    dead_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS outbox_dead_letters_task_type_dead_at_idx ON outbox_dead_letters (task_type, dead_at);
+
+-- exhausted tasks used to stay in the outbox as NO_ATTEMPTS_LEFT
+INSERT INTO outbox_dead_letters (task_id, task_type, entry_id, attempts_count, last_error, created_at, dead_at)
+SELECT task_id, task_type, entry_id, attempts_count, 'attempts exhausted before dead letters were kept',
+       created_at, COALESCE(finished_at, updated_at)
+FROM outbox
+WHERE task_status = 'NO_ATTEMPTS_LEFT';
+
+DELETE FROM outbox WHERE task_status = 'NO_ATTEMPTS_LEFT';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+INSERT INTO outbox (task_status, task_type, entry_id, created_at, updated_at, attempts_count, finished_at)
+SELECT 'NO_ATTEMPTS_LEFT', task_type, entry_id, created_at, dead_at, attempts_count, dead_at
+FROM outbox_dead_letters;
+
+DROP TABLE IF EXISTS outbox_dead_letters;
+ALTER TABLE outbox DROP COLUMN IF EXISTS last_error;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- W3C trace context of the operation that created the task, published with
+-- its event
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS trace_parent text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS trace_state text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS trace_state,
+    DROP COLUMN IF EXISTS trace_parent;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- reissues outlive the orders they were made for, supervisor_id is null when
+-- the owner asked for the new code
+CREATE TABLE IF NOT EXISTS pickup_code_reissues (
+    entry_id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    supervisor_id bigint,
+    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS pickup_code_reissues_order_id_idx ON pickup_code_reissues (order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pickup_code_reissues;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- storage fees accrue from the moment the order was put on the shelf,
+-- last_changed_at moves with every update of the order
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS arrived_at timestamptz;
+UPDATE orders SET arrived_at = last_changed_at WHERE arrived_at IS NULL;
+ALTER TABLE orders
+    ALTER COLUMN arrived_at SET DEFAULT NOW(),
+    ALTER COLUMN arrived_at SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders DROP COLUMN IF EXISTS arrived_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- trace context of the dead task, a requeued task publishes its event with it
+ALTER TABLE outbox_dead_letters
+    ADD COLUMN IF NOT EXISTS trace_parent text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS trace_state text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox_dead_letters
+    DROP COLUMN IF EXISTS trace_state,
+    DROP COLUMN IF EXISTS trace_parent;
+-- +goose StatementEnd
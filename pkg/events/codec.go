@@ -0,0 +1,78 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ContentTypeHeader is the Kafka header telling how a message is encoded.
+	ContentTypeHeader = "content-type"
+	// ContentTypeProtobuf is the data content type of protobuf payloads.
+	ContentTypeProtobuf = "application/protobuf"
+	// ContentTypeCloudEventsJSON is a JSON encoded envelope.
+	ContentTypeCloudEventsJSON = "application/cloudevents+json"
+	// ContentTypeCloudEventsProtobuf is an eventspb.Envelope.
+	ContentTypeCloudEventsProtobuf = "application/cloudevents+protobuf"
+)
+
+// Encoding selects the codec the outbox publishes with.
+type Encoding string
+
+const (
+	EncodingJSON     Encoding = "json"
+	EncodingProtobuf Encoding = "protobuf"
+)
+
+var ErrUnknownEncoding = errors.New("unknown event encoding")
+
+// Codec encodes envelopes to Kafka messages and back. Decoded envelopes carry
+// JSON data whatever the message encoding, so DecodeData works for both.
+type Codec interface {
+	ContentType() string
+	Marshal(e Envelope) ([]byte, error)
+	Unmarshal(b []byte) (Envelope, error)
+}
+
+func NewCodec(encoding Encoding) (Codec, error) {
+	switch encoding {
+	case EncodingJSON:
+		return jsonCodec{}, nil
+	case EncodingProtobuf:
+		return protobufCodec{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEncoding, encoding)
+	}
+}
+
+// CodecFor picks the codec by the content type header of a message.
+func CodecFor(contentType string) (Codec, error) {
+	switch contentType {
+	case ContentTypeCloudEventsJSON:
+		return jsonCodec{}, nil
+	case ContentTypeCloudEventsProtobuf:
+		return protobufCodec{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEncoding, contentType)
+	}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string {
+	return ContentTypeCloudEventsJSON
+}
+
+func (jsonCodec) Marshal(e Envelope) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func (jsonCodec) Unmarshal(b []byte) (Envelope, error) {
+	var e Envelope
+	if err := json.Unmarshal(b, &e); err != nil {
+		return Envelope{}, fmt.Errorf("unmarshal envelope: %w", err)
+	}
+
+	return e, nil
+}
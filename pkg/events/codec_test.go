@@ -0,0 +1,82 @@
+package events
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events/eventspb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCodec_RoundTrip(t *testing.T) {
+	t.Parallel()
+	incidentID := int64(7)
+	payloads := []Payload{
+		OrderStatusChanged{
+			OrderID:        42,
+			PreviousStatus: "completed",
+			CurrentStatus:  "refunded",
+			Refund:         &Refund{ReasonCode: "size", Comment: "too small", ItemCondition: "opened"},
+			IncidentID:     &incidentID,
+		},
+		HTTPRequestAudited{
+			EntryID:       3,
+			Method:        http.MethodPost,
+			Path:          "/orders/42",
+			RequestHeader: http.Header{"Content-Type": {"application/json"}, "Accept": {"a", "b"}},
+			RequestBody:   []byte(`{"order_id":42}`),
+			QueryParams:   map[string]string{"id": "42"},
+			StatusCode:    http.StatusOK,
+			ResponseBody:  []byte(`null`),
+		},
+	}
+	trace := TraceContext{TraceParent: testTraceParent, TraceState: "vendor=value"}
+
+	for _, encoding := range []Encoding{EncodingJSON, EncodingProtobuf} {
+		codec, err := NewCodec(encoding)
+		require.NoError(t, err)
+		for _, payload := range payloads {
+			t.Run(string(encoding)+" "+payload.EventType(), func(t *testing.T) {
+				t.Parallel()
+				envelope, err := NewEnvelope("event-1", time.Date(2025, 4, 29, 7, 0, 0, 0, time.UTC), trace, payload)
+				require.NoError(t, err)
+
+				encoded, err := codec.Marshal(envelope)
+				require.NoError(t, err)
+				byHeader, err := CodecFor(codec.ContentType())
+				require.NoError(t, err)
+				decoded, err := byHeader.Unmarshal(encoded)
+				require.NoError(t, err)
+
+				require.Equal(t, envelope, decoded)
+			})
+		}
+	}
+}
+
+func TestProtobufCodec_Marshal(t *testing.T) {
+	t.Parallel()
+	envelope, err := NewEnvelope("event-1", time.Now(), TraceContext{}, OrderStatusChanged{OrderID: 42})
+	require.NoError(t, err)
+
+	encoded, err := protobufCodec{}.Marshal(envelope)
+	require.NoError(t, err)
+
+	var pb eventspb.Envelope
+	require.NoError(t, proto.Unmarshal(encoded, &pb))
+	require.Equal(t, ContentTypeProtobuf, pb.GetDataContentType())
+	var payload eventspb.OrderStatusChanged
+	require.NoError(t, proto.Unmarshal(pb.GetData(), &payload))
+	require.Equal(t, int64(42), payload.GetOrderId())
+}
+
+func TestNewCodec(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCodec("xml")
+	require.ErrorIs(t, err, ErrUnknownEncoding)
+	_, err = CodecFor("text/plain")
+	require.ErrorIs(t, err, ErrUnknownEncoding)
+}
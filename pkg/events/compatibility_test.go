@@ -0,0 +1,76 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events/eventspb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type publishedField struct {
+	name   protoreflect.Name
+	number protoreflect.FieldNumber
+	kind   protoreflect.Kind
+}
+
+// publishedFields are the fields consumers rely on. New fields may be added to
+// the messages, but a field listed here must never be removed, renamed,
+// renumbered or change its type. Add new fields here once they are published.
+var publishedFields = map[proto.Message][]publishedField{
+	&eventspb.Envelope{}: {
+		{"id", 1, protoreflect.StringKind},
+		{"source", 2, protoreflect.StringKind},
+		{"spec_version", 3, protoreflect.StringKind},
+		{"type", 4, protoreflect.StringKind},
+		{"data_content_type", 5, protoreflect.StringKind},
+		{"schema_version", 6, protoreflect.Int32Kind},
+		{"time", 7, protoreflect.MessageKind},
+		{"aggregate_id", 8, protoreflect.StringKind},
+		{"trace_parent", 9, protoreflect.StringKind},
+		{"trace_state", 10, protoreflect.StringKind},
+		{"data", 11, protoreflect.BytesKind},
+	},
+	&eventspb.OrderStatusChanged{}: {
+		{"order_id", 1, protoreflect.Int64Kind},
+		{"previous_status", 2, protoreflect.StringKind},
+		{"current_status", 3, protoreflect.StringKind},
+		{"refund", 4, protoreflect.MessageKind},
+		{"incident_id", 5, protoreflect.Int64Kind},
+	},
+	&eventspb.Refund{}: {
+		{"reason_code", 1, protoreflect.StringKind},
+		{"comment", 2, protoreflect.StringKind},
+		{"item_condition", 3, protoreflect.StringKind},
+	},
+	&eventspb.HTTPRequestAudited{}: {
+		{"entry_id", 1, protoreflect.Int64Kind},
+		{"method", 2, protoreflect.StringKind},
+		{"path", 3, protoreflect.StringKind},
+		{"request_header", 4, protoreflect.MessageKind},
+		{"request_body", 5, protoreflect.BytesKind},
+		{"query_params", 6, protoreflect.MessageKind},
+		{"status_code", 7, protoreflect.Int32Kind},
+		{"response_body", 8, protoreflect.BytesKind},
+	},
+	&eventspb.HeaderValues{}: {
+		{"values", 1, protoreflect.StringKind},
+	},
+}
+
+func TestEventMessages_Compatibility(t *testing.T) {
+	t.Parallel()
+
+	for msg, fields := range publishedFields {
+		descriptor := msg.ProtoReflect().Descriptor()
+		for _, want := range fields {
+			field := descriptor.Fields().ByName(want.name)
+			require.NotNil(t, field, "%s.%s was removed or renamed", descriptor.FullName(), want.name)
+			require.Equal(t, want.number, field.Number(), "%s.%s was renumbered", descriptor.FullName(), want.name)
+			require.Equal(t, want.kind, field.Kind(), "%s.%s changed its type", descriptor.FullName(), want.name)
+			require.Equal(t, want.name, descriptor.Fields().ByNumber(want.number).Name(),
+				"%s reuses number %d", descriptor.FullName(), want.number)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Package events holds the messages the pickup point service publishes to
+// Kafka. Every message is an Envelope laid out after CloudEvents 1.0 with the
+// payload in Data, consumers pick the payload type by Envelope.Type. Messages
+// are encoded by a Codec, the protobuf schema is in proto/events.proto.
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// SpecVersion is the CloudEvents version the envelope follows.
+	SpecVersion = "1.0"
+	// Source identifies the service in every envelope.
+	Source = "/pvz"
+	// ContentTypeJSON is the encoding of the payload.
+	ContentTypeJSON = "application/json"
+)
+
+var ErrUnexpectedType = errors.New("unexpected event type")
+
+// Payload is the data of an event. SchemaVersion is bumped whenever the
+// payload changes in a way old consumers cannot read.
+type Payload interface {
+	EventType() string
+	SchemaVersion() int
+	AggregateID() string
+}
+
+// Envelope wraps a payload with what a consumer needs to route, dedupe and
+// trace it. ID is the same for every delivery of one event, Time is when the
+// event occurred, not when it was published.
+type Envelope struct {
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	SpecVersion     string          `json:"specversion"`
+	Type            string          `json:"type"`
+	DataContentType string          `json:"datacontenttype"`
+	SchemaVersion   int             `json:"schemaversion"`
+	Time            time.Time       `json:"time"`
+	AggregateID     string          `json:"aggregateid"`
+	TraceContext                    // W3C trace context of the operation that caused the event
+	Data            json.RawMessage `json:"data"`
+}
+
+func NewEnvelope(id string, occurredAt time.Time, trace TraceContext, payload Payload) (Envelope, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("marshal %s payload: %w", payload.EventType(), err)
+	}
+
+	return Envelope{
+		ID:              id,
+		Source:          Source,
+		SpecVersion:     SpecVersion,
+		Type:            payload.EventType(),
+		DataContentType: ContentTypeJSON,
+		SchemaVersion:   payload.SchemaVersion(),
+		Time:            occurredAt.UTC(),
+		AggregateID:     payload.AggregateID(),
+		TraceContext:    trace,
+		Data:            data,
+	}, nil
+}
+
+// DecodeData unmarshals the data into payload, the envelope must carry an
+// event of the payload type.
+func (e Envelope) DecodeData(payload Payload) error {
+	if e.Type != payload.EventType() {
+		return fmt.Errorf("%w: %s, want %s", ErrUnexpectedType, e.Type, payload.EventType())
+	}
+
+	return json.Unmarshal(e.Data, payload)
+}
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestNewEnvelope(t *testing.T) {
+	t.Parallel()
+	occurredAt := time.Date(2025, 4, 29, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	incidentID := int64(7)
+	payload := OrderStatusChanged{
+		OrderID:        42,
+		PreviousStatus: "accepted",
+		CurrentStatus:  "blocked",
+		IncidentID:     &incidentID,
+	}
+
+	envelope, err := NewEnvelope("ORDER_STATUS_LOG-1", occurredAt, TraceContext{TraceParent: testTraceParent}, payload)
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	require.Equal(t, "ORDER_STATUS_LOG-1", fields["id"])
+	require.Equal(t, SpecVersion, fields["specversion"])
+	require.Equal(t, OrderStatusChangedType, fields["type"])
+	require.Equal(t, ContentTypeJSON, fields["datacontenttype"])
+	require.Equal(t, float64(1), fields["schemaversion"])
+	require.Equal(t, "2025-04-29T07:00:00Z", fields["time"])
+	require.Equal(t, "42", fields["aggregateid"])
+	require.Equal(t, testTraceParent, fields["traceparent"])
+	require.NotContains(t, fields, "tracestate")
+
+	var decoded Envelope
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	var data OrderStatusChanged
+	require.NoError(t, decoded.DecodeData(&data))
+	require.Equal(t, payload, data)
+}
+
+func TestEnvelope_DecodeData(t *testing.T) {
+	t.Parallel()
+	envelope, err := NewEnvelope("AUDIT_LOG-1", time.Now(), TraceContext{}, HTTPRequestAudited{EntryID: 1})
+	require.NoError(t, err)
+
+	err = envelope.DecodeData(&OrderStatusChanged{})
+
+	require.ErrorIs(t, err, ErrUnexpectedType)
+}
+
+func TestTraceContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("survives a round trip through a context", func(t *testing.T) {
+		t.Parallel()
+		trace := TraceContext{TraceParent: testTraceParent, TraceState: "vendor=value"}
+
+		require.Equal(t, trace, TraceFromContext(ContextWithTrace(context.Background(), trace)))
+	})
+	t.Run("empty without a span", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, TraceContext{}, TraceFromContext(context.Background()))
+	})
+	t.Run("malformed headers are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, TraceContext{}, TraceFromHeaders(map[string][]string{"Traceparent": {"broken"}}))
+		require.Equal(t, testTraceParent,
+			TraceFromHeaders(map[string][]string{"Traceparent": {testTraceParent}}).TraceParent)
+	})
+}
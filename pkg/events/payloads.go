@@ -0,0 +1,69 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const (
+	OrderStatusChangedType = "pvz.order.status_changed"
+	HTTPRequestAuditedType = "pvz.http.request_audited"
+)
+
+// OrderStatusChanged is published for every status change of an order. Refund
+// is set when the order was refunded, IncidentID when an incident blocked or
+// released it.
+type OrderStatusChanged struct {
+	OrderID        int64   `json:"order_id"`
+	PreviousStatus string  `json:"previous_status"`
+	CurrentStatus  string  `json:"current_status"`
+	Refund         *Refund `json:"refund,omitempty"`
+	IncidentID     *int64  `json:"incident_id,omitempty"`
+}
+
+// Refund tells why the customer returned the order and what state it came
+// back in.
+type Refund struct {
+	ReasonCode    string `json:"reason_code"`
+	Comment       string `json:"comment,omitempty"`
+	ItemCondition string `json:"item_condition"`
+}
+
+func (OrderStatusChanged) EventType() string {
+	return OrderStatusChangedType
+}
+
+func (OrderStatusChanged) SchemaVersion() int {
+	return 1
+}
+
+func (p OrderStatusChanged) AggregateID() string {
+	return strconv.FormatInt(p.OrderID, 10)
+}
+
+// HTTPRequestAudited is published for every audited HTTP request. EntryID is
+// the audit log entry, the request has no other identity. Bodies are JSON as
+// they were audited.
+type HTTPRequestAudited struct {
+	EntryID       int64             `json:"entry_id"`
+	Method        string            `json:"method"`
+	Path          string            `json:"path"`
+	RequestHeader http.Header       `json:"request_header,omitempty"`
+	RequestBody   json.RawMessage   `json:"request_body,omitempty"`
+	QueryParams   map[string]string `json:"query_params,omitempty"`
+	StatusCode    int               `json:"status_code"`
+	ResponseBody  json.RawMessage   `json:"response_body,omitempty"`
+}
+
+func (HTTPRequestAudited) EventType() string {
+	return HTTPRequestAuditedType
+}
+
+func (HTTPRequestAudited) SchemaVersion() int {
+	return 1
+}
+
+func (p HTTPRequestAudited) AggregateID() string {
+	return strconv.FormatInt(p.EntryID, 10)
+}
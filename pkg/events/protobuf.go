@@ -0,0 +1,187 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"gitlab.ozon.dev/dimabelunin7/homework/hw-4/pkg/events/eventspb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type protobufCodec struct{}
+
+func (protobufCodec) ContentType() string {
+	return ContentTypeCloudEventsProtobuf
+}
+
+func (protobufCodec) Marshal(e Envelope) ([]byte, error) {
+	payload, err := payloadToProto(e.Type, e.Data)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s payload: %w", e.Type, err)
+	}
+
+	return proto.Marshal(&eventspb.Envelope{
+		Id:              e.ID,
+		Source:          e.Source,
+		SpecVersion:     e.SpecVersion,
+		Type:            e.Type,
+		DataContentType: ContentTypeProtobuf,
+		SchemaVersion:   int32(e.SchemaVersion),
+		Time:            timestamppb.New(e.Time),
+		AggregateId:     e.AggregateID,
+		TraceParent:     e.TraceParent,
+		TraceState:      e.TraceState,
+		Data:            data,
+	})
+}
+
+func (protobufCodec) Unmarshal(b []byte) (Envelope, error) {
+	var pb eventspb.Envelope
+	if err := proto.Unmarshal(b, &pb); err != nil {
+		return Envelope{}, fmt.Errorf("unmarshal envelope: %w", err)
+	}
+	data, err := payloadFromProto(pb.GetType(), pb.GetData())
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	return Envelope{
+		ID:              pb.GetId(),
+		Source:          pb.GetSource(),
+		SpecVersion:     pb.GetSpecVersion(),
+		Type:            pb.GetType(),
+		DataContentType: ContentTypeJSON,
+		SchemaVersion:   int(pb.GetSchemaVersion()),
+		Time:            pb.GetTime().AsTime(),
+		AggregateID:     pb.GetAggregateId(),
+		TraceContext:    TraceContext{TraceParent: pb.GetTraceParent(), TraceState: pb.GetTraceState()},
+		Data:            data,
+	}, nil
+}
+
+func payloadToProto(eventType string, data json.RawMessage) (proto.Message, error) {
+	switch eventType {
+	case OrderStatusChangedType:
+		var p OrderStatusChanged
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+
+		return p.toProto(), nil
+	case HTTPRequestAuditedType:
+		var p HTTPRequestAudited
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+
+		return p.toProto(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedType, eventType)
+	}
+}
+
+func payloadFromProto(eventType string, data []byte) (json.RawMessage, error) {
+	var payload Payload
+	switch eventType {
+	case OrderStatusChangedType:
+		var pb eventspb.OrderStatusChanged
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		payload = orderStatusChangedFromProto(&pb)
+	case HTTPRequestAuditedType:
+		var pb eventspb.HTTPRequestAudited
+		if err := proto.Unmarshal(data, &pb); err != nil {
+			return nil, fmt.Errorf("unmarshal %s payload: %w", eventType, err)
+		}
+		payload = httpRequestAuditedFromProto(&pb)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedType, eventType)
+	}
+
+	return json.Marshal(payload)
+}
+
+func (p OrderStatusChanged) toProto() *eventspb.OrderStatusChanged {
+	pb := &eventspb.OrderStatusChanged{
+		OrderId:        p.OrderID,
+		PreviousStatus: p.PreviousStatus,
+		CurrentStatus:  p.CurrentStatus,
+		IncidentId:     p.IncidentID,
+	}
+	if p.Refund != nil {
+		pb.Refund = &eventspb.Refund{
+			ReasonCode:    p.Refund.ReasonCode,
+			Comment:       p.Refund.Comment,
+			ItemCondition: p.Refund.ItemCondition,
+		}
+	}
+
+	return pb
+}
+
+func orderStatusChangedFromProto(pb *eventspb.OrderStatusChanged) OrderStatusChanged {
+	p := OrderStatusChanged{
+		OrderID:        pb.GetOrderId(),
+		PreviousStatus: pb.GetPreviousStatus(),
+		CurrentStatus:  pb.GetCurrentStatus(),
+		IncidentID:     pb.IncidentId,
+	}
+	if pb.GetRefund() != nil {
+		p.Refund = &Refund{
+			ReasonCode:    pb.GetRefund().GetReasonCode(),
+			Comment:       pb.GetRefund().GetComment(),
+			ItemCondition: pb.GetRefund().GetItemCondition(),
+		}
+	}
+
+	return p
+}
+
+func (p HTTPRequestAudited) toProto() *eventspb.HTTPRequestAudited {
+	var header map[string]*eventspb.HeaderValues
+	if len(p.RequestHeader) > 0 {
+		header = make(map[string]*eventspb.HeaderValues, len(p.RequestHeader))
+		for name, values := range p.RequestHeader {
+			header[name] = &eventspb.HeaderValues{Values: values}
+		}
+	}
+
+	return &eventspb.HTTPRequestAudited{
+		EntryId:       p.EntryID,
+		Method:        p.Method,
+		Path:          p.Path,
+		RequestHeader: header,
+		RequestBody:   p.RequestBody,
+		QueryParams:   p.QueryParams,
+		StatusCode:    int32(p.StatusCode),
+		ResponseBody:  p.ResponseBody,
+	}
+}
+
+func httpRequestAuditedFromProto(pb *eventspb.HTTPRequestAudited) HTTPRequestAudited {
+	var header http.Header
+	if len(pb.GetRequestHeader()) > 0 {
+		header = make(http.Header, len(pb.GetRequestHeader()))
+		for name, values := range pb.GetRequestHeader() {
+			header[name] = values.GetValues()
+		}
+	}
+
+	return HTTPRequestAudited{
+		EntryID:       pb.GetEntryId(),
+		Method:        pb.GetMethod(),
+		Path:          pb.GetPath(),
+		RequestHeader: header,
+		RequestBody:   pb.GetRequestBody(),
+		QueryParams:   pb.GetQueryParams(),
+		StatusCode:    int(pb.GetStatusCode()),
+		ResponseBody:  pb.GetResponseBody(),
+	}
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const (
+	traceParentKey = "traceparent"
+	traceStateKey  = "tracestate"
+)
+
+// TraceContext is the W3C trace context carried by an event, it is empty when
+// the operation was not traced.
+type TraceContext struct {
+	TraceParent string `json:"traceparent,omitempty"`
+	TraceState  string `json:"tracestate,omitempty"`
+}
+
+// TraceFromContext captures the trace context of the span in ctx.
+func TraceFromContext(ctx context.Context) TraceContext {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+
+	return TraceContext{
+		TraceParent: carrier.Get(traceParentKey),
+		TraceState:  carrier.Get(traceStateKey),
+	}
+}
+
+// TraceFromHeaders reads the trace context a client sent with a request.
+func TraceFromHeaders(header map[string][]string) TraceContext {
+	carrier := propagation.HeaderCarrier(header)
+	// extracting validates the headers, malformed ones are dropped
+	return TraceFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+}
+
+// ContextWithTrace returns ctx with the trace context as the remote parent,
+// spans a consumer starts from it continue the producer's trace.
+func ContextWithTrace(ctx context.Context, trace TraceContext) context.Context {
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{
+		traceParentKey: trace.TraceParent,
+		traceStateKey:  trace.TraceState,
+	})
+}